@@ -106,7 +106,7 @@ func main() {
 
 	fmt.Println("\nRecent commits:")
 	for i, logEntry := range logs {
-		fmt.Printf("  %d. %s - %s\n", i+1, logEntry.Message, logEntry.Author)
+		fmt.Printf("  %d. %s - %s\n", i+1, logEntry.Subject, logEntry.Author)
 	}
 
 	fmt.Printf("\nRepository created at: %s\n", repoPath)
@@ -95,7 +95,7 @@ func main() {
 	if err == nil {
 		fmt.Println("\nCommit history:")
 		for i, logEntry := range logs {
-			fmt.Printf("  %d. %s - %s\n", i+1, logEntry.Message, logEntry.Author)
+			fmt.Printf("  %d. %s - %s\n", i+1, logEntry.Subject, logEntry.Author)
 		}
 	}
 
@@ -184,7 +184,7 @@ line 3
 			},
 		}
 
-		err = gitInstance.ResolveConflicts(resolutions)
+		_, err = gitInstance.ResolveConflicts(resolutions)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -205,7 +205,7 @@ line 3
 	if err == nil {
 		fmt.Println("\nCommit history:")
 		for i, logEntry := range logs {
-			fmt.Printf("  %d. %s\n", i+1, logEntry.Message)
+			fmt.Printf("  %d. %s\n", i+1, logEntry.Subject)
 		}
 	}
 
@@ -181,7 +181,7 @@ func connect(dsn string) (*sql.DB, error) {
 	if err == nil {
 		fmt.Println("\nCommit history:")
 		for i, logEntry := range logs {
-			fmt.Printf("  %d. %s\n", i+1, logEntry.Message)
+			fmt.Printf("  %d. %s\n", i+1, logEntry.Subject)
 		}
 	}
 
@@ -123,7 +123,7 @@ func main() {
 
 	fmt.Printf("\nTags in bare repository:\n")
 	for _, tag := range tags {
-		fmt.Printf("  %s\n", tag)
+		fmt.Printf("  %s\n", tag.Name)
 	}
 
 	// Delete the branch
@@ -33,8 +33,11 @@ func TestRevertCommand(t *testing.T) {
 	require.Len(t, logs, 1)
 	
 	// Test revert - we test with HEAD since it's more reliable
-	err = gitInstance.Revert(git.WithArgs("HEAD", "--no-edit"))
+	result, err := gitInstance.Revert([]string{"HEAD"})
 	require.NoError(t, err)
+	assert.True(t, result.Success)
+	require.Len(t, result.Applied, 1)
+	assert.Equal(t, logs[0].Commit, result.Applied[0].Source)
 	
 	// Verify revert commit was created
 	logs, err = gitInstance.Log(git.LogWithMaxCount("2"))
@@ -79,7 +82,7 @@ func TestRebaseCommand(t *testing.T) {
 	require.NoError(t, err)
 	
 	// Test rebase onto main - this tests the interface
-	err = gitInstance.Rebase(git.WithArgs("main"))
+	_, err = gitInstance.Rebase(git.WithArgs("main"))
 	// Rebase may succeed or fail depending on conflicts, but shouldn't panic
 	// The value is testing the interface exists and handles various outcomes
 }
@@ -102,9 +105,12 @@ func TestReflogCommand(t *testing.T) {
 		require.NoError(t, err)
 	}
 	
-	// Test reflog - tests interface
-	err = gitInstance.Reflog()
+	// Initial commit plus three more
+	entries, err := gitInstance.Reflog("")
 	require.NoError(t, err, "Reflog should not error on valid repository")
+	require.Len(t, entries, 4)
+	assert.Equal(t, "commit", entries[0].Action)
+	assert.Equal(t, "Reflog commit 3", entries[0].Message)
 }
 
 // Test Remove command - test removing files from Git
@@ -177,13 +183,13 @@ func TestAdvancedCommandErrors(t *testing.T) {
 	gitInstance.SetWorkingDirectory(tempDir)
 	
 	// These should fail gracefully on non-git directory
-	err = gitInstance.Revert()
+	_, err = gitInstance.Revert(nil)
 	assert.Error(t, err, "Revert should fail on non-git directory")
 	
-	err = gitInstance.Rebase()
+	_, err = gitInstance.Rebase()
 	assert.Error(t, err, "Rebase should fail on non-git directory")
 	
-	err = gitInstance.Reflog()
+	_, err = gitInstance.Reflog("")
 	assert.Error(t, err, "Reflog should fail on non-git directory")
 	
 	err = gitInstance.Remove()
@@ -0,0 +1,16 @@
+package git
+
+import (
+	"io"
+)
+
+// Archive writes an archive of treeish to w. The archive is streamed from
+// git as it is produced rather than held in memory. Large archives may need
+// a longer WithTimeout than the default
+func (g *gitImpl) Archive(treeish string, w io.Writer, opts ...Option) error {
+	// Options are parsed anywhere on the command line, which lets
+	// ArchiveWithPaths add paths after the tree-ish
+	cmd := g.newCommand("archive", treeish)
+	cmd.ApplyOptions(opts...)
+	return cmd.ExecuteTo(w)
+}
@@ -0,0 +1,112 @@
+package git_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	stderrors "errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readTar returns the regular files of a tar archive with their content
+func readTar(t *testing.T, r io.Reader) map[string]string {
+	files := make(map[string]string)
+	reader := tar.NewReader(r)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+}
+
+// setupArchivable commits README.md, src/main.go and docs/guide.md
+func setupArchivable(t *testing.T) (string, git.Git) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	for _, file := range []string{"src/main.go", "docs/guide.md"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, filepath.Dir(file)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, file), []byte(file+"\n"), 0644))
+	}
+	require.NoError(t, gitInstance.Add([]string{"."}))
+	require.NoError(t, gitInstance.Commit("Add sources"))
+	return tempDir, gitInstance
+}
+
+// Test tar archives with a prefix, path filters and untracked files
+func TestArchiveTar(t *testing.T) {
+	tempDir, gitInstance := setupArchivable(t)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "build.txt"), []byte("untracked\n"), 0644))
+
+	var archive bytes.Buffer
+	err := gitInstance.Archive("HEAD", &archive,
+		git.ArchiveWithPrefix("project/"),
+		git.ArchiveWithPaths("src", "README.md"),
+		git.ArchiveWithAddFile("build.txt"),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"project/README.md":   "# Test Repo",
+		"project/src/main.go": "src/main.go\n",
+		"project/build.txt":   "untracked\n",
+	}, readTar(t, &archive))
+}
+
+// Test compressed formats, virtual files and archives of remote repositories
+func TestArchiveFormats(t *testing.T) {
+	tempDir, gitInstance := setupArchivable(t)
+
+	var archive bytes.Buffer
+	require.NoError(t, gitInstance.Archive("HEAD", &archive,
+		git.ArchiveWithFormat(types.ArchiveFormatTarGz),
+		git.ArchiveWithAddVirtualFile("VERSION", "1.0.0\n"),
+	))
+	gz, err := gzip.NewReader(&archive)
+	require.NoError(t, err)
+	files := readTar(t, gz)
+	assert.Len(t, files, 4)
+	assert.Equal(t, "1.0.0\n", files["VERSION"])
+
+	archive.Reset()
+	require.NoError(t, gitInstance.Archive("HEAD:docs", &archive,
+		git.ArchiveWithFormat(types.ArchiveFormatZip),
+		git.ArchiveWithCompressionLevel(9),
+	))
+	zipReader, err := zip.NewReader(bytes.NewReader(archive.Bytes()), int64(archive.Len()))
+	require.NoError(t, err)
+	require.Len(t, zipReader.File, 1)
+	assert.Equal(t, "guide.md", zipReader.File[0].Name)
+
+	other, err := git.NewGit()
+	require.NoError(t, err)
+	other.SetWorkingDirectory(t.TempDir())
+	archive.Reset()
+	require.NoError(t, other.Archive("main", &archive, git.ArchiveWithRemote(tempDir)))
+	assert.Contains(t, readTar(t, &archive), "docs/guide.md")
+
+	err = gitInstance.Archive("missing", io.Discard)
+	var gitErr *errors.GitError
+	require.True(t, stderrors.As(err, &gitErr), "expected GitError, got %T", err)
+	assert.Contains(t, gitErr.Stderr, "not a valid object name")
+}
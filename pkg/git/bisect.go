@@ -0,0 +1,266 @@
+package git
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+var (
+	// "Bisecting: 3 revisions left to test after this (roughly 2 steps)"
+	bisectProgressPattern = regexp.MustCompile(`(?m)^Bisecting: (\d+) revisions? left to test after this \(roughly (\d+) steps?\)\n\[([0-9a-f]+)\]`)
+	// "# good: [<sha>] <subject>", written for every marked commit
+	bisectMarkPattern = regexp.MustCompile(`^# (\S+): \[([0-9a-f]+)\] ?(.*)$`)
+	// "# first bad commit: [<sha>] <subject>" and "# possible first bad commit: ..."
+	bisectFirstPattern = regexp.MustCompile(`^# (possible )?first \S+ commit: \[([0-9a-f]+)\]`)
+	// Quoted arguments of the "git bisect start" line
+	bisectArgPattern = regexp.MustCompile(`'((?:[^']|'\\'')*)'`)
+)
+
+// bisectTerms are the words used to mark commits in a bisect session
+type bisectTerms struct {
+	good string
+	bad  string
+}
+
+// BisectStart starts a bisect session. bad and good may be empty and marked
+// later with BisectBad and BisectGood
+func (g *gitImpl) BisectStart(bad string, good []string, opts ...Option) (*types.BisectResult, error) {
+	cmd := g.newCommand("bisect", "start")
+	if bad != "" {
+		cmd.AddArgs(bad)
+	}
+	cmd.AddArgs(good...)
+	cmd.ApplyOptions(opts...)
+	return g.runBisect(cmd)
+}
+
+// BisectGood marks commits as good (the old state), the current commit when none are given
+func (g *gitImpl) BisectGood(revs ...string) (*types.BisectResult, error) {
+	return g.bisectMark(types.BisectVerdictGood, revs)
+}
+
+// BisectBad marks commits as bad (the new state), the current commit when none are given
+func (g *gitImpl) BisectBad(revs ...string) (*types.BisectResult, error) {
+	return g.bisectMark(types.BisectVerdictBad, revs)
+}
+
+// BisectSkip marks commits as untestable, the current commit when none are given
+func (g *gitImpl) BisectSkip(revs ...string) (*types.BisectResult, error) {
+	return g.bisectMark(types.BisectVerdictSkip, revs)
+}
+
+// BisectStatus reports the progress of the bisect session. RevisionsLeft and
+// StepsLeft are only known right after a command that selects a commit
+func (g *gitImpl) BisectStatus() (*types.BisectResult, error) {
+	log, err := g.BisectLog()
+	if err != nil {
+		return nil, err
+	}
+
+	result, waiting := parseBisectLog(log)
+	if !result.Done && !waiting {
+		result.Current = g.revParse("BISECT_HEAD")
+		if result.Current == "" {
+			result.Current = g.revParse("HEAD")
+		}
+	}
+	return result, nil
+}
+
+// BisectRun runs a shell command on each commit to test until the first bad
+// commit is found. The command exits 0 for good, 125 to skip and any other
+// code below 128 for bad. A session can take long, so it has no timeout
+// unless one is given with WithTimeout
+func (g *gitImpl) BisectRun(command string, opts ...Option) (*types.BisectResult, error) {
+	cmd := g.newCommand("bisect", "run", "sh", "-c", command)
+	cmd.SetTimeout(0)
+	cmd.ApplyOptions(opts...)
+	return g.runBisect(cmd)
+}
+
+// BisectRunFunc calls test for each commit to test and marks it with the
+// returned verdict until the first bad commit is found. The commit is checked
+// out unless the session started with BisectWithNoCheckout. An error from test
+// stops the session where it is
+func (g *gitImpl) BisectRunFunc(test func(commit string) (types.BisectVerdict, error)) (*types.BisectResult, error) {
+	result, err := g.BisectStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	for !result.Done {
+		if result.Current == "" {
+			return result, errors.ErrBisectNotReady
+		}
+
+		verdict, err := test(result.Current)
+		if err != nil {
+			return result, err
+		}
+
+		result, err = g.bisectMark(verdict, nil)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// BisectLog returns the log of the bisect session, which BisectReplay can replay
+func (g *gitImpl) BisectLog() (string, error) {
+	cmd := g.newCommand("bisect", "log")
+	output, err := cmd.Execute()
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
+
+// BisectReplay replays a log written by BisectLog. The file is read by git
+func (g *gitImpl) BisectReplay(logFile string) (*types.BisectResult, error) {
+	cmd := g.newCommand("bisect", "replay", logFile)
+	return g.runBisect(cmd)
+}
+
+// BisectReset ends the bisect session and checks out commit, or the branch
+// the session started on when commit is empty
+func (g *gitImpl) BisectReset(commit string) error {
+	cmd := g.newCommand("bisect", "reset")
+	if commit != "" {
+		cmd.AddArgs(commit)
+	}
+	_, err := cmd.Execute()
+	return err
+}
+
+// bisectMark marks commits with the term the session uses for verdict
+func (g *gitImpl) bisectMark(verdict types.BisectVerdict, revs []string) (*types.BisectResult, error) {
+	log, err := g.BisectLog()
+	if err != nil {
+		return nil, err
+	}
+
+	term := string(verdict)
+	terms := parseBisectTerms(log)
+	switch verdict {
+	case types.BisectVerdictGood:
+		term = terms.good
+	case types.BisectVerdictBad:
+		term = terms.bad
+	}
+
+	cmd := g.newCommand("bisect", term)
+	cmd.AddArgs(revs...)
+	return g.runBisect(cmd)
+}
+
+// runBisect executes a bisect command and reports the progress of the session
+func (g *gitImpl) runBisect(cmd Command) (*types.BisectResult, error) {
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+	output, err := cmd.Execute()
+
+	log, logErr := g.BisectLog()
+	if logErr != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, logErr
+	}
+
+	result, _ := parseBisectLog(log)
+	if matches := bisectProgressPattern.FindStringSubmatch(string(output)); matches != nil && !result.Done {
+		result.RevisionsLeft, _ = strconv.Atoi(matches[1])
+		result.StepsLeft, _ = strconv.Atoi(matches[2])
+		result.Current = matches[3]
+	}
+
+	// git exits non-zero when only skipped commits are left
+	if err != nil && !result.Done {
+		return result, err
+	}
+	return result, nil
+}
+
+// parseBisectLog reads the marked commits and the outcome from a bisect log,
+// and whether the session still waits for a good or bad commit
+func parseBisectLog(log string) (*types.BisectResult, bool) {
+	result := &types.BisectResult{
+		Tested: []types.BisectStep{},
+	}
+	terms := parseBisectTerms(log)
+	waiting := false
+
+	for _, line := range strings.Split(log, "\n") {
+		if !strings.HasPrefix(line, "#") {
+			continue
+		}
+		waiting = strings.HasPrefix(line, "# status: waiting")
+		if line == "# only skipped commits left to test" {
+			result.Candidates = nil
+		}
+
+		if matches := bisectFirstPattern.FindStringSubmatch(line); matches != nil {
+			result.Done = true
+			if matches[1] == "" {
+				result.FirstBad = matches[2]
+			} else {
+				result.Candidates = append(result.Candidates, matches[2])
+			}
+			continue
+		}
+
+		matches := bisectMarkPattern.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+		step := types.BisectStep{Commit: matches[2], Subject: matches[3]}
+		switch matches[1] {
+		case terms.good:
+			step.Verdict = types.BisectVerdictGood
+		case terms.bad:
+			step.Verdict = types.BisectVerdictBad
+		case "skip":
+			step.Verdict = types.BisectVerdictSkip
+		default:
+			continue
+		}
+		result.Tested = append(result.Tested, step)
+	}
+
+	return result, waiting
+}
+
+// parseBisectTerms reads custom terms from the "git bisect start" line of a log
+func parseBisectTerms(log string) bisectTerms {
+	terms := bisectTerms{good: "good", bad: "bad"}
+
+	for _, line := range strings.Split(log, "\n") {
+		if !strings.HasPrefix(line, "git bisect start") {
+			continue
+		}
+
+		var args []string
+		for _, match := range bisectArgPattern.FindAllStringSubmatch(line, -1) {
+			args = append(args, strings.ReplaceAll(match[1], `'\''`, "'"))
+		}
+		for i, arg := range args {
+			name, value, found := strings.Cut(arg, "=")
+			if !found && i+1 < len(args) {
+				value = args[i+1]
+			}
+			switch name {
+			case "--term-old", "--term-good":
+				terms.good = value
+			case "--term-new", "--term-bad":
+				terms.bad = value
+			}
+		}
+	}
+
+	return terms
+}
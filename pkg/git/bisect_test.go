@@ -0,0 +1,185 @@
+package git_test
+
+import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBisectable commits version 1 to 8 of version.txt and returns the
+// commits, oldest first. Versions from 5 on are bad
+func setupBisectable(t *testing.T) (string, git.Git, []string) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	for i := 1; i <= 8; i++ {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "version.txt"), []byte(strconv.Itoa(i)+"\n"), 0644))
+		require.NoError(t, gitInstance.Add([]string{"version.txt"}))
+		require.NoError(t, gitInstance.Commit(fmt.Sprintf("Version %d", i)))
+	}
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("8"))
+	require.NoError(t, err)
+	commits := make([]string, len(logs))
+	for i, log := range logs {
+		commits[len(logs)-1-i] = log.Commit
+	}
+	return tempDir, gitInstance, commits
+}
+
+// readVersion reads the version checked out in the working tree
+func readVersion(t *testing.T, dir string) int {
+	content, err := os.ReadFile(filepath.Join(dir, "version.txt"))
+	require.NoError(t, err)
+	version, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	require.NoError(t, err)
+	return version
+}
+
+// Test marking commits by hand until the first bad commit is found
+func TestBisectManual(t *testing.T) {
+	tempDir, gitInstance, commits := setupBisectable(t)
+
+	result, err := gitInstance.BisectStart(commits[7], []string{commits[0]})
+	require.NoError(t, err)
+	assert.False(t, result.Done)
+	assert.Equal(t, commits[3], result.Current)
+	assert.Equal(t, 3, result.RevisionsLeft)
+	assert.Equal(t, 2, result.StepsLeft)
+	require.Len(t, result.Tested, 2)
+	assert.Equal(t, types.BisectStep{Commit: commits[7], Subject: "Version 8", Verdict: types.BisectVerdictBad}, result.Tested[0])
+	assert.Equal(t, types.BisectVerdictGood, result.Tested[1].Verdict)
+
+	for !result.Done {
+		switch version := readVersion(t, tempDir); {
+		case version == 6:
+			result, err = gitInstance.BisectSkip()
+		case version >= 5:
+			result, err = gitInstance.BisectBad()
+		default:
+			result, err = gitInstance.BisectGood()
+		}
+		require.NoError(t, err)
+	}
+	assert.Equal(t, commits[4], result.FirstBad)
+	assert.Empty(t, result.Current)
+	assert.Contains(t, result.Tested, types.BisectStep{Commit: commits[5], Subject: "Version 6", Verdict: types.BisectVerdictSkip})
+
+	status, err := gitInstance.BisectStatus()
+	require.NoError(t, err)
+	assert.Equal(t, result.FirstBad, status.FirstBad)
+	assert.Equal(t, result.Tested, status.Tested)
+
+	// Replay the session after resetting
+	log, err := gitInstance.BisectLog()
+	require.NoError(t, err)
+	logFile := filepath.Join(t.TempDir(), "bisect.log")
+	require.NoError(t, os.WriteFile(logFile, []byte(log), 0644))
+
+	require.NoError(t, gitInstance.BisectReset(""))
+	assert.Equal(t, 8, readVersion(t, tempDir))
+	_, err = gitInstance.BisectStatus()
+	assert.Error(t, err)
+
+	result, err = gitInstance.BisectReplay(logFile)
+	require.NoError(t, err)
+	assert.Equal(t, commits[4], result.FirstBad)
+	require.NoError(t, gitInstance.BisectReset(""))
+}
+
+// Test bisecting with a shell command
+func TestBisectRun(t *testing.T) {
+	_, gitInstance, commits := setupBisectable(t)
+
+	_, err := gitInstance.BisectStart(commits[7], []string{commits[0]})
+	require.NoError(t, err)
+	state, err := gitInstance.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationBisect, state.Operation)
+	assert.Equal(t, "main", state.HeadName)
+
+	result, err := gitInstance.BisectRun(`test "$(cat version.txt)" -lt "$LIMIT"`, git.WithEnv("LIMIT", "5"))
+	require.NoError(t, err)
+	assert.True(t, result.Done)
+	assert.Equal(t, commits[4], result.FirstBad)
+	require.NoError(t, gitInstance.BisectReset(""))
+}
+
+// Test bisecting with a Go callback, custom terms and without checkouts
+func TestBisectRunFunc(t *testing.T) {
+	tempDir, gitInstance, commits := setupBisectable(t)
+	index := make(map[string]int)
+	for i, commit := range commits {
+		index[commit] = i + 1
+	}
+
+	result, err := gitInstance.BisectStart("", nil, git.BisectWithTerms("fast", "slow"), git.BisectWithNoCheckout())
+	require.NoError(t, err)
+	assert.Empty(t, result.Current)
+
+	// Testing cannot start before both terms are known
+	_, err = gitInstance.BisectRunFunc(func(string) (types.BisectVerdict, error) {
+		return types.BisectVerdictGood, nil
+	})
+	assert.True(t, stderrors.Is(err, errors.ErrBisectNotReady))
+
+	_, err = gitInstance.BisectBad(commits[7])
+	require.NoError(t, err)
+	_, err = gitInstance.BisectGood(commits[0])
+	require.NoError(t, err)
+
+	var tested []int
+	result, err = gitInstance.BisectRunFunc(func(commit string) (types.BisectVerdict, error) {
+		version := index[commit]
+		tested = append(tested, version)
+		if version >= 3 {
+			return types.BisectVerdictBad, nil
+		}
+		return types.BisectVerdictGood, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, commits[2], result.FirstBad)
+	assert.NotEmpty(t, tested)
+	assert.Len(t, result.Tested, len(tested)+2)
+
+	// The working tree was never touched
+	assert.Equal(t, 8, readVersion(t, tempDir))
+
+	// Errors from the callback stop the session
+	require.NoError(t, gitInstance.BisectReset(""))
+	_, err = gitInstance.BisectStart(commits[7], []string{commits[0]})
+	require.NoError(t, err)
+	failure := stderrors.New("test failed to run")
+	result, err = gitInstance.BisectRunFunc(func(string) (types.BisectVerdict, error) {
+		return "", failure
+	})
+	assert.Equal(t, failure, err)
+	assert.Equal(t, commits[3], result.Current)
+	require.NoError(t, gitInstance.BisectReset(""))
+}
+
+// Test sessions where only skipped commits are left
+func TestBisectOnlySkipped(t *testing.T) {
+	_, gitInstance, commits := setupBisectable(t)
+
+	_, err := gitInstance.BisectStart(commits[7], []string{commits[5]})
+	require.NoError(t, err)
+	result, err := gitInstance.BisectSkip()
+	require.NoError(t, err)
+	assert.True(t, result.Done)
+	assert.Empty(t, result.FirstBad)
+	assert.ElementsMatch(t, []string{commits[6], commits[7]}, result.Candidates)
+	require.NoError(t, gitInstance.BisectReset(""))
+}
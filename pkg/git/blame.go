@@ -0,0 +1,123 @@
+package git
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// Blame attributes each line of path to the commit that last changed it.
+// Lines are returned in file order
+func (g *gitImpl) Blame(path string, opts ...Option) ([]types.BlameLine, error) {
+	cmd := g.newCommand("blame", "--line-porcelain")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs("--", path)
+
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBlamePorcelain(string(output)), nil
+}
+
+// parseBlamePorcelain parses the porcelain, line porcelain and incremental
+// blame formats. Each entry starts with "<sha> <orig> <final> [<count>]",
+// followed by commit headers (only on the first entry of a commit unless
+// line porcelain is used) and ends with "filename". Porcelain formats follow
+// it with the tab-prefixed content of one line, incremental entries cover
+// <count> lines without content
+func parseBlamePorcelain(output string) []types.BlameLine {
+	lines := []types.BlameLine{}
+	commits := make(map[string]*types.BlameLine)
+
+	var commit *types.BlameLine // Commit of the entry being parsed, nil between entries
+	origLine, finalLine, count := 0, 0, 0
+
+	// addLines records count lines of the current entry
+	addLines := func(count int, content string) {
+		for i := 0; i < count; i++ {
+			line := *commit
+			line.OrigLine = origLine + i
+			line.FinalLine = finalLine + i
+			line.Content = content
+			lines = append(lines, line)
+		}
+		commit = nil
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "\t") {
+			if commit != nil {
+				addLines(1, line[1:])
+			}
+			continue
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+		if commit == nil || len(key) >= 40 {
+			// An entry without content ends at the next one
+			if commit != nil {
+				addLines(count, "")
+			}
+
+			// "<sha> <orig> <final> [<count>]" starts a new entry
+			fields := strings.Fields(line)
+			if len(fields) < 3 {
+				continue
+			}
+			var found bool
+			if commit, found = commits[fields[0]]; !found {
+				commit = &types.BlameLine{Commit: fields[0]}
+				commits[fields[0]] = commit
+			}
+			origLine, _ = strconv.Atoi(fields[1])
+			finalLine, _ = strconv.Atoi(fields[2])
+			count = 1
+			if len(fields) > 3 {
+				count, _ = strconv.Atoi(fields[3])
+			}
+			continue
+		}
+
+		switch key {
+		case "author":
+			commit.Author = value
+		case "author-mail":
+			commit.AuthorEmail = strings.Trim(value, "<>")
+		case "author-time":
+			commit.AuthorTime = parseRawDate(value)
+		case "author-tz":
+			commit.AuthorTime = parseRawDate(strconv.FormatInt(commit.AuthorTime.Unix(), 10) + " " + value)
+		case "committer":
+			commit.Committer = value
+		case "committer-mail":
+			commit.CommitterEmail = strings.Trim(value, "<>")
+		case "committer-time":
+			commit.CommitterTime = parseRawDate(value)
+		case "committer-tz":
+			commit.CommitterTime = parseRawDate(strconv.FormatInt(commit.CommitterTime.Unix(), 10) + " " + value)
+		case "summary":
+			commit.Summary = value
+		case "boundary":
+			commit.Boundary = true
+		case "previous":
+			previous, path, _ := strings.Cut(value, " ")
+			commit.Previous, commit.PreviousPath = previous, unquoteDiffPath(path)
+		case "filename":
+			// Paths are C-quoted like in diffs
+			commit.OrigPath = unquoteDiffPath(value)
+		}
+	}
+	if commit != nil {
+		addLines(count, "")
+	}
+
+	// Incremental output is in the order git found the lines
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].FinalLine < lines[j].FinalLine
+	})
+	return lines
+}
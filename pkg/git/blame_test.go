@@ -0,0 +1,191 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBlame commits three versions of code.txt and returns the commits,
+// oldest first
+func setupBlame(t *testing.T) (string, git.Git, []string) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	versions := []string{
+		"one\ntwo\nthree\n",
+		"one\nTWO\nthree\nfour\n",
+		"one\nTWO\nthree\nfour\nfive\n",
+	}
+	for i, content := range versions {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, "code.txt"), []byte(content), 0644))
+		require.NoError(t, gitInstance.Add([]string{"code.txt"}))
+		require.NoError(t, gitInstance.Commit([]string{"First", "Second", "Third"}[i]))
+	}
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("4"))
+	require.NoError(t, err)
+	return tempDir, gitInstance, []string{logs[2].Commit, logs[1].Commit, logs[0].Commit}
+}
+
+// Test per-line commits, identities and previous commits
+func TestBlame(t *testing.T) {
+	tempDir, gitInstance, commits := setupBlame(t)
+
+	lines, err := gitInstance.Blame("code.txt")
+	require.NoError(t, err)
+	require.Len(t, lines, 5)
+
+	expected := []struct {
+		commit  string
+		content string
+	}{
+		{commits[0], "one"},
+		{commits[1], "TWO"},
+		{commits[0], "three"},
+		{commits[1], "four"},
+		{commits[2], "five"},
+	}
+	for i, line := range lines {
+		assert.Equal(t, expected[i].commit, line.Commit, "line %d", i+1)
+		assert.Equal(t, expected[i].content, line.Content, "line %d", i+1)
+		assert.Equal(t, i+1, line.FinalLine)
+		assert.Equal(t, "code.txt", line.OrigPath)
+		assert.Equal(t, "Test User", line.Author)
+		assert.Equal(t, "test@example.com", line.AuthorEmail)
+		assert.False(t, line.AuthorTime.IsZero())
+		assert.Equal(t, "test@example.com", line.CommitterEmail)
+	}
+	assert.Equal(t, "First", lines[2].Summary)
+	assert.Equal(t, 3, lines[2].OrigLine)
+	assert.Equal(t, "Second", lines[1].Summary)
+	assert.Equal(t, commits[0], lines[1].Previous)
+	assert.Equal(t, "code.txt", lines[1].PreviousPath)
+	assert.False(t, lines[1].Boundary)
+
+	// Uncommitted lines belong to no commit yet
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "code.txt"), []byte("one\nTWO\nthree\nfour\nfive\nsix\n"), 0644))
+	lines, err = gitInstance.Blame("code.txt")
+	require.NoError(t, err)
+	require.Len(t, lines, 6)
+	assert.Equal(t, "0000000000000000000000000000000000000000", lines[5].Commit)
+	assert.Equal(t, "Not Committed Yet", lines[5].Author)
+}
+
+// Test paths with spaces and non-ASCII characters, which git quotes
+func TestBlameQuotedPaths(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	name := "my file é.txt"
+	for _, content := range []string{"one\n", "one\ntwo\n"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644))
+		require.NoError(t, gitInstance.Add([]string{name}))
+		require.NoError(t, gitInstance.Commit("Change "+name))
+	}
+
+	lines, err := gitInstance.Blame(name)
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, name, lines[0].OrigPath)
+	assert.Equal(t, name, lines[1].OrigPath)
+	assert.Equal(t, name, lines[1].PreviousPath)
+
+	lines, err = gitInstance.Blame(name, git.BlameWithIncremental())
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, name, lines[1].OrigPath)
+}
+
+// Test line ranges, revisions and incremental output
+func TestBlameOptions(t *testing.T) {
+	_, gitInstance, commits := setupBlame(t)
+
+	lines, err := gitInstance.Blame("code.txt", git.BlameWithLines(2, 3))
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "TWO", lines[0].Content)
+	assert.Equal(t, 2, lines[0].FinalLine)
+
+	lines, err = gitInstance.Blame("code.txt", git.BlameWithRevision(commits[0]), git.BlameWithLineRange("/two/,+1"))
+	require.NoError(t, err)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "two", lines[0].Content)
+	assert.Equal(t, commits[0], lines[0].Commit)
+
+	// Lines older than the range belong to its boundary
+	lines, err = gitInstance.Blame("code.txt", git.BlameWithRevision(commits[1]+".."))
+	require.NoError(t, err)
+	require.Len(t, lines, 5)
+	assert.True(t, lines[0].Boundary)
+	assert.Equal(t, commits[1], lines[0].Commit)
+	assert.False(t, lines[4].Boundary)
+
+	full, err := gitInstance.Blame("code.txt")
+	require.NoError(t, err)
+	incremental, err := gitInstance.Blame("code.txt", git.BlameWithIncremental())
+	require.NoError(t, err)
+	require.Len(t, incremental, len(full))
+	for i := range full {
+		assert.Empty(t, incremental[i].Content)
+		incremental[i].Content = full[i].Content
+		assert.Equal(t, full[i], incremental[i])
+	}
+}
+
+// Test following moved lines and ignoring revisions
+func TestBlameDetection(t *testing.T) {
+	tempDir, gitInstance, commits := setupBlame(t)
+
+	// Move two long lines to a new file
+	moved := "a line long enough for copy detection to notice it\nanother line that is long enough to be detected\n"
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "source.txt"), []byte("header\n"+moved), 0644))
+	require.NoError(t, gitInstance.Add([]string{"source.txt"}))
+	require.NoError(t, gitInstance.Commit("Add source"))
+	logs, err := gitInstance.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	source := logs[0].Commit
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "source.txt"), []byte("header\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "moved.txt"), []byte(moved), 0644))
+	require.NoError(t, gitInstance.Add([]string{"."}))
+	require.NoError(t, gitInstance.Commit("Move lines"))
+
+	// Reformat the file
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "code.txt"), []byte("one  \nTWO  \nthree  \n"), 0644))
+	require.NoError(t, gitInstance.Commit("Reformat", git.CommitWithAll()))
+	logs, err = gitInstance.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	reformat := logs[0].Commit
+
+	lines, err := gitInstance.Blame("code.txt")
+	require.NoError(t, err)
+	assert.Equal(t, reformat, lines[0].Commit)
+
+	lines, err = gitInstance.Blame("code.txt", git.BlameWithIgnoreRev(reformat))
+	require.NoError(t, err)
+	assert.Equal(t, commits[0], lines[0].Commit)
+	assert.Equal(t, commits[1], lines[1].Commit)
+
+	ignoreFile := filepath.Join(tempDir, ".git-blame-ignore-revs")
+	require.NoError(t, os.WriteFile(ignoreFile, []byte(reformat+"\n"), 0644))
+	lines, err = gitInstance.Blame("code.txt", git.BlameWithIgnoreRevsFile(ignoreFile))
+	require.NoError(t, err)
+	assert.Equal(t, commits[0], lines[0].Commit)
+
+	lines, err = gitInstance.Blame("moved.txt", git.BlameWithDetectCopies())
+	require.NoError(t, err)
+	require.Len(t, lines, 2)
+	assert.Equal(t, source, lines[0].Commit)
+	assert.Equal(t, "source.txt", lines[0].OrigPath)
+	assert.Equal(t, 2, lines[0].OrigLine)
+	assert.Equal(t, 1, lines[0].FinalLine)
+}
@@ -1,60 +1,236 @@
 package git
 
 import (
+	stderrors "errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/instruqt/git-exec/pkg/git/errors"
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
-// ListBranches lists all branches in the repository
+// Ref prefixes of local and remote-tracking branches, also used by the
+// ListBranches options to select which branches are listed
+const (
+	localBranchPrefix  = "refs/heads/"
+	remoteBranchPrefix = "refs/remotes/"
+)
+
+// branchPatternArg marks a short name pattern added by ListBranchesWithPattern,
+// as in "--branch-pattern=feature/*". branchRefArgs turns it into a ref pattern
+const branchPatternArg = "--branch-pattern="
+
+// branchFields are the for-each-ref fields of a branch, separated by NUL
+var branchFields = []string{
+	"%(refname)",
+	"%(HEAD)",
+	"%(objectname)",
+	"%(symref)",
+	"%(upstream)",
+	"%(upstream:track,nobracket)",
+	"%(contents:subject)",
+	"%(committerdate:iso-strict)",
+	"%(worktreepath)",
+}
+
+// ListBranches lists local branches, or remote-tracking branches with
+// ListBranchesWithRemotes or ListBranchesWithAll, with their commit,
+// upstream tracking information and the worktree they are checked out in
 func (g *gitImpl) ListBranches(opts ...Option) ([]types.Branch, error) {
-	cmd := g.newCommand("branch")
+	cmd := g.newCommand("for-each-ref", "--format="+strings.Join(branchFields, "%00"))
+
+	// Apply all provided options
 	cmd.ApplyOptions(opts...)
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+	cmd.SetArgs(branchRefArgs(cmd.GetArgs()))
+
 	output, err := cmd.Execute()
 	if err != nil {
 		return nil, err
 	}
-	
+
 	branches := []types.Branch{}
-	lines := strings.Split(string(output), "\n")
-	for _, line := range lines {
-		if line == "" {
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Split(line, "\x00")
+		if len(fields) < len(branchFields) {
 			continue
 		}
-		active := false
-		name := line
-		if strings.HasPrefix(line, "* ") {
-			active = true
-			name = line[2:]
-		} else if strings.HasPrefix(line, "  ") {
-			name = line[2:]
-		}
-		branches = append(branches, types.Branch{Name: strings.TrimSpace(name), Active: active})
+		branches = append(branches, parseBranch(fields))
 	}
 	return branches, nil
 }
 
-// CreateBranch creates a new branch
+// branchRefArgs turns the ref prefixes and short name patterns added by the
+// ListBranches options into for-each-ref patterns, listing local branches
+// when no prefix was given. Other arguments are passed on unchanged
+func branchRefArgs(args []string) []string {
+	sub := subcommandArgs(args)
+	result := append([]string{}, args[:len(args)-len(sub)]...)
+
+	var prefixes, patterns []string
+	for _, arg := range sub {
+		switch {
+		case arg == localBranchPrefix || arg == remoteBranchPrefix:
+			prefixes = append(prefixes, arg)
+		case strings.HasPrefix(arg, branchPatternArg):
+			patterns = append(patterns, strings.TrimPrefix(arg, branchPatternArg))
+		default:
+			result = append(result, arg)
+		}
+	}
+
+	if len(prefixes) == 0 {
+		prefixes = []string{localBranchPrefix}
+	}
+	if len(patterns) == 0 {
+		return append(result, prefixes...)
+	}
+	for _, prefix := range prefixes {
+		for _, pattern := range patterns {
+			result = append(result, prefix+pattern)
+		}
+	}
+	return result
+}
+
+// parseBranch parses the fields of one for-each-ref line
+func parseBranch(fields []string) types.Branch {
+	branch := types.Branch{
+		Name:         shortBranchName(fields[0]),
+		Ref:          fields[0],
+		Active:       fields[1] == "*",
+		Remote:       strings.HasPrefix(fields[0], remoteBranchPrefix),
+		Commit:       fields[2],
+		SymbolicRef:  shortBranchName(fields[3]),
+		Upstream:     shortBranchName(fields[4]),
+		Subject:      fields[6],
+		WorktreePath: fields[8],
+	}
+
+	// "gone", or "ahead N", "behind N" or both separated by a comma
+	if fields[5] == "gone" {
+		branch.UpstreamGone = true
+	} else if fields[5] != "" {
+		for _, part := range strings.Split(fields[5], ", ") {
+			direction, count, _ := strings.Cut(part, " ")
+			switch direction {
+			case "ahead":
+				branch.Ahead, _ = strconv.Atoi(count)
+			case "behind":
+				branch.Behind, _ = strconv.Atoi(count)
+			}
+		}
+	}
+
+	if date, err := time.Parse(time.RFC3339, fields[7]); err == nil {
+		branch.CommitDate = date
+	}
+	return branch
+}
+
+// shortBranchName strips the refs/heads/ or refs/remotes/ prefix of a ref
+func shortBranchName(ref string) string {
+	if strings.HasPrefix(ref, localBranchPrefix) {
+		return strings.TrimPrefix(ref, localBranchPrefix)
+	}
+	return strings.TrimPrefix(ref, remoteBranchPrefix)
+}
+
+// CreateBranch creates a new branch at HEAD, or at the start point given
+// with CreateBranchWithStartPoint. Overwriting a branch checked out in a
+// worktree with CreateBranchWithForce returns an errors.BranchCheckedOutError
 func (g *gitImpl) CreateBranch(branch string, opts ...Option) error {
 	cmd := g.newCommand("branch", branch)
 	cmd.ApplyOptions(opts...)
-	_, err := cmd.Execute()
-	return err
+	return executeBranch(cmd)
 }
 
-// DeleteBranch deletes a branch
+// DeleteBranch deletes a branch. A branch that is not fully merged returns an
+// errors.BranchNotMergedError unless DeleteBranchWithForce is given, and a
+// branch checked out in a worktree returns an errors.BranchCheckedOutError
 func (g *gitImpl) DeleteBranch(branch string, opts ...Option) error {
-	cmd := g.newCommand("branch", "-d", branch)
+	cmd := g.newCommand("branch", "--delete", branch)
 	cmd.ApplyOptions(opts...)
-	_, err := cmd.Execute()
-	return err
+	return executeBranch(cmd)
+}
+
+// DeleteRemoteBranch deletes a branch on the remote, along with its
+// remote-tracking branch, and returns the push result for the ref. Deleting a
+// branch the remote does not have fails
+func (g *gitImpl) DeleteRemoteBranch(remote, branch string, opts ...Option) ([]types.Remote, error) {
+	cmd := g.newCommand("push", "--porcelain", "--delete", remote, branch)
+	cmd.ApplyOptions(opts...)
+	return g.executePush(cmd)
 }
 
-// SetUpstream sets the upstream branch for tracking
+// RenameBranch renames a branch along with its reflog and configuration,
+// updating the worktrees it is checked out in
+func (g *gitImpl) RenameBranch(oldName, newName string, opts ...Option) error {
+	cmd := g.newCommand("branch", "--move", oldName, newName)
+	cmd.ApplyOptions(opts...)
+	return executeBranch(cmd)
+}
+
+// CopyBranch copies a branch along with its reflog and configuration
+func (g *gitImpl) CopyBranch(source, destination string, opts ...Option) error {
+	cmd := g.newCommand("branch", "--copy", source, destination)
+	cmd.ApplyOptions(opts...)
+	return executeBranch(cmd)
+}
+
+// SetUpstream sets the upstream branch for tracking to the branch of the
+// same name on remote
 func (g *gitImpl) SetUpstream(branch string, remote string, opts ...Option) error {
-	upstreamRef := fmt.Sprintf("%s/%s", remote, branch)
-	cmd := g.newCommand("branch", "--set-upstream-to", upstreamRef, branch)
+	return g.SetUpstreamTo(branch, fmt.Sprintf("%s/%s", remote, branch), opts...)
+}
+
+// SetUpstreamTo sets the upstream of branch to any local or remote-tracking
+// branch, such as "origin/main" or "develop"
+func (g *gitImpl) SetUpstreamTo(branch, upstream string, opts ...Option) error {
+	cmd := g.newCommand("branch", "--set-upstream-to="+upstream, branch)
+	cmd.ApplyOptions(opts...)
+	return executeBranch(cmd)
+}
+
+// UnsetUpstream removes the upstream information of branch
+func (g *gitImpl) UnsetUpstream(branch string, opts ...Option) error {
+	cmd := g.newCommand("branch", "--unset-upstream", branch)
 	cmd.ApplyOptions(opts...)
+	return executeBranch(cmd)
+}
+
+// branchCheckedOutPattern matches the branch and worktree in errors about
+// branches checked out in a worktree
+var branchCheckedOutPattern = regexp.MustCompile(`(?i)branch '([^']+)' (?:checked out|used by worktree) at '([^']+)'`)
+
+// branchNotMergedPattern matches the branch in "not fully merged" errors
+var branchNotMergedPattern = regexp.MustCompile(`branch '([^']+)' is not fully merged`)
+
+// executeBranch runs a git branch command, returning typed errors for
+// branches that are not merged or are checked out in a worktree
+func executeBranch(cmd Command) error {
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
 	_, err := cmd.Execute()
+
+	var gitErr *errors.GitError
+	if !stderrors.As(err, &gitErr) {
+		return err
+	}
+	if match := branchNotMergedPattern.FindStringSubmatch(gitErr.Stderr); match != nil {
+		return &errors.BranchNotMergedError{
+			GitError: gitErr,
+			Branch:   match[1],
+		}
+	}
+	if match := branchCheckedOutPattern.FindStringSubmatch(gitErr.Stderr); match != nil {
+		return &errors.BranchCheckedOutError{
+			GitError:     gitErr,
+			Branch:       match[1],
+			WorktreePath: match[2],
+		}
+	}
 	return err
-}
\ No newline at end of file
+}
@@ -0,0 +1,281 @@
+package git_test
+
+import (
+	stderrors "errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// branchesByName indexes branches by short name
+func branchesByName(branches []types.Branch) map[string]types.Branch {
+	result := make(map[string]types.Branch)
+	for _, branch := range branches {
+		result[branch.Name] = branch
+	}
+	return result
+}
+
+// branchNames returns the short names of branches in order
+func branchNames(branches []types.Branch) []string {
+	names := []string{}
+	for _, branch := range branches {
+		names = append(names, branch.Name)
+	}
+	return names
+}
+
+// Test tracking information of local and remote-tracking branches
+func TestListBranchesTracking(t *testing.T) {
+	_, upstreamDir, downstreamDir := setupRemotePair(t)
+	upstream := openTestRepo(t, upstreamDir)
+	downstream := openTestRepo(t, downstreamDir)
+
+	require.NoError(t, upstream.CreateBranch("short-lived"))
+	_, err := upstream.Push(git.PushWithRemote("origin", "short-lived"))
+	require.NoError(t, err)
+	require.NoError(t, upstream.Commit("Upstream commit", git.CommitWithAllowEmpty()))
+	_, err = upstream.Push(git.PushWithRemote("origin", "main"))
+	require.NoError(t, err)
+
+	_, err = downstream.Fetch()
+	require.NoError(t, err)
+	_, err = downstream.Checkout(git.CheckoutWithBranch("short-lived"))
+	require.NoError(t, err)
+	_, err = downstream.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+	require.NoError(t, downstream.Commit("Local commit 1", git.CommitWithAllowEmpty()))
+	require.NoError(t, downstream.Commit("Local commit 2", git.CommitWithAllowEmpty()))
+	require.NoError(t, downstream.CreateBranch("untracked"))
+
+	// Remove the short-lived branch from the remote
+	_, err = upstream.Push(git.PushWithRemote("origin", ":short-lived"))
+	require.NoError(t, err)
+	_, err = downstream.Fetch(git.FetchWithPrune())
+	require.NoError(t, err)
+
+	branches, err := downstream.ListBranches()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"main", "short-lived", "untracked"}, branchNames(branches))
+
+	local := branchesByName(branches)
+	main := local["main"]
+	assert.Equal(t, "refs/heads/main", main.Ref)
+	assert.True(t, main.Active)
+	assert.False(t, main.Remote)
+	assert.Len(t, main.Commit, 40)
+	assert.Equal(t, "origin/main", main.Upstream)
+	assert.Equal(t, 2, main.Ahead)
+	assert.Equal(t, 1, main.Behind)
+	assert.False(t, main.UpstreamGone)
+	assert.Equal(t, "Local commit 2", main.Subject)
+	assert.WithinDuration(t, time.Now(), main.CommitDate, time.Minute)
+	assert.Equal(t, downstreamDir, main.WorktreePath)
+
+	assert.Equal(t, "origin/short-lived", local["short-lived"].Upstream)
+	assert.True(t, local["short-lived"].UpstreamGone)
+	assert.False(t, local["short-lived"].Active)
+	assert.Empty(t, local["untracked"].Upstream)
+	assert.Empty(t, local["untracked"].WorktreePath)
+
+	remotes, err := downstream.ListBranches(git.ListBranchesWithRemotes())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"origin/HEAD", "origin/main"}, branchNames(remotes))
+	assert.True(t, remotes[1].Remote)
+	assert.Equal(t, "Upstream commit", remotes[1].Subject)
+	assert.Equal(t, "origin/main", remotes[0].SymbolicRef)
+
+	all, err := downstream.ListBranches(git.ListBranchesWithAll())
+	require.NoError(t, err)
+	assert.Len(t, all, 5)
+}
+
+// Test worktrees and detached HEADs are not mistaken for branches
+func TestListBranchesWorktrees(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	worktreeDir := filepath.Join(t.TempDir(), "feature")
+	require.NoError(t, gitInstance.WorktreeAdd(worktreeDir, "HEAD", git.WorktreeWithBranch("feature")))
+	branches, err := gitInstance.ListBranches()
+	require.NoError(t, err)
+	_, err = gitInstance.Checkout(git.CheckoutWithCommit(branches[0].Commit))
+	require.NoError(t, err)
+
+	branches, err = gitInstance.ListBranches()
+	require.NoError(t, err)
+	require.Equal(t, []string{"feature", "main"}, branchNames(branches))
+
+	byName := branchesByName(branches)
+	resolved, err := filepath.EvalSymlinks(worktreeDir)
+	require.NoError(t, err)
+	assert.Equal(t, resolved, byName["feature"].WorktreePath)
+	assert.False(t, byName["feature"].Active)
+	assert.False(t, byName["main"].Active)
+	assert.Empty(t, byName["main"].WorktreePath)
+
+	// The worktree sees its own branch as checked out
+	branches, err = gitInstance.WithWorktree(worktreeDir).ListBranches()
+	require.NoError(t, err)
+	assert.True(t, branchesByName(branches)["feature"].Active)
+}
+
+// Test sorting and pattern, merged and contains filters
+func TestListBranchesFilters(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.CreateBranch("feature/merged"))
+	require.NoError(t, gitInstance.CreateBranch("release-1.10"))
+	require.NoError(t, gitInstance.CreateBranch("release-1.9"))
+
+	_, err = gitInstance.Checkout(git.CheckoutWithCreate("feature/open"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "open.txt"), []byte("open\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"open.txt"}))
+	require.NoError(t, gitInstance.Commit("Open work", git.WithEnv("GIT_COMMITTER_DATE", time.Now().Add(time.Hour).Format(time.RFC3339))))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+
+	list := func(opts ...git.Option) []string {
+		branches, err := gitInstance.ListBranches(opts...)
+		require.NoError(t, err)
+		return branchNames(branches)
+	}
+
+	assert.Equal(t, []string{"feature/merged", "feature/open"}, list(git.ListBranchesWithPattern("feature/*")))
+	assert.Equal(t, []string{"feature/merged", "release-1.10", "release-1.9"},
+		list(git.ListBranchesWithPattern("feature/*", "release-*"), git.ListBranchesWithMerged("main"), git.ListBranchesWithSort("refname")))
+	assert.Equal(t, []string{"release-1.9", "release-1.10"}, list(git.ListBranchesWithPattern("release-*"), git.ListBranchesWithSort("version:refname")))
+	assert.Equal(t, []string{"feature/open"}, list(git.ListBranchesWithNoMerged("main")))
+	assert.Equal(t, []string{"feature/open"}, list(git.ListBranchesWithContains("feature/open")))
+	assert.NotContains(t, list(git.ListBranchesWithNoContains("feature/open")), "feature/open")
+	assert.Equal(t, "feature/open", list(git.ListBranchesWithSort("-committerdate"))[0])
+
+	// Arguments that are not patterns are passed on unchanged
+	assert.Equal(t, []string{"feature/merged", "main", "release-1.10", "release-1.9"}, list(git.WithArgs("--points-at", "HEAD")))
+	assert.Equal(t, []string{"feature/open"}, list(git.WithArgs("--points-at", "feature/open"), git.ListBranchesWithPattern("feature/*")))
+}
+
+// Test renaming, copying and deleting branches with typed errors
+func TestBranchLifecycle(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	_, err = gitInstance.Checkout(git.CheckoutWithCreate("feature"))
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Commit("Feature work", git.CommitWithAllowEmpty()))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+
+	require.NoError(t, gitInstance.SetConfig("branch.feature.description", "Feature branch"))
+	require.NoError(t, gitInstance.RenameBranch("feature", "feature-renamed"))
+	require.NoError(t, gitInstance.CopyBranch("feature-renamed", "feature-copy"))
+	description, err := gitInstance.GetConfig("branch.feature-copy.description")
+	require.NoError(t, err)
+	assert.Equal(t, "Feature branch", description)
+
+	err = gitInstance.CopyBranch("feature-renamed", "main")
+	require.Error(t, err)
+
+	// Unmerged branches need a forced delete
+	err = gitInstance.DeleteBranch("feature-copy")
+	var notMerged *errors.BranchNotMergedError
+	require.True(t, stderrors.As(err, &notMerged), "expected BranchNotMergedError, got %T", err)
+	assert.Equal(t, "feature-copy", notMerged.Branch)
+	var gitErr *errors.GitError
+	assert.True(t, stderrors.As(err, &gitErr))
+	require.NoError(t, gitInstance.DeleteBranch("feature-copy", git.DeleteBranchWithForce()))
+
+	// Branches checked out in a worktree cannot be deleted or overwritten
+	worktreeDir := filepath.Join(t.TempDir(), "wt")
+	require.NoError(t, gitInstance.WorktreeAdd(worktreeDir, "feature-renamed"))
+	err = gitInstance.DeleteBranch("feature-renamed", git.DeleteBranchWithForce())
+	var checkedOut *errors.BranchCheckedOutError
+	require.True(t, stderrors.As(err, &checkedOut), "expected BranchCheckedOutError, got %T", err)
+	assert.Equal(t, "feature-renamed", checkedOut.Branch)
+	assert.Equal(t, filepath.Base(worktreeDir), filepath.Base(checkedOut.WorktreePath))
+
+	err = gitInstance.CreateBranch("feature-renamed", git.CreateBranchWithStartPoint("main"), git.CreateBranchWithForce())
+	require.True(t, stderrors.As(err, &checkedOut), "expected BranchCheckedOutError, got %T", err)
+
+	// Renaming a checked out branch updates its worktree
+	require.NoError(t, gitInstance.RenameBranch("feature-renamed", "feature-final"))
+	branches, err := gitInstance.ListBranches()
+	require.NoError(t, err)
+	require.Equal(t, []string{"feature-final", "main"}, branchNames(branches))
+	assert.Equal(t, filepath.Base(worktreeDir), filepath.Base(branches[0].WorktreePath))
+	assert.Equal(t, "Feature work", branches[0].Subject)
+}
+
+// Test start points, tracking modes and upstream changes
+func TestBranchUpstream(t *testing.T) {
+	_, upstreamDir, downstreamDir := setupRemotePair(t)
+	upstream := openTestRepo(t, upstreamDir)
+	downstream := openTestRepo(t, downstreamDir)
+
+	require.NoError(t, upstream.CreateBranch("develop"))
+	_, err := upstream.Push(git.PushWithRemote("origin", "develop"))
+	require.NoError(t, err)
+	_, err = downstream.Fetch()
+	require.NoError(t, err)
+
+	require.NoError(t, downstream.CreateBranch("tracking", git.CreateBranchWithStartPoint("origin/develop"), git.CreateBranchWithTrack()))
+	require.NoError(t, downstream.CreateBranch("inherited", git.CreateBranchWithStartPoint("tracking"), git.CreateBranchWithTrackInherit()))
+	require.NoError(t, downstream.CreateBranch("plain", git.CreateBranchWithStartPoint("origin/develop"), git.CreateBranchWithNoTrack()))
+
+	upstreams := func() map[string]string {
+		branches, err := downstream.ListBranches()
+		require.NoError(t, err)
+		result := make(map[string]string)
+		for _, branch := range branches {
+			result[branch.Name] = branch.Upstream
+		}
+		return result
+	}
+
+	assert.Equal(t, map[string]string{
+		"main":      "origin/main",
+		"tracking":  "origin/develop",
+		"inherited": "origin/develop",
+		"plain":     "",
+	}, upstreams())
+
+	require.NoError(t, downstream.SetUpstreamTo("plain", "main"))
+	require.NoError(t, downstream.UnsetUpstream("tracking"))
+	assert.Equal(t, "main", upstreams()["plain"])
+	assert.Empty(t, upstreams()["tracking"])
+
+	// Remote-tracking branches are deleted locally, remote branches by pushing
+	require.NoError(t, downstream.DeleteBranch("origin/develop", git.DeleteBranchWithRemoteTracking()))
+	remotes, err := downstream.ListBranches(git.ListBranchesWithRemotes())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"origin/HEAD", "origin/main"}, branchNames(remotes))
+
+	results, err := upstream.DeleteRemoteBranch("origin", "develop")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Refs, 1)
+	assert.Equal(t, types.RefStatusPruned, results[0].Refs[0].Status)
+	assert.Equal(t, "refs/heads/develop", results[0].Refs[0].To)
+	remotes, err = upstream.ListBranches(git.ListBranchesWithRemotes(), git.ListBranchesWithPattern("origin/develop"))
+	require.NoError(t, err)
+	assert.Empty(t, remotes)
+
+	// Deleting a branch the remote does not have fails
+	_, err = upstream.DeleteRemoteBranch("origin", "develop")
+	assert.Error(t, err)
+}
@@ -0,0 +1,162 @@
+package git
+
+import (
+	stderrors "errors"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// bundleSHAPattern matches the object names listed for missing prerequisites
+var bundleSHAPattern = regexp.MustCompile(`^[0-9a-f]{40,64}$`)
+
+// BundleCreate writes the commits selected by revs to a bundle file. revs
+// takes rev-list arguments: "--all", branch names, or ranges such as
+// "v1.0..main" for an incremental bundle that requires v1.0
+func (g *gitImpl) BundleCreate(file string, revs []string, opts ...Option) error {
+	cmd := g.newCommand("bundle", "create")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(file)
+	cmd.AddArgs(revs...)
+	_, err := cmd.Execute()
+	return err
+}
+
+// BundleCreateTo streams a bundle of the commits selected by revs to w
+func (g *gitImpl) BundleCreateTo(w io.Writer, revs []string, opts ...Option) error {
+	cmd := g.newCommand("bundle", "create")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs("-")
+	cmd.AddArgs(revs...)
+	return cmd.ExecuteTo(w)
+}
+
+// BundleVerify checks that a bundle is well-formed and that the repository
+// has its prerequisites. A bundle that fails verification is reported with
+// Valid unset and the reasons in Missing and Errors rather than as an error
+func (g *gitImpl) BundleVerify(file string, opts ...Option) (*types.BundleInfo, error) {
+	cmd := g.newCommand("bundle", "verify")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(file)
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+
+	output, err := cmd.Execute()
+	if err != nil {
+		var gitErr *errors.GitError
+		if !stderrors.As(err, &gitErr) {
+			return nil, err
+		}
+		info := parseBundleErrors(gitErr.Stderr)
+		if len(info.Errors) == 0 && len(info.Missing) == 0 {
+			return nil, err
+		}
+		return info, nil
+	}
+
+	info := parseBundleVerify(string(output))
+	info.Valid = true
+	return info, nil
+}
+
+// BundleListHeads lists the refs recorded in a bundle
+func (g *gitImpl) BundleListHeads(file string, opts ...Option) ([]types.BundleRef, error) {
+	cmd := g.newCommand("bundle", "list-heads", file)
+	cmd.ApplyOptions(opts...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return parseBundleRefs(string(output)), nil
+}
+
+// BundleUnbundle stores the objects of a bundle in the repository and returns
+// its refs. No refs are updated; use Fetch with the bundle path for that
+func (g *gitImpl) BundleUnbundle(file string, opts ...Option) ([]types.BundleRef, error) {
+	cmd := g.newCommand("bundle", "unbundle", file)
+	cmd.ApplyOptions(opts...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return parseBundleRefs(string(output)), nil
+}
+
+// parseBundleRefs parses "<sha> <refname>" lines
+func parseBundleRefs(output string) []types.BundleRef {
+	refs := []types.BundleRef{}
+	for _, line := range strings.Split(output, "\n") {
+		sha, name, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found {
+			continue
+		}
+		refs = append(refs, types.BundleRef{Name: name, SHA: sha})
+	}
+	return refs
+}
+
+// parseBundleVerify parses the description `git bundle verify` prints for a
+// valid bundle
+func parseBundleVerify(output string) *types.BundleInfo {
+	info := &types.BundleInfo{
+		Refs:          []types.BundleRef{},
+		Prerequisites: []string{},
+	}
+
+	section := ""
+	for _, line := range strings.Split(output, "\n") {
+		switch {
+		case strings.HasPrefix(line, "The bundle contains"):
+			section = "refs"
+		case strings.HasPrefix(line, "The bundle requires"):
+			section = "prerequisites"
+		case line == "The bundle records a complete history.":
+			info.CompleteHistory = true
+			section = ""
+		case strings.HasPrefix(line, "The bundle uses this hash algorithm: "):
+			info.HashAlgorithm = strings.TrimPrefix(line, "The bundle uses this hash algorithm: ")
+			section = ""
+		case section == "refs":
+			info.Refs = append(info.Refs, parseBundleRefs(line)...)
+		case section == "prerequisites":
+			// "<sha> <optional comment>"
+			if fields := strings.Fields(line); len(fields) > 0 {
+				info.Prerequisites = append(info.Prerequisites, fields[0])
+			}
+		}
+	}
+
+	return info
+}
+
+// parseBundleErrors parses the "error: " lines of a failed verification
+func parseBundleErrors(stderr string) *types.BundleInfo {
+	info := &types.BundleInfo{
+		Missing: []string{},
+		Errors:  []string{},
+	}
+
+	missing := false
+	for _, line := range strings.Split(stderr, "\n") {
+		message, found := strings.CutPrefix(line, "error: ")
+		if !found {
+			continue
+		}
+		message = strings.TrimSpace(message)
+		fields := strings.Fields(message)
+
+		switch {
+		case strings.HasPrefix(message, "Repository lacks these prerequisite commits"):
+			missing = true
+		case missing && len(fields) > 0 && bundleSHAPattern.MatchString(fields[0]):
+			info.Missing = append(info.Missing, fields[0])
+		default:
+			missing = false
+			info.Errors = append(info.Errors, message)
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,127 @@
+package git_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupBundleSource creates a repository with a v1 tag and a commit after it,
+// and returns the commits of v1 and main
+func setupBundleSource(t *testing.T) (git.Git, string, string) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.Tag("v1"))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "new.txt"), []byte("new\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"new.txt"}))
+	require.NoError(t, gitInstance.Commit("After v1"))
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("2"))
+	require.NoError(t, err)
+	return gitInstance, logs[1].Commit, logs[0].Commit
+}
+
+// newEmptyRepo initializes an empty repository
+func newEmptyRepo(t *testing.T) (string, git.Git) {
+	dir := t.TempDir()
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Init(dir))
+	gitInstance.SetWorkingDirectory(dir)
+	return dir, gitInstance
+}
+
+// Test full and incremental bundles, verification and listing heads
+func TestBundleVerify(t *testing.T) {
+	source, v1, head := setupBundleSource(t)
+	bundleDir := t.TempDir()
+	full := filepath.Join(bundleDir, "full.bundle")
+	incremental := filepath.Join(bundleDir, "incremental.bundle")
+
+	require.NoError(t, source.BundleCreate(full, []string{"--all"}))
+	require.NoError(t, source.BundleCreate(incremental, []string{"v1..main"}))
+
+	info, err := source.BundleVerify(full)
+	require.NoError(t, err)
+	assert.True(t, info.Valid)
+	assert.True(t, info.CompleteHistory)
+	assert.Empty(t, info.Prerequisites)
+	assert.Equal(t, "sha1", info.HashAlgorithm)
+	assert.Contains(t, info.Refs, types.BundleRef{Name: "refs/heads/main", SHA: head})
+	assert.Contains(t, info.Refs, types.BundleRef{Name: "refs/tags/v1", SHA: v1})
+
+	info, err = source.BundleVerify(incremental)
+	require.NoError(t, err)
+	assert.True(t, info.Valid)
+	assert.False(t, info.CompleteHistory)
+	assert.Equal(t, []string{v1}, info.Prerequisites)
+
+	heads, err := source.BundleListHeads(full, git.BundleWithRefs("refs/heads/main"))
+	require.NoError(t, err)
+	assert.Equal(t, []types.BundleRef{{Name: "refs/heads/main", SHA: head}}, heads)
+
+	// An empty repository lacks the prerequisites of the incremental bundle
+	_, empty := newEmptyRepo(t)
+	info, err = empty.BundleVerify(incremental)
+	require.NoError(t, err)
+	assert.False(t, info.Valid)
+	assert.Equal(t, []string{v1}, info.Missing)
+
+	garbage := filepath.Join(bundleDir, "garbage.bundle")
+	require.NoError(t, os.WriteFile(garbage, []byte("not a bundle\n"), 0644))
+	info, err = empty.BundleVerify(garbage)
+	require.NoError(t, err)
+	assert.False(t, info.Valid)
+	require.Len(t, info.Errors, 1)
+	assert.Contains(t, info.Errors[0], "does not look like a v2 or v3 bundle file")
+
+	// Nothing to bundle
+	assert.Error(t, source.BundleCreate(filepath.Join(bundleDir, "empty.bundle"), []string{"main..main"}))
+}
+
+// Test restoring repositories from a streamed bundle
+func TestBundleRestore(t *testing.T) {
+	source, _, head := setupBundleSource(t)
+
+	var bundle bytes.Buffer
+	require.NoError(t, source.BundleCreateTo(&bundle, []string{"main", "v1"}))
+	file := filepath.Join(t.TempDir(), "repo.bundle")
+	require.NoError(t, os.WriteFile(file, bundle.Bytes(), 0644))
+
+	// Clone from the bundle
+	cloneDir := filepath.Join(t.TempDir(), "clone")
+	cloner, err := git.NewGit()
+	require.NoError(t, err)
+	require.NoError(t, cloner.Clone(file, cloneDir, git.CloneWithBranch("main")))
+	cloned := openTestRepo(t, cloneDir)
+	logs, err := cloned.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	assert.Equal(t, head, logs[0].Commit)
+
+	// Fetch from the bundle into remote-tracking refs
+	_, fetcher := newEmptyRepo(t)
+	remotes, err := fetcher.Fetch(git.FetchWithRemote(file, "refs/heads/*:refs/remotes/bundle/*"))
+	require.NoError(t, err)
+	require.Len(t, remotes, 1)
+	assert.Equal(t, file, remotes[0].URL)
+	require.NotEmpty(t, remotes[0].Refs)
+	assert.Equal(t, types.RefStatusNew, remotes[0].Refs[0].Status)
+
+	// Unbundle only stores the objects
+	_, unbundler := newEmptyRepo(t)
+	refs, err := unbundler.BundleUnbundle(file)
+	require.NoError(t, err)
+	assert.Contains(t, refs, types.BundleRef{Name: "refs/heads/main", SHA: head})
+	show, err := unbundler.Show(head)
+	require.NoError(t, err)
+	assert.Equal(t, "After v1", show.Message)
+}
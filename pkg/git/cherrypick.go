@@ -0,0 +1,34 @@
+package git
+
+import (
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// CherryPick applies the changes of existing commits. Conflicts are not
+// reported as an error; the result names the commit that stopped and lists
+// the conflicted files
+func (g *gitImpl) CherryPick(commits []string, opts ...Option) (*types.SequencerResult, error) {
+	cmd := g.newCommand("cherry-pick")
+	cmd.ApplyOptions(opts...)
+	return g.startSequencer(cherryPickSequencer, commits, cmd)
+}
+
+// CherryPickContinue commits the resolved conflicts and applies the remaining commits
+func (g *gitImpl) CherryPickContinue() (*types.SequencerResult, error) {
+	return g.resumeSequencer(cherryPickSequencer, false)
+}
+
+// CherryPickSkip skips the commit that stopped and applies the remaining commits
+func (g *gitImpl) CherryPickSkip() (*types.SequencerResult, error) {
+	return g.resumeSequencer(cherryPickSequencer, true)
+}
+
+// CherryPickAbort cancels the cherry-pick and restores the original branch
+func (g *gitImpl) CherryPickAbort() error {
+	return g.stopSequencer(cherryPickSequencer, "--abort")
+}
+
+// CherryPickQuit forgets the cherry-pick in progress, keeping the commits applied so far
+func (g *gitImpl) CherryPickQuit() error {
+	return g.stopSequencer(cherryPickSequencer, "--quit")
+}
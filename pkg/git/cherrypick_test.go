@@ -0,0 +1,231 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupPickable creates a "feature" branch with three commits, the first of
+// which conflicts with main. It returns the feature commits, oldest first
+func setupPickable(t *testing.T) (string, git.Git, []string) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	for _, file := range []string{"README.md", "two.txt", "three.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, file), []byte("feature "+file+"\n"), 0644))
+		require.NoError(t, gitInstance.Add([]string{file}))
+		require.NoError(t, gitInstance.Commit("Feature "+file))
+	}
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("3"))
+	require.NoError(t, err)
+	commits := []string{logs[2].Commit, logs[1].Commit, logs[0].Commit}
+
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("main\n"), 0644))
+	require.NoError(t, gitInstance.Commit("Main change", git.CommitWithAll()))
+
+	return tempDir, gitInstance, commits
+}
+
+// Test cherry-picking commits that apply cleanly
+func TestCherryPick(t *testing.T) {
+	_, gitInstance, commits := setupPickable(t)
+
+	result, err := gitInstance.CherryPick([]string{commits[1], commits[2]})
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Empty(t, result.StoppedAt)
+	require.Len(t, result.Applied, 2)
+	assert.Equal(t, commits[1], result.Applied[0].Source)
+	assert.Equal(t, commits[2], result.Applied[1].Source)
+	assert.Equal(t, result.Applied[1].Commit, result.MergeCommit)
+	assert.Equal(t, 2, result.Stats.FilesChanged)
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	assert.Equal(t, "Feature three.txt", logs[0].Message)
+}
+
+// Test a cherry-pick that stops on conflicts and is continued
+func TestCherryPickConflictContinue(t *testing.T) {
+	tempDir, gitInstance, commits := setupPickable(t)
+
+	result, err := gitInstance.CherryPick([]string{"main..feature"})
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, commits[0], result.StoppedAt)
+	assert.Empty(t, result.Applied)
+	assert.Equal(t, []string{"README.md"}, result.ConflictedFiles)
+	require.Len(t, result.Conflicts, 1)
+	assert.Equal(t, types.ConflictStatusBothModified, result.Conflicts[0].Status)
+
+	_, err = gitInstance.ResolveConflicts([]types.ConflictResolution{{FilePath: "README.md", UseTheirs: true}})
+	require.NoError(t, err)
+
+	result, err = gitInstance.CherryPickContinue()
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	require.Len(t, result.Applied, 3)
+	for i, applied := range result.Applied {
+		assert.Equal(t, commits[i], applied.Source)
+		assert.NotEqual(t, applied.Source, applied.Commit)
+	}
+
+	content, err := os.ReadFile(filepath.Join(tempDir, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "feature README.md\n", string(content))
+}
+
+// Test skipping the conflicting commit and aborting a cherry-pick
+func TestCherryPickSkipAndAbort(t *testing.T) {
+	_, gitInstance, commits := setupPickable(t)
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	head := logs[0].Commit
+
+	result, err := gitInstance.CherryPick(commits)
+	require.NoError(t, err)
+	require.False(t, result.Success)
+
+	result, err = gitInstance.CherryPickSkip()
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	require.Len(t, result.Applied, 2)
+	assert.Equal(t, commits[1], result.Applied[0].Source)
+	assert.Equal(t, commits[2], result.Applied[1].Source)
+
+	// Start over and abort
+	require.NoError(t, gitInstance.Reset([]string{}, git.WithArgs("--hard", head)))
+	result, err = gitInstance.CherryPick(commits)
+	require.NoError(t, err)
+	require.False(t, result.Success)
+	require.NoError(t, gitInstance.CherryPickAbort())
+
+	logs, err = gitInstance.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	assert.Equal(t, head, logs[0].Commit)
+
+	_, err = gitInstance.CherryPickContinue()
+	assert.Error(t, err, "no cherry-pick in progress")
+}
+
+// Test created commits are paired with their sources by content, not position
+func TestCherryPickPairing(t *testing.T) {
+	_, gitInstance, commits := setupPickable(t)
+	require.NoError(t, gitInstance.Reset([]string{}, git.WithArgs("--hard", "HEAD~1")))
+
+	// Fast-forwarded commits are their own source
+	result, err := gitInstance.CherryPick(commits[:2], git.WithArgs("--ff"))
+	require.NoError(t, err)
+	require.Len(t, result.Applied, 2)
+	for i, applied := range result.Applied {
+		assert.Equal(t, commits[i], applied.Source)
+		assert.Equal(t, commits[i], applied.Commit)
+	}
+
+	// Nothing is committed with --no-commit
+	result, err = gitInstance.CherryPick(commits[2:], git.WithArgs("--no-commit"))
+	require.NoError(t, err)
+	assert.Empty(t, result.Applied)
+	require.NoError(t, gitInstance.Reset([]string{}, git.WithArgs("--hard")))
+
+	// The recorded origin names the source
+	result, err = gitInstance.CherryPick(commits[2:], git.CherryPickWithRecordOrigin())
+	require.NoError(t, err)
+	require.Len(t, result.Applied, 1)
+	assert.Equal(t, commits[2], result.Applied[0].Source)
+	assert.NotEqual(t, commits[2], result.Applied[0].Commit)
+}
+
+// Test commits that become empty and are dropped leave no gap in the pairing
+func TestCherryPickDropEmpty(t *testing.T) {
+	requireGitVersion(t, 2, 45)
+	_, gitInstance, commits := setupPickable(t)
+
+	// two.txt is already picked, so picking it again gives an empty commit
+	_, err := gitInstance.CherryPick(commits[1:2])
+	require.NoError(t, err)
+	result, err := gitInstance.CherryPick(commits[1:], git.WithArgs("--empty=drop"))
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	require.Len(t, result.Applied, 1)
+	assert.Equal(t, commits[2], result.Applied[0].Source)
+}
+
+// Test reverts that stop on conflicts
+func TestRevertConflict(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	readme := filepath.Join(tempDir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("first\n"), 0644))
+	require.NoError(t, gitInstance.Commit("First change", git.CommitWithAll()))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "other.txt"), []byte("other\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"other.txt"}))
+	require.NoError(t, gitInstance.Commit("Unrelated change"))
+	require.NoError(t, os.WriteFile(readme, []byte("second\n"), 0644))
+	require.NoError(t, gitInstance.Commit("Second change", git.CommitWithAll()))
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("3"))
+	require.NoError(t, err)
+	unrelated, first := logs[1].Commit, logs[2].Commit
+
+	// Reverting the first change conflicts with the second one
+	result, err := gitInstance.Revert([]string{unrelated, first})
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, first, result.StoppedAt)
+	require.Len(t, result.Applied, 1)
+	assert.Equal(t, unrelated, result.Applied[0].Source)
+	assert.NoFileExists(t, filepath.Join(tempDir, "other.txt"))
+	assert.Equal(t, []string{"README.md"}, result.ConflictedFiles)
+
+	require.NoError(t, gitInstance.RevertQuit())
+	_, err = gitInstance.RevertContinue()
+	assert.Error(t, err, "the sequence was forgotten")
+}
+
+// Test reverting a range pairs each revert with its source, newest first
+func TestRevertRange(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	for _, name := range []string{"one.txt", "two.txt", "three.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, name), []byte(name+"\n"), 0644))
+		require.NoError(t, gitInstance.Add([]string{name}))
+		require.NoError(t, gitInstance.Commit("Add "+name))
+	}
+
+	result, err := gitInstance.Revert([]string{"HEAD~3..HEAD"})
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	require.Len(t, result.Applied, 3)
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("6"))
+	require.NoError(t, err)
+	for i, applied := range result.Applied {
+		revert := logs[2-i]
+		source := logs[3+i]
+		assert.Equal(t, revert.Commit, applied.Commit)
+		assert.Equal(t, source.Commit, applied.Source)
+		assert.Contains(t, revert.Body, "This reverts commit "+source.Commit)
+	}
+}
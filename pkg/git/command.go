@@ -3,22 +3,24 @@ package git
 import (
 	"bytes"
 	"context"
+	stderrors "errors"
 	"fmt"
-	"os"
-	"os/exec"
+	"io"
 	"strings"
 	"time"
 
 	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
 // command represents a git command to be executed
 type command struct {
-	gitPath     string
+	executor    Executor
 	args        []string
 	workingDir  string
 	env         map[string]string
 	timeout     time.Duration
+	ctx         context.Context
 	stdin       *bytes.Buffer
 	noStderr    bool // Some commands write normal output to stderr (e.g., fetch)
 }
@@ -26,49 +28,71 @@ type command struct {
 // newCommand creates a new command with the given git operation
 func (g *gitImpl) newCommand(operation string, args ...string) Command {
 	cmd := &command{
-		gitPath:    g.path,
+		executor:   g.executor,
 		args:       append([]string{operation}, args...),
 		workingDir: g.wd,
 		env:        make(map[string]string),
 		timeout:    2 * time.Minute, // Default timeout
+		ctx:        g.ctx,
 	}
 	return cmd
 }
 
-// Execute runs the git command and returns the output
-func (c *command) Execute() ([]byte, error) {
-	ctx := context.Background()
-	if c.timeout > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, c.timeout)
-		defer cancel()
+// context returns the context the command runs under, bounded by the command timeout
+func (c *command) context() (context.Context, context.CancelFunc) {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
 	}
-
-	cmd := exec.CommandContext(ctx, c.gitPath, c.args...)
-	
-	if c.workingDir != "" {
-		cmd.Dir = c.workingDir
+	if c.timeout > 0 {
+		return context.WithTimeout(ctx, c.timeout)
 	}
+	return context.WithCancel(ctx)
+}
 
-	// Build environment
-	if len(c.env) > 0 {
-		cmd.Env = os.Environ()
-		for k, v := range c.env {
-			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
-		}
+// run hands the command to the executor, writing its output to stdout and stderr
+func (c *command) run(ctx context.Context, stdout, stderr io.Writer) error {
+	inv := &Invocation{
+		Args:   c.args,
+		Dir:    c.workingDir,
+		Env:    c.env,
+		Stdout: stdout,
+		Stderr: stderr,
 	}
 
 	// Set stdin if provided
 	if c.stdin != nil {
-		cmd.Stdin = c.stdin
+		inv.Stdin = c.stdin
 	}
 
+	return c.executor.Run(ctx, inv)
+}
+
+// canceledError reports that the command was interrupted because ctx is done
+func (c *command) canceledError(ctx context.Context) error {
+	return &errors.CanceledError{
+		Command: c.args,
+		Err:     ctx.Err(),
+	}
+}
+
+// exitCode extracts the exit status from an executor error
+func exitCode(err error) (int, bool) {
+	var exitError interface{ ExitCode() int }
+	if stderrors.As(err, &exitError) {
+		return exitError.ExitCode(), true
+	}
+	return 0, false
+}
+
+// Execute runs the git command and returns the output
+func (c *command) Execute() ([]byte, error) {
+	ctx, cancel := c.context()
+	defer cancel()
+
 	// Capture both stdout and stderr
 	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	err := cmd.Run()
+	err := c.run(ctx, &stdout, &stderr)
 	
 	// Some git commands write normal output to stderr (e.g., fetch, push)
 	// In those cases, we should return stderr as the output
@@ -77,11 +101,14 @@ func (c *command) Execute() ([]byte, error) {
 	}
 
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, c.canceledError(ctx)
+		}
 		// Check if it's an exit error and create a GitError
-		if exitError, ok := err.(*exec.ExitError); ok {
+		if code, ok := exitCode(err); ok {
 			return nil, &errors.GitError{
 				Command:  c.args,
-				ExitCode: exitError.ExitCode(),
+				ExitCode: code,
 				Stderr:   stderr.String(),
 				Stdout:   stdout.String(),
 			}
@@ -94,47 +121,55 @@ func (c *command) Execute() ([]byte, error) {
 
 // ExecuteCombined runs the git command and returns combined stdout and stderr
 func (c *command) ExecuteCombined() ([]byte, error) {
-	ctx := context.Background()
-	if c.timeout > 0 {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, c.timeout)
-		defer cancel()
-	}
+	ctx, cancel := c.context()
+	defer cancel()
 
-	cmd := exec.CommandContext(ctx, c.gitPath, c.args...)
+	var output bytes.Buffer
+	err := c.run(ctx, &output, &output)
 	
-	if c.workingDir != "" {
-		cmd.Dir = c.workingDir
-	}
-
-	// Build environment
-	if len(c.env) > 0 {
-		cmd.Env = os.Environ()
-		for k, v := range c.env {
-			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, c.canceledError(ctx)
+		}
+		// Check if it's an exit error and create a GitError
+		if code, ok := exitCode(err); ok {
+			return nil, &errors.GitError{
+				Command:  c.args,
+				ExitCode: code,
+				Stderr:   output.String(),
+			}
 		}
+		return nil, err
 	}
 
-	// Set stdin if provided
-	if c.stdin != nil {
-		cmd.Stdin = c.stdin
-	}
+	return output.Bytes(), nil
+}
+
+// ExecuteTo runs the git command and streams its output to stdout instead of
+// buffering it. Output written before a failure is not rolled back
+func (c *command) ExecuteTo(stdout io.Writer) error {
+	ctx, cancel := c.context()
+	defer cancel()
+
+	var stderr bytes.Buffer
+	err := c.run(ctx, stdout, &stderr)
 
-	output, err := cmd.CombinedOutput()
-	
 	if err != nil {
+		if ctx.Err() != nil {
+			return c.canceledError(ctx)
+		}
 		// Check if it's an exit error and create a GitError
-		if exitError, ok := err.(*exec.ExitError); ok {
-			return nil, &errors.GitError{
+		if code, ok := exitCode(err); ok {
+			return &errors.GitError{
 				Command:  c.args,
-				ExitCode: exitError.ExitCode(),
-				Stderr:   string(output),
+				ExitCode: code,
+				Stderr:   stderr.String(),
 			}
 		}
-		return nil, err
+		return err
 	}
 
-	return output, nil
+	return nil
 }
 
 // ApplyOptions applies all options to the command
@@ -146,7 +181,7 @@ func (c *command) ApplyOptions(opts ...Option) {
 
 // String returns the command as it would be executed
 func (c *command) String() string {
-	return fmt.Sprintf("%s %s", c.gitPath, strings.Join(c.args, " "))
+	return fmt.Sprintf("git %s", strings.Join(c.args, " "))
 }
 
 // Interface method implementations for option configuration
@@ -293,6 +328,25 @@ func WithConfigs(configs map[string]string) Option {
 	}
 }
 
+// subcommandArgs returns the arguments following the subcommand, skipping
+// any -c key=value pairs added by WithConfig
+func subcommandArgs(args []string) []string {
+	i := 0
+	for i+1 < len(args) && args[i] == "-c" {
+		i += 2
+	}
+	if i < len(args) {
+		i++
+	}
+	return args[i:]
+}
+
+// WithConflictStyle sets merge.conflictStyle ("merge", "diff3" or "zdiff3")
+// for commands that may leave conflict markers in files
+func WithConflictStyle(style string) Option {
+	return WithConfig("merge.conflictStyle", style)
+}
+
 // Add-specific options
 
 // AddWithForce allows adding ignored files
@@ -343,8 +397,10 @@ func AddWithPatch() Option {
 // Status-specific options
 
 // StatusWithShort gives output in short format
+//
+// Deprecated: Status always parses porcelain v2 output; this option has no effect
 func StatusWithShort() Option {
-	return WithArgs("--short")
+	return func(c Command) {}
 }
 
 // StatusWithBranch shows branch information
@@ -353,13 +409,17 @@ func StatusWithBranch() Option {
 }
 
 // StatusWithPorcelain gives porcelain output (default for this implementation)
+//
+// Deprecated: Status always parses porcelain v2 output; this option has no effect
 func StatusWithPorcelain() Option {
-	return WithArgs("--porcelain")
+	return func(c Command) {}
 }
 
 // StatusWithLong gives output in long format (default Git behavior)
+//
+// Deprecated: Status always parses porcelain v2 output; this option has no effect
 func StatusWithLong() Option {
-	return WithArgs("--long")
+	return func(c Command) {}
 }
 
 // StatusWithShowStash shows stash information
@@ -440,13 +500,19 @@ func LogWithMaxCount(count string) Option {
 }
 
 // LogWithOneline shows commits in oneline format
+//
+// Deprecated: Log parses its own record format, which --oneline would replace;
+// this option has no effect. Use Subject and a short Commit prefix instead
 func LogWithOneline() Option {
-	return WithArgs("--oneline")
+	return func(c Command) {}
 }
 
 // LogWithGraph shows a text-based graphical representation
+//
+// Deprecated: the graph prefixes would corrupt the record format Log parses;
+// this option has no effect. Use Parents to follow the history
 func LogWithGraph() Option {
-	return WithArgs("--graph")
+	return func(c Command) {}
 }
 
 // LogWithStat shows diffstat for each commit
@@ -454,6 +520,151 @@ func LogWithStat() Option {
 	return WithArgs("--stat")
 }
 
+// LogWithPatch includes the diff of each commit in Diffs
+func LogWithPatch() Option {
+	return WithArgs("--patch")
+}
+
+// LogWithNumstat includes the number of added and deleted lines per file in Diffs
+func LogWithNumstat() Option {
+	return WithArgs("--numstat")
+}
+
+// LogWithSignatures verifies commit signatures and fills in Signature. It runs
+// gpg or ssh-keygen for every signed commit, so signatures are not checked by
+// default. It also applies to Show and LogStream
+func LogWithSignatures() Option {
+	return WithArgs(logSignatureFormat)
+}
+
+// LogWithRevisions lists commits reachable from revs, which may use range
+// notation (e.g. "main..feature" or "^v1.0"), instead of HEAD
+func LogWithRevisions(revs ...string) Option {
+	return WithArgs(revs...)
+}
+
+// LogWithPaths only lists commits touching the given paths. Apply it after
+// all other options
+func LogWithPaths(paths ...string) Option {
+	return func(c Command) {
+		c.AddArgs("--")
+		c.AddArgs(paths...)
+	}
+}
+
+// LogWithAuthor only lists commits whose author matches pattern
+func LogWithAuthor(pattern string) Option {
+	return WithArgs("--author=" + pattern)
+}
+
+// LogWithGrep only lists commits whose message matches pattern
+func LogWithGrep(pattern string) Option {
+	return WithArgs("--grep=" + pattern)
+}
+
+// LogWithSince only lists commits more recent than t
+func LogWithSince(t time.Time) Option {
+	return WithArgs("--since=" + t.Format(time.RFC3339))
+}
+
+// LogWithUntil only lists commits older than t
+func LogWithUntil(t time.Time) Option {
+	return WithArgs("--until=" + t.Format(time.RFC3339))
+}
+
+// LogWithFirstParent follows only the first parent of merge commits
+func LogWithFirstParent() Option {
+	return WithArgs("--first-parent")
+}
+
+// LogWithAncestryPath only lists commits on the ancestry path of a range
+// such as "v1.0..main"
+func LogWithAncestryPath() Option {
+	return WithArgs("--ancestry-path")
+}
+
+// LogWithNoMerges leaves out merge commits
+func LogWithNoMerges() Option {
+	return WithArgs("--no-merges")
+}
+
+// LogWithSkip skips the first count commits
+func LogWithSkip(count int) Option {
+	return WithArgs(fmt.Sprintf("--skip=%d", count))
+}
+
+// LogWithCursor continues a listing after commit, as returned by
+// LogIterator.Cursor, by listing its ancestors. It replaces the starting
+// revision; ranges can still be limited with "^<rev>" revisions, and
+// LogWithSkip skips commits after the cursor. Pages only match a single
+// listing when every later commit is an ancestor of the cursor, so the
+// cursor requires LogWithFirstParent
+func LogWithCursor(commit string) Option {
+	return WithArgs(logCursorArg + commit)
+}
+
+// Show-specific options
+
+// ShowWithNoPatch leaves out the diff Show includes by default
+func ShowWithNoPatch() Option {
+	return WithArgs("--no-patch")
+}
+
+// ShowWithNumstat reports the number of added and deleted lines per file instead of the diff
+func ShowWithNumstat() Option {
+	return WithArgs("--numstat")
+}
+
+// Diff-specific options
+
+// DiffWithStaged compares the index with HEAD instead of the working tree
+func DiffWithStaged() Option {
+	return WithArgs("--cached")
+}
+
+// DiffWithCommit compares against the given commit (call twice to compare two commits)
+func DiffWithCommit(commit string) Option {
+	return WithArgs(commit)
+}
+
+// DiffWithRaw includes --raw records with modes, object IDs and status letters
+func DiffWithRaw() Option {
+	return WithArgs("--raw")
+}
+
+// DiffWithNumstat includes per-file insertion and deletion counts
+func DiffWithNumstat() Option {
+	return WithArgs("--numstat")
+}
+
+// DiffWithPatch includes the patch when combined with --raw or --numstat
+func DiffWithPatch() Option {
+	return WithArgs("--patch")
+}
+
+// DiffWithFindRenames detects renames
+func DiffWithFindRenames() Option {
+	return WithArgs("--find-renames")
+}
+
+// DiffWithFindCopies detects copies as well as renames
+func DiffWithFindCopies() Option {
+	return WithArgs("--find-copies")
+}
+
+// DiffWithContextLines sets the number of context lines around each change
+func DiffWithContextLines(lines int) Option {
+	return WithArgs(fmt.Sprintf("--unified=%d", lines))
+}
+
+// DiffWithPaths limits the diff to the given paths
+func DiffWithPaths(paths []string) Option {
+	return func(c Command) {
+		c.AddArgs("--")
+		c.AddArgs(paths...)
+	}
+}
+
 // Checkout-specific options
 
 // CheckoutWithBranch specifies the branch to checkout
@@ -528,6 +739,578 @@ func MergeWithCommitMessage(message string) Option {
 	return WithArgs("-m", message)
 }
 
+// Reflog-specific options
+
+// ReflogWithMaxCount limits the number of reflog entries to show
+func ReflogWithMaxCount(count string) Option {
+	return WithArgs("--max-count", count)
+}
+
+// ReflogWithExpire prunes entries older than the given time (e.g. "90.days.ago", "now")
+func ReflogWithExpire(time string) Option {
+	return WithArgs("--expire=" + time)
+}
+
+// ReflogWithExpireUnreachable prunes entries older than the given time that
+// are not reachable from the current tip of the ref
+func ReflogWithExpireUnreachable(time string) Option {
+	return WithArgs("--expire-unreachable=" + time)
+}
+
+// ReflogWithAll expires the reflogs of all refs
+func ReflogWithAll() Option {
+	return WithArgs("--all")
+}
+
+// ReflogWithRewrite adjusts the old value of the entry following a pruned
+// one so the log stays consistent
+func ReflogWithRewrite() Option {
+	return WithArgs("--rewrite")
+}
+
+// ReflogWithUpdateRef sets the ref to the value of the new top entry if the
+// previous top entry was pruned
+func ReflogWithUpdateRef() Option {
+	return WithArgs("--updateref")
+}
+
+// ReflogWithDryRun shows which entries would be pruned without pruning them
+func ReflogWithDryRun() Option {
+	return WithArgs("--dry-run")
+}
+
+// Stash-specific options
+
+// StashWithMessage sets the description of the stash entry
+func StashWithMessage(message string) Option {
+	return WithArgs("--message", message)
+}
+
+// StashWithIncludeUntracked also stashes untracked files
+func StashWithIncludeUntracked() Option {
+	return WithArgs("--include-untracked")
+}
+
+// StashWithAll also stashes untracked and ignored files
+func StashWithAll() Option {
+	return WithArgs("--all")
+}
+
+// StashWithKeepIndex leaves staged changes in the index and working tree
+func StashWithKeepIndex() Option {
+	return WithArgs("--keep-index")
+}
+
+// StashWithIndex also restores the staged state when applying a stash
+func StashWithIndex() Option {
+	return WithArgs("--index")
+}
+
+// StashWithPathspec limits the stash to the given paths (must be the last option)
+func StashWithPathspec(paths ...string) Option {
+	return func(c Command) {
+		c.AddArgs("--")
+		c.AddArgs(paths...)
+	}
+}
+
+// Worktree-specific options
+
+// WorktreeWithBranch creates a new branch for the worktree
+func WorktreeWithBranch(branch string) Option {
+	return WithArgs("-b", branch)
+}
+
+// WorktreeWithDetach checks out a detached HEAD in the new worktree
+func WorktreeWithDetach() Option {
+	return WithArgs("--detach")
+}
+
+// WorktreeWithNoCheckout creates the worktree without checking out files
+func WorktreeWithNoCheckout() Option {
+	return WithArgs("--no-checkout")
+}
+
+// WorktreeWithForce overrides safeguards, e.g. to remove a dirty worktree
+func WorktreeWithForce() Option {
+	return WithArgs("--force")
+}
+
+// WorktreeWithLock locks the worktree right after it is added
+func WorktreeWithLock() Option {
+	return WithArgs("--lock")
+}
+
+// WorktreeWithReason records why the worktree is locked
+func WorktreeWithReason(reason string) Option {
+	return WithArgs("--reason", reason)
+}
+
+// WorktreeWithExpire only prunes worktrees missing for longer than the given time
+func WorktreeWithExpire(time string) Option {
+	return WithArgs("--expire", time)
+}
+
+// Submodule-specific options
+
+// SubmoduleWithPaths limits the command to the given submodule paths (must be the last option)
+func SubmoduleWithPaths(paths ...string) Option {
+	return func(c Command) {
+		c.AddArgs("--")
+		c.AddArgs(paths...)
+	}
+}
+
+// SubmoduleWithInit initializes submodules that have not been initialized before updating
+func SubmoduleWithInit() Option {
+	return WithArgs("--init")
+}
+
+// SubmoduleWithRecursive also processes nested submodules
+func SubmoduleWithRecursive() Option {
+	return WithArgs("--recursive")
+}
+
+// SubmoduleWithRemote updates submodules to the tip of their remote-tracking branch
+func SubmoduleWithRemote() Option {
+	return WithArgs("--remote")
+}
+
+// SubmoduleWithDepth creates shallow clones of submodules
+func SubmoduleWithDepth(depth int) Option {
+	return WithArgs("--depth", fmt.Sprintf("%d", depth))
+}
+
+// SubmoduleWithBranch sets the branch tracked by an added submodule
+func SubmoduleWithBranch(branch string) Option {
+	return WithArgs("--branch", branch)
+}
+
+// SubmoduleWithName sets the logical name of an added submodule
+func SubmoduleWithName(name string) Option {
+	return WithArgs("--name", name)
+}
+
+// SubmoduleWithForce discards local changes when updating or deinitializing submodules
+func SubmoduleWithForce() Option {
+	return WithArgs("--force")
+}
+
+// Cherry-pick and revert options
+
+// CherryPickWithRecordOrigin appends "(cherry picked from commit ...)" to the message
+func CherryPickWithRecordOrigin() Option {
+	return WithArgs("-x")
+}
+
+// CherryPickWithAllowEmpty keeps commits that become empty
+func CherryPickWithAllowEmpty() Option {
+	return WithArgs("--allow-empty")
+}
+
+// SequencerWithNoCommit applies the changes to the index and working tree without committing
+func SequencerWithNoCommit() Option {
+	return WithArgs("--no-commit")
+}
+
+// SequencerWithMainline selects the parent to diff against when picking or reverting merge commits
+func SequencerWithMainline(parent int) Option {
+	return WithArgs("--mainline", fmt.Sprintf("%d", parent))
+}
+
+// SequencerWithStrategy specifies the merge strategy used to apply commits
+func SequencerWithStrategy(strategy string) Option {
+	return WithArgs("--strategy", strategy)
+}
+
+// Rebase-specific options
+
+// RebaseWithOnto rebases onto newbase instead of the upstream
+func RebaseWithOnto(newbase string) Option {
+	return WithArgs("--onto", newbase)
+}
+
+// RebaseWithRoot rebases all commits reachable from the branch, including the root commit
+func RebaseWithRoot() Option {
+	return WithArgs("--root")
+}
+
+// RebaseWithRebaseMerges recreates merge commits instead of flattening history
+func RebaseWithRebaseMerges() Option {
+	return WithArgs("--rebase-merges")
+}
+
+// RebaseWithAutosquash moves fixup! and squash! commits next to the commits they amend
+func RebaseWithAutosquash() Option {
+	return WithArgs("--autosquash")
+}
+
+// RebaseWithAutostash stashes local changes before the rebase and reapplies them afterwards
+func RebaseWithAutostash() Option {
+	return WithArgs("--autostash")
+}
+
+// Bisect-specific options
+
+// BisectWithTerms uses custom terms for the old (good) and new (bad) states
+func BisectWithTerms(old, new string) Option {
+	return WithArgs("--term-old="+old, "--term-new="+new)
+}
+
+// BisectWithNoCheckout updates BISECT_HEAD instead of checking out each commit to test
+func BisectWithNoCheckout() Option {
+	return WithArgs("--no-checkout")
+}
+
+// BisectWithFirstParent follows only the first parent of merge commits
+func BisectWithFirstParent() Option {
+	return WithArgs("--first-parent")
+}
+
+// BisectWithPaths only tests commits that touch the given paths
+func BisectWithPaths(paths ...string) Option {
+	return func(c Command) {
+		c.AddArgs("--")
+		c.AddArgs(paths...)
+	}
+}
+
+// Archive-specific options
+
+// ArchiveWithFormat sets the archive format, tar when not set
+func ArchiveWithFormat(format types.ArchiveFormat) Option {
+	return WithArgs("--format=" + string(format))
+}
+
+// ArchiveWithPrefix prepends prefix to every path in the archive (e.g. "project/")
+func ArchiveWithPrefix(prefix string) Option {
+	return WithArgs("--prefix=" + prefix)
+}
+
+// ArchiveWithPaths only includes the given paths of the tree
+func ArchiveWithPaths(paths ...string) Option {
+	return WithArgs(paths...)
+}
+
+// ArchiveWithAddFile adds an untracked file from the working tree to the archive
+func ArchiveWithAddFile(file string) Option {
+	return WithArgs("--add-file=" + file)
+}
+
+// ArchiveWithAddVirtualFile adds a file with the given path and content to the archive
+func ArchiveWithAddVirtualFile(path, content string) Option {
+	return WithArgs("--add-virtual-file=" + path + ":" + content)
+}
+
+// ArchiveWithRemote retrieves the archive from a remote repository instead of the local one
+func ArchiveWithRemote(remote string) Option {
+	return WithArgs("--remote=" + remote)
+}
+
+// ArchiveWithCompressionLevel sets the compression level for zip and tar.gz, from 0 to 9
+func ArchiveWithCompressionLevel(level int) Option {
+	return WithArgs(fmt.Sprintf("-%d", level))
+}
+
+// Bundle-specific options
+
+// BundleWithVersion sets the bundle format version (2 or 3)
+func BundleWithVersion(version int) Option {
+	return WithArgs(fmt.Sprintf("--version=%d", version))
+}
+
+// BundleWithRefs limits listed or unbundled refs to the given names
+func BundleWithRefs(refs ...string) Option {
+	return WithArgs(refs...)
+}
+
+// Blame-specific options
+
+// BlameWithLines blames only lines start to end, counting from 1
+func BlameWithLines(start, end int) Option {
+	return WithArgs("-L", fmt.Sprintf("%d,%d", start, end))
+}
+
+// BlameWithLineRange blames a range in any form -L accepts (e.g. "/^func main/,+10" or ":main")
+func BlameWithLineRange(spec string) Option {
+	return WithArgs("-L", spec)
+}
+
+// BlameWithRevision blames the file as of rev instead of the working tree
+func BlameWithRevision(rev string) Option {
+	return WithArgs(rev)
+}
+
+// BlameWithDetectMoves attributes lines moved or copied within the file to their origin
+func BlameWithDetectMoves() Option {
+	return WithArgs("-M")
+}
+
+// BlameWithDetectCopies attributes lines copied from other files changed in the same commit
+// to their origin. Pass it up to three times to also search older commits and all files
+func BlameWithDetectCopies() Option {
+	return WithArgs("-C")
+}
+
+// BlameWithIgnoreRev blames changes made by rev on the commits before it
+func BlameWithIgnoreRev(rev string) Option {
+	return WithArgs("--ignore-rev", rev)
+}
+
+// BlameWithIgnoreRevsFile ignores the revisions listed in file (e.g. .git-blame-ignore-revs)
+func BlameWithIgnoreRevsFile(file string) Option {
+	return WithArgs("--ignore-revs-file", file)
+}
+
+// BlameWithIncremental reports ranges of lines as git finds them, without their content
+func BlameWithIncremental() Option {
+	return WithArgs("--incremental")
+}
+
+// Branch-specific options
+
+// ListBranchesWithRemotes lists remote-tracking branches instead of local branches
+func ListBranchesWithRemotes() Option {
+	return WithArgs(remoteBranchPrefix)
+}
+
+// ListBranchesWithAll lists both local and remote-tracking branches
+func ListBranchesWithAll() Option {
+	return WithArgs(localBranchPrefix, remoteBranchPrefix)
+}
+
+// ListBranchesWithPattern lists only branches whose short name, such as
+// "feature/*" or "origin/release-*", matches one of the patterns
+func ListBranchesWithPattern(patterns ...string) Option {
+	args := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		args = append(args, branchPatternArg+pattern)
+	}
+	return WithArgs(args...)
+}
+
+// ListBranchesWithSort sorts branches by a for-each-ref field, such as
+// "committerdate" or "version:refname"; prefix it with "-" for descending
+// order. Later sort keys take precedence
+func ListBranchesWithSort(key string) Option {
+	return WithArgs("--sort=" + key)
+}
+
+// ListBranchesWithMerged lists only branches reachable from commit
+func ListBranchesWithMerged(commit string) Option {
+	return WithArgs("--merged=" + commit)
+}
+
+// ListBranchesWithNoMerged lists only branches not reachable from commit
+func ListBranchesWithNoMerged(commit string) Option {
+	return WithArgs("--no-merged=" + commit)
+}
+
+// ListBranchesWithContains lists only branches containing commit
+func ListBranchesWithContains(commit string) Option {
+	return WithArgs("--contains=" + commit)
+}
+
+// ListBranchesWithNoContains lists only branches not containing commit
+func ListBranchesWithNoContains(commit string) Option {
+	return WithArgs("--no-contains=" + commit)
+}
+
+// CreateBranchWithStartPoint creates the branch at a commit, branch or tag
+// instead of HEAD
+func CreateBranchWithStartPoint(startPoint string) Option {
+	return WithArgs(startPoint)
+}
+
+// CreateBranchWithTrack sets the start point, which must be a branch, as the
+// upstream of the new branch
+func CreateBranchWithTrack() Option {
+	return WithArgs("--track=direct")
+}
+
+// CreateBranchWithTrackInherit copies the upstream of the start point branch
+func CreateBranchWithTrackInherit() Option {
+	return WithArgs("--track=inherit")
+}
+
+// CreateBranchWithNoTrack sets no upstream, even when branch.autoSetupMerge
+// would set one
+func CreateBranchWithNoTrack() Option {
+	return WithArgs("--no-track")
+}
+
+// CreateBranchWithForce resets the branch to the start point if it already exists
+func CreateBranchWithForce() Option {
+	return WithArgs("--force")
+}
+
+// DeleteBranchWithForce deletes the branch even if it is not fully merged
+func DeleteBranchWithForce() Option {
+	return WithArgs("--force")
+}
+
+// DeleteBranchWithRemoteTracking deletes a remote-tracking branch, such as
+// "origin/feature", instead of a local branch. The branch on the remote is
+// kept; use DeleteRemoteBranch to remove it there
+func DeleteBranchWithRemoteTracking() Option {
+	return WithArgs("--remotes")
+}
+
+// RenameBranchWithForce renames the branch even if the new name already exists
+func RenameBranchWithForce() Option {
+	return WithArgs("--force")
+}
+
+// CopyBranchWithForce copies the branch even if the destination already exists
+func CopyBranchWithForce() Option {
+	return WithArgs("--force")
+}
+
+// Tag-specific options
+
+// TagWithMessage creates an annotated tag with message
+func TagWithMessage(message string) Option {
+	return WithArgs("--message", message)
+}
+
+// TagWithSign creates a signed annotated tag with the default key, using
+// gpg.format to choose between GPG and SSH. Combine with TagWithMessage,
+// otherwise git opens an editor for the message
+func TagWithSign() Option {
+	return WithArgs("--sign")
+}
+
+// TagWithLocalUser creates a signed annotated tag with the given GPG key ID
+// or SSH key file
+func TagWithLocalUser(key string) Option {
+	return WithArgs("--local-user=" + key)
+}
+
+// TagWithTarget tags a commit or other object instead of HEAD
+func TagWithTarget(object string) Option {
+	return WithArgs(object)
+}
+
+// TagWithForce replaces an existing tag with the same name
+func TagWithForce() Option {
+	return WithArgs("--force")
+}
+
+// ListTagsWithPattern lists only tags whose name, such as "v1.*", matches
+// one of the patterns
+func ListTagsWithPattern(patterns ...string) Option {
+	return func(c Command) {
+		for _, pattern := range patterns {
+			c.AddArgs(tagPrefix + pattern)
+		}
+	}
+}
+
+// ListTagsWithSort sorts tags by a for-each-ref field, such as
+// "version:refname" or "creatordate"; prefix it with "-" for descending
+// order. Later sort keys take precedence
+func ListTagsWithSort(key string) Option {
+	return WithArgs("--sort=" + key)
+}
+
+// ListTagsWithContains lists only tags containing commit
+func ListTagsWithContains(commit string) Option {
+	return WithArgs("--contains=" + commit)
+}
+
+// ListTagsWithNoContains lists only tags not containing commit
+func ListTagsWithNoContains(commit string) Option {
+	return WithArgs("--no-contains=" + commit)
+}
+
+// ListTagsWithVerify verifies the signature of every signed tag and fills in
+// Signature. A signature that cannot be checked is reported as
+// SignatureStatusUnverifiable instead of failing the listing
+func ListTagsWithVerify() Option {
+	return WithArgs(verifyTagsArg)
+}
+
+// ListTagsWithPointsAt lists only tags pointing at object, directly or
+// through an annotated tag
+func ListTagsWithPointsAt(object string) Option {
+	return WithArgs("--points-at=" + object)
+}
+
+// Fetch-specific options
+
+// FetchWithRemote fetches from the given remote, optionally limited to refspecs
+func FetchWithRemote(remote string, refspecs ...string) Option {
+	return func(c Command) {
+		c.AddArgs(remote)
+		c.AddArgs(refspecs...)
+	}
+}
+
+// FetchWithAll fetches all remotes
+func FetchWithAll() Option {
+	return WithArgs("--all")
+}
+
+// FetchWithPrune removes remote-tracking refs that no longer exist on the remote
+func FetchWithPrune() Option {
+	return WithArgs("--prune")
+}
+
+// FetchWithTags fetches all tags from the remote
+func FetchWithTags() Option {
+	return WithArgs("--tags")
+}
+
+// FetchWithForce allows updating refs that are not fast-forwards
+func FetchWithForce() Option {
+	return WithArgs("--force")
+}
+
+// FetchWithDepth limits fetching to the specified number of commits
+func FetchWithDepth(depth int) Option {
+	return WithArgs("--depth", fmt.Sprintf("%d", depth))
+}
+
+// Push-specific options
+
+// PushWithRemote pushes to the given remote, optionally limited to refspecs
+func PushWithRemote(remote string, refspecs ...string) Option {
+	return func(c Command) {
+		c.AddArgs(remote)
+		c.AddArgs(refspecs...)
+	}
+}
+
+// PushWithForce allows the remote refs to be overwritten
+func PushWithForce() Option {
+	return WithArgs("--force")
+}
+
+// PushWithForceWithLease overwrites remote refs only if they still match the local remote-tracking refs
+func PushWithForceWithLease() Option {
+	return WithArgs("--force-with-lease")
+}
+
+// PushWithSetUpstream sets the upstream of the pushed branches
+func PushWithSetUpstream() Option {
+	return WithArgs("--set-upstream")
+}
+
+// PushWithAtomic updates either all refs on the remote or none of them
+func PushWithAtomic() Option {
+	return WithArgs("--atomic")
+}
+
+// PushWithDelete deletes the refs given with PushWithRemote from the remote
+func PushWithDelete() Option {
+	return WithArgs("--delete")
+}
+
+// PushWithDryRun shows what would be pushed without updating the remote
+func PushWithDryRun() Option {
+	return WithArgs("--dry-run")
+}
+
 // Init-specific options
 
 // InitWithBare creates a bare repository
@@ -575,6 +1358,18 @@ func CloneWithSingleBranch() Option {
 	return WithArgs("--single-branch")
 }
 
+// CloneWithRecurseSubmodules initializes and clones submodules after cloning
+func CloneWithRecurseSubmodules() Option {
+	return WithArgs("--recurse-submodules")
+}
+
+// Pull-specific options
+
+// PullWithRecurseSubmodules also updates submodules to the fetched commits
+func PullWithRecurseSubmodules() Option {
+	return WithArgs("--recurse-submodules")
+}
+
 // Config-specific options
 
 // ConfigWithLocalScope operates on repository-specific config
@@ -410,7 +410,9 @@ func TestDiffCommand(t *testing.T) {
 	diffs, err := gitInstance.Diff()
 	require.NoError(t, err)
 	
-	// Current implementation returns empty slice, but it shouldn't error
-	// This tests that the interface works
-	assert.NotNil(t, diffs)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "README.md", diffs[0].NewFile)
+	assert.Equal(t, "modified", string(diffs[0].Status))
+	require.Len(t, diffs[0].Hunks, 1)
+	assert.Contains(t, diffs[0].Contents, "+# Modified Test Repo")
 }
\ No newline at end of file
@@ -0,0 +1,390 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// DefaultConflictMarkerSize is the length of git's conflict markers unless
+// the conflict-marker-size attribute says otherwise
+const DefaultConflictMarkerSize = 7
+
+// Conflicts lists the unmerged files left by a merge, pull, rebase, cherry-pick
+// or revert, with the conflict sections parsed from their working tree content.
+// Files are read through the executor, on the file system git runs on.
+func (g *gitImpl) Conflicts(opts ...Option) ([]types.ConflictFile, error) {
+	conflicts, err := g.listConflicts(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	g.loadConflictSections(conflicts)
+	return conflicts, nil
+}
+
+// ResolveConflicts applies the resolutions and stages every file that no
+// longer contains conflict markers. Files that still do are reported in the
+// result, left unstaged and listed in an errors.UnresolvedConflictsError.
+func (g *gitImpl) ResolveConflicts(resolutions []types.ConflictResolution) ([]types.ConflictResolutionResult, error) {
+	conflicts, err := g.listConflicts()
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]types.ConflictStatus)
+	for _, conflict := range conflicts {
+		statuses[conflict.Path] = conflict.Status
+	}
+
+	results := []types.ConflictResolutionResult{}
+	unresolved := []string{}
+	for _, resolution := range resolutions {
+		result, err := g.resolveConflict(resolution, statuses[resolution.FilePath])
+		results = append(results, result)
+		if err != nil {
+			return results, err
+		}
+		if !result.Staged && !result.Deleted {
+			unresolved = append(unresolved, resolution.FilePath)
+		}
+	}
+
+	if len(unresolved) > 0 {
+		return results, &errors.UnresolvedConflictsError{Paths: unresolved}
+	}
+	return results, nil
+}
+
+// resolveConflict applies a single resolution and stages the file when no
+// conflict markers remain
+func (g *gitImpl) resolveConflict(resolution types.ConflictResolution, status types.ConflictStatus) (types.ConflictResolutionResult, error) {
+	path := resolution.FilePath
+	result := types.ConflictResolutionResult{FilePath: path}
+
+	// Taking a side that has no version of the file removes it
+	removed := resolution.Delete ||
+		(resolution.UseOurs && !conflictHasOurs(status)) ||
+		(resolution.UseTheirs && !conflictHasTheirs(status))
+
+	switch {
+	case removed:
+		cmd := g.newCommand("rm", "--quiet", "--", path)
+		if _, err := cmd.Execute(); err != nil {
+			return result, err
+		}
+		result.Deleted = true
+		return result, nil
+	case resolution.UseOurs:
+		cmd := g.newCommand("checkout", "--ours", "--", path)
+		if _, err := cmd.Execute(); err != nil {
+			return result, err
+		}
+	case resolution.UseTheirs:
+		cmd := g.newCommand("checkout", "--theirs", "--", path)
+		if _, err := cmd.Execute(); err != nil {
+			return result, err
+		}
+	case resolution.Custom && len(resolution.Sections) > 0:
+		resolved, err := g.rewriteConflictSections(path, resolution.Sections)
+		if err != nil {
+			return result, err
+		}
+		result.ResolvedSections = resolved
+	}
+
+	_, sections, err := g.readConflictFile(path)
+	if err != nil {
+		return result, err
+	}
+	result.RemainingSections = len(sections)
+	if result.RemainingSections > 0 {
+		return result, nil
+	}
+
+	cmd := g.newCommand("add", "--", path)
+	if _, err := cmd.Execute(); err != nil {
+		return result, err
+	}
+	result.Staged = true
+	return result, nil
+}
+
+// rewriteConflictSections replaces the selected conflict blocks of a file,
+// markers included, with the supplied resolutions
+func (g *gitImpl) rewriteConflictSections(path string, resolved []types.ResolvedSection) (int, error) {
+	content, sections, err := g.readConflictFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	replacements := make(map[int]string)
+	for _, section := range resolved {
+		if section.SectionIndex < 0 || section.SectionIndex >= len(sections) {
+			return 0, fmt.Errorf("%s: section %d out of range, file has %d conflict sections",
+				path, section.SectionIndex, len(sections))
+		}
+		resolution := section.Resolution
+		if resolution != "" && !strings.HasSuffix(resolution, "\n") {
+			resolution += "\n"
+		}
+		replacements[section.SectionIndex] = resolution
+	}
+
+	lines := strings.SplitAfter(content, "\n")
+	var output strings.Builder
+	line := 0
+	for index, section := range sections {
+		resolution, ok := replacements[index]
+		if !ok {
+			continue
+		}
+		output.WriteString(strings.Join(lines[line:section.StartLine-1], ""))
+		output.WriteString(resolution)
+		line = section.EndLine
+	}
+	output.WriteString(strings.Join(lines[line:], ""))
+
+	if err := g.writeFile(path, output.String()); err != nil {
+		return 0, err
+	}
+	return len(replacements), nil
+}
+
+// readConflictFile reads a file from the working tree and parses its conflict markers
+func (g *gitImpl) readConflictFile(path string) (string, []types.ConflictSection, error) {
+	files, err := g.readFiles(path)
+	if err != nil {
+		return "", nil, err
+	}
+	content, found := files[path]
+	if !found {
+		return "", nil, fmt.Errorf("%s: not a file in the working tree", path)
+	}
+	markerSize := g.conflictMarkerSizes([]string{path})[path]
+	return string(content), ParseConflictMarkers(string(content), markerSize), nil
+}
+
+// conflictHasOurs reports whether our side has a version of the file
+func conflictHasOurs(status types.ConflictStatus) bool {
+	switch status {
+	case types.ConflictStatusDeletedByUs, types.ConflictStatusAddedByThem, types.ConflictStatusBothDeleted:
+		return false
+	}
+	return true
+}
+
+// conflictHasTheirs reports whether their side has a version of the file
+func conflictHasTheirs(status types.ConflictStatus) bool {
+	switch status {
+	case types.ConflictStatusDeletedByThem, types.ConflictStatusAddedByUs, types.ConflictStatusBothDeleted:
+		return false
+	}
+	return true
+}
+
+// listConflicts returns the unmerged paths in the index, classified by the
+// stages present for each path
+func (g *gitImpl) listConflicts(opts ...Option) ([]types.ConflictFile, error) {
+	cmd := g.newCommand("ls-files", "--unmerged", "-z")
+	cmd.ApplyOptions(opts...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := []types.ConflictFile{}
+	stages := make(map[string][4]bool)
+	for _, record := range strings.Split(string(output), "\x00") {
+		// <mode> SP <object> SP <stage> TAB <path>
+		info, path, found := strings.Cut(record, "\t")
+		if !found {
+			continue
+		}
+		fields := strings.Fields(info)
+		if len(fields) != 3 {
+			continue
+		}
+		stage, err := strconv.Atoi(fields[2])
+		if err != nil || stage < 1 || stage > 3 {
+			continue
+		}
+
+		present, seen := stages[path]
+		if !seen {
+			conflicts = append(conflicts, types.ConflictFile{Path: path})
+		}
+		present[stage] = true
+		stages[path] = present
+	}
+
+	for i := range conflicts {
+		present := stages[conflicts[i].Path]
+		conflicts[i].Status = conflictStatusFromStages(present[1], present[2], present[3])
+	}
+
+	return conflicts, nil
+}
+
+// loadConflictSections reads each conflicted file and parses its conflict
+// markers. Files missing from the working tree (e.g. deleted on one side)
+// are left without content.
+func (g *gitImpl) loadConflictSections(conflicts []types.ConflictFile) {
+	if len(conflicts) == 0 {
+		return
+	}
+
+	paths := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		paths = append(paths, conflict.Path)
+	}
+	markerSizes := g.conflictMarkerSizes(paths)
+	files, err := g.readFiles(paths...)
+	if err != nil {
+		return
+	}
+
+	for i := range conflicts {
+		content, found := files[conflicts[i].Path]
+		if !found {
+			continue
+		}
+		conflicts[i].Content = content
+		conflicts[i].Sections = ParseConflictMarkers(conflicts[i].Content, markerSizes[conflicts[i].Path])
+	}
+}
+
+// conflictMarkerSizes looks up the conflict-marker-size attribute of each path
+func (g *gitImpl) conflictMarkerSizes(paths []string) map[string]int {
+	sizes := make(map[string]int)
+
+	cmd := g.newCommand("check-attr", "-z", "conflict-marker-size", "--")
+	cmd.AddArgs(paths...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return sizes
+	}
+
+	// <path> NUL <attribute> NUL <value> NUL
+	fields := strings.Split(string(output), "\x00")
+	for i := 0; i+2 < len(fields); i += 3 {
+		if size, err := strconv.Atoi(fields[i+2]); err == nil && size > 0 {
+			sizes[fields[i]] = size
+		}
+	}
+	return sizes
+}
+
+// conflictStatusFromStages classifies a conflict from the presence of the
+// base (1), ours (2) and theirs (3) index stages
+func conflictStatusFromStages(base, ours, theirs bool) types.ConflictStatus {
+	switch {
+	case ours && theirs && !base:
+		return types.ConflictStatusBothAdded
+	case ours && !theirs && base:
+		return types.ConflictStatusDeletedByThem
+	case !ours && theirs && base:
+		return types.ConflictStatusDeletedByUs
+	case ours && !theirs:
+		return types.ConflictStatusAddedByUs
+	case !ours && theirs:
+		return types.ConflictStatusAddedByThem
+	case !ours && !theirs:
+		return types.ConflictStatusBothDeleted
+	default:
+		return types.ConflictStatusBothModified
+	}
+}
+
+// conflictState tracks which part of a conflict block is being read
+type conflictState int
+
+const (
+	conflictOutside conflictState = iota
+	conflictOurs
+	conflictBase
+	conflictTheirs
+)
+
+// ParseConflictMarkers returns the conflict sections of a file containing
+// conflict markers, in the merge, diff3 or zdiff3 style. markerSize is the
+// marker length (the conflict-marker-size attribute); values <= 0 use the
+// default of 7. Markers of any other length, such as those of nested
+// conflicts from recursive merges, are treated as content. Line numbers are
+// 1-based and point at the opening and closing markers.
+func ParseConflictMarkers(content string, markerSize int) []types.ConflictSection {
+	if markerSize <= 0 {
+		markerSize = DefaultConflictMarkerSize
+	}
+
+	sections := []types.ConflictSection{}
+	var section types.ConflictSection
+	var ours, base, theirs strings.Builder
+	state := conflictOutside
+
+	for i, line := range strings.SplitAfter(content, "\n") {
+		if line == "" {
+			continue
+		}
+
+		switch state {
+		case conflictOutside:
+			if label, ok := conflictMarker(line, '<', markerSize); ok {
+				section = types.ConflictSection{StartLine: i + 1, OurLabel: label}
+				ours.Reset()
+				base.Reset()
+				theirs.Reset()
+				state = conflictOurs
+			}
+		case conflictOurs, conflictBase:
+			if label, ok := conflictMarker(line, '|', markerSize); ok && state == conflictOurs {
+				section.BaseLabel = label
+				section.HasBase = true
+				state = conflictBase
+			} else if label, ok := conflictMarker(line, '=', markerSize); ok && label == "" {
+				state = conflictTheirs
+			} else if state == conflictOurs {
+				ours.WriteString(line)
+			} else {
+				base.WriteString(line)
+			}
+		case conflictTheirs:
+			if label, ok := conflictMarker(line, '>', markerSize); ok {
+				section.EndLine = i + 1
+				section.TheirLabel = label
+				section.OurContent = ours.String()
+				section.BaseContent = base.String()
+				section.TheirContent = theirs.String()
+				sections = append(sections, section)
+				state = conflictOutside
+			} else {
+				theirs.WriteString(line)
+			}
+		}
+	}
+
+	return sections
+}
+
+// conflictMarker reports whether line is a conflict marker made of exactly
+// size copies of ch, returning the label that follows it
+func conflictMarker(line string, ch byte, size int) (string, bool) {
+	line = strings.TrimRight(line, "\r\n")
+	if len(line) < size {
+		return "", false
+	}
+	for i := 0; i < size; i++ {
+		if line[i] != ch {
+			return "", false
+		}
+	}
+	if len(line) == size {
+		return "", true
+	}
+	if line[size] != ' ' {
+		return "", false
+	}
+	return line[size+1:], true
+}
@@ -0,0 +1,315 @@
+package git_test
+
+import (
+	stderrors "errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test parsing conflict markers in the supported conflict styles
+func TestParseConflictMarkers(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		markerSize int
+		expected   []types.ConflictSection
+	}{
+		{
+			name: "merge style",
+			content: "header\n" +
+				"<<<<<<< HEAD\n" +
+				"ours\n" +
+				"=======\n" +
+				"theirs\n" +
+				"more theirs\n" +
+				">>>>>>> feature\n" +
+				"footer\n",
+			expected: []types.ConflictSection{{
+				StartLine:    2,
+				EndLine:      7,
+				OurContent:   "ours\n",
+				TheirContent: "theirs\nmore theirs\n",
+				OurLabel:     "HEAD",
+				TheirLabel:   "feature",
+			}},
+		},
+		{
+			name: "diff3 style with two sections",
+			content: "<<<<<<< HEAD\n" +
+				"a1\n" +
+				"||||||| merged common ancestors\n" +
+				"a0\n" +
+				"=======\n" +
+				"a2\n" +
+				">>>>>>> feature\n" +
+				"middle\n" +
+				"<<<<<<< HEAD\n" +
+				"=======\n" +
+				"b2\n" +
+				">>>>>>> feature\n",
+			expected: []types.ConflictSection{
+				{
+					StartLine:    1,
+					EndLine:      7,
+					OurContent:   "a1\n",
+					BaseContent:  "a0\n",
+					TheirContent: "a2\n",
+					HasBase:      true,
+					OurLabel:     "HEAD",
+					BaseLabel:    "merged common ancestors",
+					TheirLabel:   "feature",
+				},
+				{
+					StartLine:    9,
+					EndLine:      12,
+					TheirContent: "b2\n",
+					OurLabel:     "HEAD",
+					TheirLabel:   "feature",
+				},
+			},
+		},
+		{
+			name: "custom marker size keeps shorter markers as content",
+			content: "<<<<<<<<<< ours\r\n" +
+				"<<<<<<< inner\r\n" +
+				"=======\r\n" +
+				"==========\r\n" +
+				"theirs\r\n" +
+				">>>>>>>>>> theirs\r\n",
+			markerSize: 10,
+			expected: []types.ConflictSection{{
+				StartLine:    1,
+				EndLine:      6,
+				OurContent:   "<<<<<<< inner\r\n=======\r\n",
+				TheirContent: "theirs\r\n",
+				OurLabel:     "ours",
+				TheirLabel:   "theirs",
+			}},
+		},
+		{
+			name:     "unterminated section",
+			content:  "<<<<<<< HEAD\nours\n=======\ntheirs\n",
+			expected: []types.ConflictSection{},
+		},
+		{
+			name:     "no markers",
+			content:  "<<<<<<<< not a marker\n======= neither\n",
+			expected: []types.ConflictSection{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, git.ParseConflictMarkers(tt.content, tt.markerSize))
+		})
+	}
+}
+
+// setupConflict creates a repository where merging "feature" into main
+// conflicts in each of the given files
+func setupConflict(t *testing.T, files ...string) (string, git.Git) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	writeAll := func(content string) {
+		for _, file := range files {
+			require.NoError(t, os.WriteFile(filepath.Join(tempDir, file), []byte(content), 0644))
+		}
+		require.NoError(t, gitInstance.Add(files))
+	}
+
+	writeAll("one\nbase\nthree\n")
+	require.NoError(t, gitInstance.Commit("Base"))
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+
+	writeAll("one\nmain\nthree\n")
+	require.NoError(t, gitInstance.Commit("Main change"))
+
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	writeAll("one\nfeature\nthree\n")
+	require.NoError(t, gitInstance.Commit("Feature change"))
+
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+	return tempDir, gitInstance
+}
+
+// Test conflicted merges include the parsed sections of every file
+func TestMergeConflictSections(t *testing.T) {
+	tempDir, gitInstance := setupConflict(t, "plain.txt", "wide.txt")
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, ".gitattributes"), []byte("wide.txt conflict-marker-size=12\n"), 0644))
+
+	result, err := gitInstance.Merge(git.MergeWithBranch("feature"), git.WithConflictStyle("diff3"))
+	require.NoError(t, err)
+	require.False(t, result.Success)
+	require.Len(t, result.Conflicts, 2)
+
+	for _, conflict := range result.Conflicts {
+		require.Len(t, conflict.Sections, 1, conflict.Path)
+		section := conflict.Sections[0]
+		assert.Equal(t, 2, section.StartLine)
+		assert.Equal(t, 8, section.EndLine)
+		assert.Equal(t, "main\n", section.OurContent)
+		assert.Equal(t, "base\n", section.BaseContent)
+		assert.Equal(t, "feature\n", section.TheirContent)
+		assert.True(t, section.HasBase)
+		assert.Equal(t, "HEAD", section.OurLabel)
+		assert.Equal(t, "feature", section.TheirLabel)
+		assert.Contains(t, conflict.Content, "|||||||")
+	}
+	assert.Contains(t, result.Conflicts[1].Content, strings.Repeat("<", 12)+" HEAD")
+
+	// The same information is available after the fact
+	conflicts, err := gitInstance.Conflicts()
+	require.NoError(t, err)
+	assert.Equal(t, result.Conflicts, conflicts)
+
+	require.NoError(t, gitInstance.MergeAbort())
+	conflicts, err = gitInstance.Conflicts()
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+}
+
+// Test conflicted files are read where the executor runs git
+func TestConflictsRemoteExecutor(t *testing.T) {
+	tempDir, gitInstance := setupConflict(t, "plain.txt", "other.txt")
+	result, err := gitInstance.Merge(git.MergeWithBranch("feature"))
+	require.NoError(t, err)
+	require.Len(t, result.Conflicts, 2)
+
+	remote := openRemoteRepo(t, tempDir)
+	conflicts, err := remote.Conflicts()
+	require.NoError(t, err)
+	assert.Equal(t, result.Conflicts, conflicts)
+	require.Len(t, conflicts[1].Sections, 1)
+	assert.Equal(t, "feature\n", conflicts[1].Sections[0].TheirContent)
+}
+
+// Test resolving individual sections and refusing files with markers left
+func TestResolveConflictSections(t *testing.T) {
+	tempDir, gitInstance := setupConflict(t, "custom.txt", "untouched.txt", "theirs.txt")
+
+	result, err := gitInstance.Merge(git.MergeWithBranch("feature"))
+	require.NoError(t, err)
+	require.Len(t, result.Conflicts, 3)
+
+	report, err := gitInstance.ResolveConflicts([]types.ConflictResolution{
+		{
+			FilePath: "custom.txt",
+			Custom:   true,
+			Sections: []types.ResolvedSection{{SectionIndex: 0, Resolution: "main and feature"}},
+		},
+		{FilePath: "untouched.txt", Custom: true},
+		{FilePath: "theirs.txt", UseTheirs: true},
+	})
+	var unresolved *errors.UnresolvedConflictsError
+	require.True(t, stderrors.As(err, &unresolved), "expected UnresolvedConflictsError, got %v", err)
+	assert.Equal(t, []string{"untouched.txt"}, unresolved.Paths)
+	assert.Equal(t, []types.ConflictResolutionResult{
+		{FilePath: "custom.txt", Staged: true, ResolvedSections: 1},
+		{FilePath: "untouched.txt", RemainingSections: 1},
+		{FilePath: "theirs.txt", Staged: true},
+	}, report)
+
+	content, err := os.ReadFile(filepath.Join(tempDir, "custom.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "one\nmain and feature\nthree\n", string(content))
+	content, err = os.ReadFile(filepath.Join(tempDir, "theirs.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "one\nfeature\nthree\n", string(content))
+
+	conflicts, err := gitInstance.Conflicts()
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "untouched.txt", conflicts[0].Path)
+
+	_, err = gitInstance.ResolveConflicts([]types.ConflictResolution{{
+		FilePath: "untouched.txt",
+		Custom:   true,
+		Sections: []types.ResolvedSection{{SectionIndex: 1, Resolution: "x"}},
+	}})
+	assert.ErrorContains(t, err, "out of range")
+}
+
+// Test resolutions are written where the executor runs git
+func TestResolveConflictsRemoteExecutor(t *testing.T) {
+	tempDir, gitInstance := setupConflict(t, "custom.txt", "script.sh")
+	_, err := gitInstance.Merge(git.MergeWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, os.Chmod(filepath.Join(tempDir, "script.sh"), 0755))
+
+	remote := openRemoteRepo(t, tempDir)
+	report, err := remote.ResolveConflicts([]types.ConflictResolution{
+		{
+			FilePath: "custom.txt",
+			Custom:   true,
+			Sections: []types.ResolvedSection{{SectionIndex: 0, Resolution: "resolved remotely"}},
+		},
+		{
+			FilePath: "script.sh",
+			Custom:   true,
+			Sections: []types.ResolvedSection{{SectionIndex: 0, Resolution: "echo resolved"}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ConflictResolutionResult{
+		{FilePath: "custom.txt", Staged: true, ResolvedSections: 1},
+		{FilePath: "script.sh", Staged: true, ResolvedSections: 1},
+	}, report)
+
+	content, err := os.ReadFile(filepath.Join(tempDir, "custom.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "one\nresolved remotely\nthree\n", string(content))
+	info, err := os.Stat(filepath.Join(tempDir, "script.sh"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	conflicts, err := remote.Conflicts()
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+}
+
+// Test delete/modify conflicts can be resolved by removing the file
+func TestResolveConflictDeletions(t *testing.T) {
+	tempDir, gitInstance := setupConflict(t, "kept.txt", "dropped.txt")
+
+	// Feature deletes both files, main modified them
+	_, err := gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Remove(git.WithArgs("kept.txt", "dropped.txt")))
+	require.NoError(t, gitInstance.Commit("Delete files"))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+
+	result, err := gitInstance.Merge(git.MergeWithBranch("feature"))
+	require.NoError(t, err)
+	require.Len(t, result.Conflicts, 2)
+	for _, conflict := range result.Conflicts {
+		assert.Equal(t, types.ConflictStatusDeletedByThem, conflict.Status)
+	}
+
+	report, err := gitInstance.ResolveConflicts([]types.ConflictResolution{
+		{FilePath: "kept.txt", UseOurs: true},
+		{FilePath: "dropped.txt", UseTheirs: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []types.ConflictResolutionResult{
+		{FilePath: "kept.txt", Staged: true},
+		{FilePath: "dropped.txt", Deleted: true},
+	}, report)
+
+	assert.FileExists(t, filepath.Join(tempDir, "kept.txt"))
+	assert.NoFileExists(t, filepath.Join(tempDir, "dropped.txt"))
+	require.NoError(t, gitInstance.Commit("Merge feature"))
+}
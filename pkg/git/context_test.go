@@ -0,0 +1,123 @@
+package git_test
+
+import (
+	"context"
+	stderrors "errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that a cancelled context stops commands before they run
+func TestWithContextCanceled(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = gitInstance.WithContext(ctx).Status()
+	require.Error(t, err)
+
+	var canceledErr *errors.CanceledError
+	require.True(t, stderrors.As(err, &canceledErr), "expected CanceledError, got %T", err)
+	assert.True(t, stderrors.Is(err, context.Canceled))
+	assert.Equal(t, "status", canceledErr.Command[0])
+
+	// The original instance is not bound to the cancelled context
+	_, err = gitInstance.Status()
+	assert.NoError(t, err)
+}
+
+// Test that a deadline kills a hung git process including its children
+func TestWithContextDeadline(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook scripts require a POSIX shell")
+	}
+
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	// A pre-commit hook that never finishes in time
+	hook := filepath.Join(tempDir, ".git", "hooks", "pre-commit")
+	err = os.WriteFile(hook, []byte("#!/bin/sh\nsleep 30\n"), 0755)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(tempDir, "hang.txt"), []byte("hang"), 0644)
+	require.NoError(t, err)
+	err = gitInstance.Add([]string{"hang.txt"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = gitInstance.WithContext(ctx).Commit("should not be created")
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), 10*time.Second, "hook process was not killed")
+
+	var canceledErr *errors.CanceledError
+	require.True(t, stderrors.As(err, &canceledErr), "expected CanceledError, got %T", err)
+	assert.True(t, stderrors.Is(err, context.DeadlineExceeded))
+}
+
+// Test that sessions keep their behaviour when bound to a context
+func TestSessionWithContext(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	session, err := git.NewSession(sessionDir, git.SessionWithUser("Context User", "context@example.com"))
+	require.NoError(t, err)
+
+	bound, ok := session.WithContext(context.Background()).(git.Session)
+	require.True(t, ok, "session bound to a context should still be a Session")
+	assert.Equal(t, "Context User", bound.GetSessionConfig().UserName)
+
+	err = os.WriteFile(filepath.Join(sessionDir, "file.txt"), []byte("content"), 0644)
+	require.NoError(t, err)
+	err = bound.Add([]string{"file.txt"})
+	require.NoError(t, err)
+	err = bound.Commit("Commit with context")
+	require.NoError(t, err)
+
+	logs, err := bound.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Contains(t, logs[0].Author, "Context User")
+}
+
+// Test that sessions keep their behaviour when bound to a worktree
+func TestSessionWithWorktree(t *testing.T) {
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	session, err := git.NewSession(sessionDir, git.SessionWithUser("Worktree User", "worktree@example.com"))
+	require.NoError(t, err)
+	require.NoError(t, session.Commit("Initial commit", git.CommitWithAllowEmpty()))
+
+	worktreeDir := filepath.Join(t.TempDir(), "review")
+	require.NoError(t, session.WorktreeAdd(worktreeDir, "HEAD", git.WorktreeWithBranch("review")))
+
+	bound, ok := session.WithWorktree(worktreeDir).(git.Session)
+	require.True(t, ok, "session bound to a worktree should still be a Session")
+	assert.Equal(t, worktreeDir, bound.GetSessionConfig().WorkingDirectory)
+	assert.Equal(t, sessionDir, session.GetSessionConfig().WorkingDirectory)
+	assert.Equal(t, "Worktree User", bound.GetSessionConfig().UserName)
+
+	require.NoError(t, os.WriteFile(filepath.Join(worktreeDir, "file.txt"), []byte("content"), 0644))
+	require.NoError(t, bound.Add([]string{"file.txt"}))
+	require.NoError(t, bound.Commit("Commit in worktree"))
+
+	logs, err := session.Log(git.LogWithMaxCount("1"), git.LogWithRevisions("review"))
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, "Commit in worktree", logs[0].Subject)
+	assert.Contains(t, logs[0].Author, "Worktree User")
+}
@@ -1,12 +1,17 @@
 package git
 
 import (
+	"regexp"
+	"strconv"
+	"strings"
+
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
 // Diff shows differences between commits, commit and working tree, etc
 func (g *gitImpl) Diff(opts ...Option) ([]types.Diff, error) {
-	cmd := g.newCommand("diff")
+	cmd := g.newCommand("diff", "--no-color", "--no-ext-diff")
+	cmd.AddArgs(diffPrefixArgs...)
 	cmd.ApplyOptions(opts...)
 	output, err := cmd.Execute()
 	if err != nil {
@@ -15,6 +20,386 @@ func (g *gitImpl) Diff(opts ...Option) ([]types.Diff, error) {
 	if len(output) == 0 {
 		return []types.Diff{}, nil
 	}
-	// Simplified - would need proper diff parsing
-	return []types.Diff{}, nil
-}
\ No newline at end of file
+	return parseDiffOutput(string(output)), nil
+}
+
+// diffPrefixArgs force the a/ and b/ path prefixes parseDiffOutput expects,
+// which the diff.noprefix and diff.mnemonicPrefix settings would change
+var diffPrefixArgs = []string{"--src-prefix=a/", "--dst-prefix=b/"}
+
+var (
+	// Unified "@@ -1,2 +1,3 @@" or combined "@@@ -1,2 -1,2 +1,3 @@@" headers,
+	// with one more @ than there are parents
+	hunkHeaderPattern = regexp.MustCompile(`^(@@+) -(\d+)(?:,(\d+))? (?:-\d+(?:,\d+)? )*\+(\d+)(?:,(\d+))? (@@+) ?(.*)$`)
+	numstatPattern    = regexp.MustCompile(`^(\d+|-)\t(\d+|-)\t(.+)$`)
+	rawPattern        = regexp.MustCompile(`^:(\d{6}) (\d{6}) ([0-9a-f]+)(?:\.\.\.)? ([0-9a-f]+)(?:\.\.\.)? ([A-Z])(\d*)\t(.+)$`)
+)
+
+// diffParser accumulates diff entries while scanning git output
+type diffParser struct {
+	diffs   []types.Diff
+	current int // Index of the file being parsed, -1 outside a patch
+	hunk    *types.DiffHunk
+	parents int // Prefix columns of hunk lines, one per parent
+	oldLine int
+	newLine int
+	oldLeft int
+	newLeft int
+	patch   []string
+}
+
+// parseDiffOutput parses unified diff output, optionally preceded by --raw and
+// --numstat records, into one Diff per file
+func parseDiffOutput(output string) []types.Diff {
+	p := &diffParser{current: -1}
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		p.parseLine(line)
+	}
+	p.finishFile()
+	return p.diffs
+}
+
+func (p *diffParser) parseLine(line string) {
+	switch {
+	case strings.HasPrefix(line, "diff --git "):
+		p.finishFile()
+		oldFile, newFile := splitDiffPaths(strings.TrimPrefix(line, "diff --git "))
+		p.startFile("unified", oldFile, newFile)
+	case strings.HasPrefix(line, "diff --cc "), strings.HasPrefix(line, "diff --combined "):
+		p.finishFile()
+		path := unquoteDiffPath(strings.TrimPrefix(strings.TrimPrefix(line, "diff --cc "), "diff --combined "))
+		p.startFile("combined", path, path)
+	case p.current < 0:
+		p.parseRecord(line)
+		return
+	case p.hunk != nil && (p.oldLeft > 0 || p.newLeft > 0 || strings.HasPrefix(line, "\\")):
+		p.parseHunkLine(line)
+	default:
+		p.parseHeaderLine(line)
+	}
+	if p.current >= 0 {
+		p.patch = append(p.patch, line)
+	}
+}
+
+// parseRecord handles --raw and --numstat lines that precede the patches
+func (p *diffParser) parseRecord(line string) {
+	if m := rawPattern.FindStringSubmatch(line); m != nil {
+		paths := strings.SplitN(m[7], "\t", 2)
+		oldFile := unquoteDiffPath(paths[0])
+		newFile := oldFile
+		if len(paths) == 2 {
+			newFile = unquoteDiffPath(paths[1])
+		}
+		d := p.find(oldFile, newFile, "raw")
+		d.Status = rawDiffStatus(m[5])
+		oldMode, newMode := parseMode(m[1]), parseMode(m[2])
+		switch m[5] {
+		case "A":
+			d.Header.NewFileMode = newMode
+		case "D":
+			d.Header.DeletedFileMode = oldMode
+		default:
+			d.Header.OldMode = oldMode
+			d.Header.NewMode = newMode
+		}
+		index := m[3] + ".." + m[4]
+		d.Header.Index = &index
+		if m[6] != "" {
+			score, _ := strconv.Atoi(m[6])
+			d.Header.SimilarityIndex = &score
+		}
+		switch m[5] {
+		case "R":
+			d.Header.RenameFrom, d.Header.RenameTo = &oldFile, &newFile
+		case "C":
+			d.Header.CopyFrom, d.Header.CopyTo = &oldFile, &newFile
+		}
+		return
+	}
+
+	if m := numstatPattern.FindStringSubmatch(line); m != nil {
+		oldFile, newFile := splitRenamePath(m[3])
+		d := p.find(oldFile, newFile, "numstat")
+		stat := &types.DiffStat{File: newFile}
+		if m[1] == "-" && m[2] == "-" {
+			d.Binary = true
+		} else {
+			stat.Insertions, _ = strconv.Atoi(m[1])
+			stat.Deletions, _ = strconv.Atoi(m[2])
+			stat.Changes = stat.Insertions + stat.Deletions
+		}
+		d.Stat = stat
+	}
+}
+
+// parseHeaderLine handles the extended header lines between "diff --git" and the first hunk
+func (p *diffParser) parseHeaderLine(line string) {
+	d := &p.diffs[p.current]
+	switch {
+	case strings.HasPrefix(line, "@@"):
+		m := hunkHeaderPattern.FindStringSubmatch(line)
+		if m == nil || m[1] != m[6] {
+			return
+		}
+		hunk := types.DiffHunk{
+			OldStart: atoiDefault(m[2], 0),
+			OldLines: atoiDefault(m[3], 1),
+			NewStart: atoiDefault(m[4], 0),
+			NewLines: atoiDefault(m[5], 1),
+			Section:  m[7],
+		}
+		d.Hunks = append(d.Hunks, hunk)
+		p.hunk = &d.Hunks[len(d.Hunks)-1]
+		p.parents = len(m[1]) - 1
+		p.oldLine, p.newLine = hunk.OldStart, hunk.NewStart
+		p.oldLeft, p.newLeft = hunk.OldLines, hunk.NewLines
+	case strings.HasPrefix(line, "old mode "):
+		d.Header.OldMode = parseMode(strings.TrimPrefix(line, "old mode "))
+	case strings.HasPrefix(line, "new mode "):
+		d.Header.NewMode = parseMode(strings.TrimPrefix(line, "new mode "))
+	case strings.HasPrefix(line, "deleted file mode "):
+		d.Header.DeletedFileMode = parseMode(strings.TrimPrefix(line, "deleted file mode "))
+		d.Status = types.FileStatusDeleted
+	case strings.HasPrefix(line, "new file mode "):
+		d.Header.NewFileMode = parseMode(strings.TrimPrefix(line, "new file mode "))
+		d.Status = types.FileStatusAdded
+	case strings.HasPrefix(line, "copy from "):
+		from := unquoteDiffPath(strings.TrimPrefix(line, "copy from "))
+		d.Header.CopyFrom, d.OldFile = &from, from
+		d.Status = types.FileStatusCopied
+	case strings.HasPrefix(line, "copy to "):
+		to := unquoteDiffPath(strings.TrimPrefix(line, "copy to "))
+		d.Header.CopyTo, d.NewFile = &to, to
+	case strings.HasPrefix(line, "rename from "):
+		from := unquoteDiffPath(strings.TrimPrefix(line, "rename from "))
+		d.Header.RenameFrom, d.OldFile = &from, from
+		d.Status = types.FileStatusRenamed
+	case strings.HasPrefix(line, "rename to "):
+		to := unquoteDiffPath(strings.TrimPrefix(line, "rename to "))
+		d.Header.RenameTo, d.NewFile = &to, to
+	case strings.HasPrefix(line, "similarity index "):
+		score := atoiDefault(strings.TrimSuffix(strings.TrimPrefix(line, "similarity index "), "%"), 0)
+		d.Header.SimilarityIndex = &score
+	case strings.HasPrefix(line, "dissimilarity index "):
+		score := atoiDefault(strings.TrimSuffix(strings.TrimPrefix(line, "dissimilarity index "), "%"), 0)
+		d.Header.DissimilarityIndex = &score
+	case strings.HasPrefix(line, "index "):
+		fields := strings.Fields(strings.TrimPrefix(line, "index "))
+		if len(fields) > 0 {
+			index := fields[0]
+			d.Header.Index = &index
+		}
+		if len(fields) > 1 && d.Header.OldMode == nil && d.Header.NewMode == nil {
+			d.Header.OldMode = parseMode(fields[1])
+			d.Header.NewMode = parseMode(fields[1])
+		}
+	case strings.HasPrefix(line, "--- "):
+		if path := stripDiffPrefix(unquoteDiffPath(strings.TrimSuffix(line[4:], "\t")), "a/"); path != "/dev/null" {
+			d.OldFile = path
+		}
+	case strings.HasPrefix(line, "+++ "):
+		if path := stripDiffPrefix(unquoteDiffPath(strings.TrimSuffix(line[4:], "\t")), "b/"); path != "/dev/null" {
+			d.NewFile = path
+		}
+	case strings.HasPrefix(line, "Binary files "), line == "GIT binary patch":
+		d.Binary = true
+	}
+}
+
+// parseHunkLine records a single line of hunk content. Lines of combined
+// diffs start with a column per parent: "-" in a column marks a line of that
+// parent missing from the result, "+" a line of the result the parent lacks.
+// Old line numbers follow the first parent
+func (p *diffParser) parseHunkLine(line string) {
+	if strings.HasPrefix(line, "\\") {
+		// "\ No newline at end of file" applies to the preceding line
+		if n := len(p.hunk.Lines); n > 0 {
+			p.hunk.Lines[n-1].NoNewline = true
+		}
+		return
+	}
+
+	// Some tools strip the space from empty context lines
+	columns := line
+	if len(columns) > p.parents {
+		columns = line[:p.parents]
+	}
+	diffLine := types.DiffLine{Content: strings.TrimPrefix(line, columns)}
+	columns += strings.Repeat(" ", p.parents-len(columns))
+
+	inResult := !strings.Contains(columns, "-")
+	inFirstParent := columns[0] == ' ' && inResult || columns[0] == '-'
+	switch {
+	case !inResult:
+		diffLine.Type = types.DiffLineDeleted
+	case strings.Contains(columns, "+"):
+		diffLine.Type = types.DiffLineAdded
+	default:
+		diffLine.Type = types.DiffLineContext
+	}
+	if inFirstParent {
+		diffLine.OldLine = p.oldLine
+		p.oldLine++
+		p.oldLeft--
+	}
+	if inResult {
+		diffLine.NewLine = p.newLine
+		p.newLine++
+		p.newLeft--
+	}
+	p.hunk.Lines = append(p.hunk.Lines, diffLine)
+}
+
+// startFile begins a patch section, merging it with an earlier raw/numstat record for the same file
+func (p *diffParser) startFile(format, oldFile, newFile string) {
+	d := p.find(oldFile, newFile, format)
+	d.Format = format
+	if d.Status == "" {
+		d.Status = types.FileStatusModified
+	}
+	for i := range p.diffs {
+		if &p.diffs[i] == d {
+			p.current = i
+		}
+	}
+}
+
+// finishFile stores the raw patch text of the current file
+func (p *diffParser) finishFile() {
+	if p.current >= 0 && len(p.patch) > 0 {
+		p.diffs[p.current].Contents = strings.Join(p.patch, "\n") + "\n"
+	}
+	p.current = -1
+	p.hunk = nil
+	p.patch = nil
+}
+
+// find returns the entry for the given paths, creating it if it does not exist yet
+func (p *diffParser) find(oldFile, newFile, format string) *types.Diff {
+	for i := range p.diffs {
+		if p.diffs[i].NewFile == newFile && p.diffs[i].OldFile == oldFile && p.diffs[i].Contents == "" {
+			return &p.diffs[i]
+		}
+	}
+	p.diffs = append(p.diffs, types.Diff{
+		Format:  format,
+		OldFile: oldFile,
+		NewFile: newFile,
+	})
+	return &p.diffs[len(p.diffs)-1]
+}
+
+// splitDiffPaths splits the "a/old b/new" part of a "diff --git" line
+func splitDiffPaths(paths string) (string, string) {
+	var oldFile, newFile string
+	switch {
+	case strings.HasPrefix(paths, `"`):
+		end := closingQuote(paths)
+		oldFile = unquoteDiffPath(paths[:end+1])
+		newFile = unquoteDiffPath(strings.TrimPrefix(paths[end+1:], " "))
+	case strings.Contains(paths, ` "`):
+		i := strings.Index(paths, ` "`)
+		oldFile, newFile = paths[:i], unquoteDiffPath(paths[i+1:])
+	case len(paths)%2 == 1 && paths[:len(paths)/2][1:] == paths[len(paths)/2+1:][1:]:
+		// Unchanged path: both halves are equal apart from the prefix
+		oldFile, newFile = paths[:len(paths)/2], paths[len(paths)/2+1:]
+	default:
+		// Renames are corrected later by the rename/---/+++ headers
+		if i := strings.Index(paths, " b/"); i >= 0 {
+			oldFile, newFile = paths[:i], paths[i+1:]
+		} else {
+			oldFile, newFile = paths, paths
+		}
+	}
+	return stripDiffPrefix(oldFile, "a/"), stripDiffPrefix(newFile, "b/")
+}
+
+// splitRenamePath expands numstat rename notation ("old => new" or "dir/{old => new}/file")
+func splitRenamePath(path string) (string, string) {
+	if !strings.Contains(path, " => ") {
+		path = unquoteDiffPath(path)
+		return path, path
+	}
+	start, end := strings.Index(path, "{"), strings.LastIndex(path, "}")
+	if start >= 0 && end > start {
+		parts := strings.SplitN(path[start+1:end], " => ", 2)
+		if len(parts) == 2 {
+			prefix, suffix := path[:start], path[end+1:]
+			oldFile := strings.Replace(prefix+parts[0]+suffix, "//", "/", 1)
+			newFile := strings.Replace(prefix+parts[1]+suffix, "//", "/", 1)
+			return strings.TrimPrefix(oldFile, "/"), strings.TrimPrefix(newFile, "/")
+		}
+	}
+	parts := strings.SplitN(path, " => ", 2)
+	return unquoteDiffPath(parts[0]), unquoteDiffPath(parts[1])
+}
+
+// closingQuote returns the index of the quote that ends the C-style quoted string at s[0]
+func closingQuote(s string) int {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return len(s) - 1
+}
+
+// unquoteDiffPath decodes paths git quoted because they contain special characters
+func unquoteDiffPath(path string) string {
+	if len(path) < 2 || path[0] != '"' || path[len(path)-1] != '"' {
+		return path
+	}
+	if unquoted, err := strconv.Unquote(path); err == nil {
+		return unquoted
+	}
+	return path
+}
+
+// stripDiffPrefix removes the a/ or b/ prefix git adds to paths
+func stripDiffPrefix(path, prefix string) string {
+	return strings.TrimPrefix(path, prefix)
+}
+
+// parseMode parses an octal git file mode
+func parseMode(mode string) *int {
+	value, err := strconv.ParseInt(strings.TrimSpace(mode), 8, 32)
+	if err != nil {
+		return nil
+	}
+	result := int(value)
+	return &result
+}
+
+// rawDiffStatus maps a --raw status letter to a FileStatus
+func rawDiffStatus(status string) types.FileStatus {
+	switch status {
+	case "A":
+		return types.FileStatusAdded
+	case "D":
+		return types.FileStatusDeleted
+	case "M":
+		return types.FileStatusModified
+	case "R":
+		return types.FileStatusRenamed
+	case "C":
+		return types.FileStatusCopied
+	case "T":
+		return types.FileStatusTypeChanged
+	case "U":
+		return types.FileStatusUpdated
+	default:
+		return types.FileStatusUnspecified
+	}
+}
+
+// atoiDefault parses s as an integer, returning def when s is empty or invalid
+func atoiDefault(s string, def int) int {
+	if value, err := strconv.Atoi(s); err == nil {
+		return value
+	}
+	return def
+}
@@ -0,0 +1,192 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test hunk parsing with line numbers
+func TestDiffHunks(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	file := filepath.Join(tempDir, "lines.txt")
+	err = os.WriteFile(file, []byte("one\ntwo\nthree\nfour\nfive\n"), 0644)
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Add([]string{"lines.txt"}))
+	require.NoError(t, gitInstance.Commit("Add lines"))
+
+	err = os.WriteFile(file, []byte("one\n2\nthree\nfour\nfive\nsix"), 0644)
+	require.NoError(t, err)
+
+	diffs, err := gitInstance.Diff(git.DiffWithContextLines(1))
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+
+	diff := diffs[0]
+	assert.Equal(t, "unified", diff.Format)
+	assert.Equal(t, "lines.txt", diff.OldFile)
+	assert.Equal(t, "lines.txt", diff.NewFile)
+	assert.Equal(t, types.FileStatusModified, diff.Status)
+	require.NotNil(t, diff.Header.Index)
+	require.NotNil(t, diff.Header.NewMode)
+	assert.Equal(t, 0100644, *diff.Header.NewMode)
+
+	require.Len(t, diff.Hunks, 2)
+	first := diff.Hunks[0]
+	assert.Equal(t, 1, first.OldStart)
+	assert.Equal(t, 3, first.OldLines)
+	assert.Equal(t, []types.DiffLine{
+		{Type: types.DiffLineContext, Content: "one", OldLine: 1, NewLine: 1},
+		{Type: types.DiffLineDeleted, Content: "two", OldLine: 2},
+		{Type: types.DiffLineAdded, Content: "2", NewLine: 2},
+		{Type: types.DiffLineContext, Content: "three", OldLine: 3, NewLine: 3},
+	}, first.Lines)
+
+	last := diff.Hunks[1].Lines[len(diff.Hunks[1].Lines)-1]
+	assert.Equal(t, types.DiffLineAdded, last.Type)
+	assert.Equal(t, "six", last.Content)
+	assert.Equal(t, 6, last.NewLine)
+	assert.True(t, last.NoNewline)
+}
+
+// Test headers for added, deleted, renamed, mode-changed and binary files
+func TestDiffHeaders(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	content := []byte("line 1\nline 2\nline 3\nline 4\nline 5\n")
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "move me.txt"), content, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "script.sh"), []byte("echo hi\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "delete.txt"), []byte("bye\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{}))
+	require.NoError(t, gitInstance.Commit("Add files"))
+
+	require.NoError(t, os.Rename(filepath.Join(tempDir, "move me.txt"), filepath.Join(tempDir, "moved é.txt")))
+	require.NoError(t, os.Chmod(filepath.Join(tempDir, "script.sh"), 0755))
+	require.NoError(t, os.Remove(filepath.Join(tempDir, "delete.txt")))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "image.bin"), []byte{0, 1, 2, 0, 255}, 0644))
+	require.NoError(t, gitInstance.Add([]string{}, git.AddWithAll()))
+
+	diffs, err := gitInstance.Diff(
+		git.DiffWithStaged(),
+		git.DiffWithRaw(),
+		git.DiffWithNumstat(),
+		git.DiffWithPatch(),
+		git.DiffWithFindRenames(),
+	)
+	require.NoError(t, err)
+
+	byFile := make(map[string]types.Diff)
+	for _, diff := range diffs {
+		byFile[diff.NewFile] = diff
+	}
+	require.Len(t, byFile, 4)
+
+	renamed := byFile["moved é.txt"]
+	assert.Equal(t, types.FileStatusRenamed, renamed.Status)
+	assert.Equal(t, "move me.txt", renamed.OldFile)
+	require.NotNil(t, renamed.Header.RenameFrom)
+	assert.Equal(t, "move me.txt", *renamed.Header.RenameFrom)
+	require.NotNil(t, renamed.Header.SimilarityIndex)
+	assert.Equal(t, 100, *renamed.Header.SimilarityIndex)
+	require.NotNil(t, renamed.Stat)
+	assert.Equal(t, 0, renamed.Stat.Changes)
+
+	script := byFile["script.sh"]
+	assert.Equal(t, types.FileStatusModified, script.Status)
+	require.NotNil(t, script.Header.OldMode)
+	require.NotNil(t, script.Header.NewMode)
+	assert.Equal(t, 0100644, *script.Header.OldMode)
+	assert.Equal(t, 0100755, *script.Header.NewMode)
+
+	deleted := byFile["delete.txt"]
+	assert.Equal(t, types.FileStatusDeleted, deleted.Status)
+	require.NotNil(t, deleted.Header.DeletedFileMode)
+	require.NotNil(t, deleted.Stat)
+	assert.Equal(t, 1, deleted.Stat.Deletions)
+	require.Len(t, deleted.Hunks, 1)
+	assert.Equal(t, types.DiffLineDeleted, deleted.Hunks[0].Lines[0].Type)
+
+	binary := byFile["image.bin"]
+	assert.Equal(t, types.FileStatusAdded, binary.Status)
+	assert.True(t, binary.Binary)
+	assert.Empty(t, binary.Hunks)
+	require.NotNil(t, binary.Header.NewFileMode)
+	assert.Equal(t, 0100644, *binary.Header.NewFileMode)
+}
+
+// Test combined diffs of conflicted and resolved merges
+func TestDiffCombined(t *testing.T) {
+	tempDir, gitInstance := setupConflict(t, "file.txt")
+	result, err := gitInstance.Merge(git.MergeWithBranch("feature"))
+	require.NoError(t, err)
+	require.False(t, result.Success)
+
+	diffs, err := gitInstance.Diff()
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "combined", diffs[0].Format)
+	require.Len(t, diffs[0].Hunks, 1)
+	assert.Equal(t, 7, diffs[0].Hunks[0].NewLines)
+	assert.Len(t, diffs[0].Hunks[0].Lines, 7)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "file.txt"), []byte("one\nresolved\nthree\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"file.txt"}))
+	require.NoError(t, gitInstance.Commit("Merge feature"))
+
+	show, err := gitInstance.Show("HEAD")
+	require.NoError(t, err)
+	require.Len(t, show.Diffs, 1)
+	assert.Equal(t, "combined", show.Diffs[0].Format)
+	assert.Equal(t, "file.txt", show.Diffs[0].NewFile)
+	require.Len(t, show.Diffs[0].Hunks, 1)
+
+	hunk := show.Diffs[0].Hunks[0]
+	assert.Equal(t, 1, hunk.OldStart)
+	assert.Equal(t, 3, hunk.OldLines)
+	assert.Equal(t, 3, hunk.NewLines)
+	assert.Equal(t, []types.DiffLine{
+		{Type: types.DiffLineContext, Content: "one", OldLine: 1, NewLine: 1},
+		{Type: types.DiffLineDeleted, Content: "main", OldLine: 2},
+		{Type: types.DiffLineDeleted, Content: "feature"},
+		{Type: types.DiffLineAdded, Content: "resolved", NewLine: 2},
+		{Type: types.DiffLineContext, Content: "three", OldLine: 3, NewLine: 3},
+	}, hunk.Lines)
+}
+
+// Test paths keep their a/ and b/ prefixes whatever the diff configuration
+func TestDiffPrefixConfig(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("changed\n"), 0644))
+	for _, config := range []string{"diff.noprefix", "diff.mnemonicPrefix"} {
+		require.NoError(t, gitInstance.SetConfig(config, "true"))
+
+		diffs, err := gitInstance.Diff()
+		require.NoError(t, err, config)
+		require.Len(t, diffs, 1, config)
+		assert.Equal(t, "README.md", diffs[0].OldFile, config)
+		assert.Equal(t, "README.md", diffs[0].NewFile, config)
+
+		show, err := gitInstance.Show("HEAD")
+		require.NoError(t, err, config)
+		require.Len(t, show.Diffs, 1, config)
+		assert.Equal(t, "README.md", show.Diffs[0].NewFile, config)
+
+		require.NoError(t, gitInstance.UnsetConfig(config))
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
 var (
 	ErrNotEmptyRepository = errors.New("destination path already exists and is not an empty directory")
 	ErrUnknownRevision    = errors.New("unknown revision or path not in the working tree")
+	ErrBisectNotReady     = errors.New("bisect needs a good and a bad commit before commits can be tested")
+	ErrTagNotSigned       = errors.New("tag has no signature")
 )
 
 // ErrorType represents different categories of Git errors
@@ -40,6 +44,97 @@ func (e *GitError) Error() string {
 		strings.Join(e.Command, " "), e.ExitCode, e.Stderr)
 }
 
+// CanceledError is returned when a git command is interrupted because its
+// context was cancelled or its deadline (including the command timeout) expired
+type CanceledError struct {
+	Command []string
+	Err     error // context.Canceled or context.DeadlineExceeded
+}
+
+// Error implements the error interface
+func (e *CanceledError) Error() string {
+	return fmt.Sprintf("git %s canceled: %v", strings.Join(e.Command, " "), e.Err)
+}
+
+// Unwrap returns the underlying context error
+func (e *CanceledError) Unwrap() error {
+	return e.Err
+}
+
+// PushRejectedError is returned when the remote rejected some or all of the
+// pushed refs. Remotes holds the complete per-ref result, including refs that
+// were updated successfully
+type PushRejectedError struct {
+	*GitError
+	Remotes  []types.Remote
+	Rejected []types.Ref
+}
+
+// Error implements the error interface
+func (e *PushRejectedError) Error() string {
+	refs := make([]string, 0, len(e.Rejected))
+	for _, ref := range e.Rejected {
+		if ref.Reason != nil {
+			refs = append(refs, fmt.Sprintf("%s (%s)", ref.To, *ref.Reason))
+		} else {
+			refs = append(refs, ref.To)
+		}
+	}
+	return fmt.Sprintf("push rejected %d ref(s): %s", len(e.Rejected), strings.Join(refs, ", "))
+}
+
+// Unwrap returns the underlying GitError
+func (e *PushRejectedError) Unwrap() error {
+	return e.GitError
+}
+
+// UnresolvedConflictsError is returned by ResolveConflicts when files still
+// contain conflict markers after the resolutions were applied. Those files
+// are left unstaged
+type UnresolvedConflictsError struct {
+	Paths []string
+}
+
+// Error implements the error interface
+func (e *UnresolvedConflictsError) Error() string {
+	return fmt.Sprintf("conflict markers remain in %d file(s): %s", len(e.Paths), strings.Join(e.Paths, ", "))
+}
+
+// BranchNotMergedError is returned when deleting a branch whose commits are
+// not merged into its upstream or HEAD; force the deletion to lose them
+type BranchNotMergedError struct {
+	*GitError
+	Branch string
+}
+
+// Error implements the error interface
+func (e *BranchNotMergedError) Error() string {
+	return fmt.Sprintf("branch %s is not fully merged", e.Branch)
+}
+
+// Unwrap returns the underlying GitError
+func (e *BranchNotMergedError) Unwrap() error {
+	return e.GitError
+}
+
+// BranchCheckedOutError is returned when deleting or overwriting a branch
+// that is checked out in a worktree
+type BranchCheckedOutError struct {
+	*GitError
+	Branch       string
+	WorktreePath string
+}
+
+// Error implements the error interface
+func (e *BranchCheckedOutError) Error() string {
+	return fmt.Sprintf("branch %s is checked out at %s", e.Branch, e.WorktreePath)
+}
+
+// Unwrap returns the underlying GitError
+func (e *BranchCheckedOutError) Unwrap() error {
+	return e.GitError
+}
+
 // ParseErrorType attempts to determine the error type from the stderr output
 func (e *GitError) ParseErrorType() ErrorType {
 	stderr := strings.ToLower(e.Stderr)
@@ -0,0 +1,81 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+)
+
+// Invocation describes a single git process to run
+type Invocation struct {
+	Args   []string          // Arguments passed to git (without the git binary itself)
+	Dir    string            // Working directory, empty for the executor default
+	Env    map[string]string // Environment variables added on top of the executor environment
+	Stdin  io.Reader         // Standard input, nil for none
+	Stdout io.Writer         // Receives standard output
+	Stderr io.Writer         // Receives standard error (may be the same writer as Stdout)
+}
+
+// Executor runs git invocations. The default executor runs the local git binary;
+// custom executors can run git inside a container, through a remote agent or
+// replay recorded output in tests.
+//
+// Run must return once the process has finished and all output has been written.
+// A non-zero exit status should be reported as an error implementing
+// ExitCode() int (as *exec.ExitError does) so it is surfaced as errors.GitError.
+// When ctx is done, Run should stop the process and return.
+type Executor interface {
+	Run(ctx context.Context, inv *Invocation) error
+}
+
+// ExecutorFunc adapts a function to the Executor interface
+type ExecutorFunc func(ctx context.Context, inv *Invocation) error
+
+// Run calls f(ctx, inv)
+func (f ExecutorFunc) Run(ctx context.Context, inv *Invocation) error {
+	return f(ctx, inv)
+}
+
+// LocalExecutor runs git as a local child process
+type LocalExecutor struct {
+	Path string // Path to the git binary
+}
+
+// NewLocalExecutor creates an executor for the git binary found in PATH
+func NewLocalExecutor() (*LocalExecutor, error) {
+	path, err := exec.LookPath("git")
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalExecutor{
+		Path: path,
+	}, nil
+}
+
+// Run starts git and waits for it to finish. Cancelling ctx kills git and
+// every process it spawned.
+func (e *LocalExecutor) Run(ctx context.Context, inv *Invocation) error {
+	cmd := exec.CommandContext(ctx, e.Path, inv.Args...)
+	setProcessGroup(cmd)
+
+	if inv.Dir != "" {
+		cmd.Dir = inv.Dir
+	}
+
+	// Build environment
+	if len(inv.Env) > 0 {
+		cmd.Env = os.Environ()
+		for k, v := range inv.Env {
+			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+		}
+	}
+
+	cmd.Stdin = inv.Stdin
+	cmd.Stdout = inv.Stdout
+	cmd.Stderr = inv.Stderr
+
+	return cmd.Run()
+}
@@ -0,0 +1,121 @@
+package git_test
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exitStatus mimics *exec.ExitError for transcript executors
+type exitStatus int
+
+func (e exitStatus) Error() string { return fmt.Sprintf("exit status %d", int(e)) }
+func (e exitStatus) ExitCode() int { return int(e) }
+
+// remoteDir is the working directory repositories behind remoteExecutor are
+// opened with. It does not exist locally
+const remoteDir = "/remote/repo"
+
+// remoteExecutor runs git in dir while callers address it as remoteDir, like
+// an executor running git on another machine
+func remoteExecutor(t *testing.T, dir string) git.Executor {
+	local, err := git.NewLocalExecutor()
+	require.NoError(t, err)
+	return git.ExecutorFunc(func(ctx context.Context, inv *git.Invocation) error {
+		mapped := *inv
+		mapped.Dir = strings.Replace(inv.Dir, remoteDir, dir, 1)
+		return local.Run(ctx, &mapped)
+	})
+}
+
+// openRemoteRepo opens the repository in dir through remoteExecutor
+func openRemoteRepo(t *testing.T, dir string) git.Git {
+	gitInstance, err := git.NewGit(git.GitWithExecutor(remoteExecutor(t, dir)))
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(remoteDir)
+	return gitInstance
+}
+
+// Test replaying recorded git output through a custom executor
+func TestExecutorTranscript(t *testing.T) {
+	var invocations []string
+	executor := git.ExecutorFunc(func(ctx context.Context, inv *git.Invocation) error {
+		invocations = append(invocations, strings.Join(inv.Args, " "))
+		switch inv.Args[0] {
+		case "status":
+			fmt.Fprint(inv.Stdout, "# branch.oid (initial)\x00# branch.head main\x00? new.txt\x00"+
+				"1 A. N... 000000 100644 100644 0000000000000000000000000000000000000000 e69de29bb2d1d6434b8b29ae775ad8c2e48c5391 added.txt\x00")
+			return nil
+		case "tag":
+			fmt.Fprint(inv.Stderr, "fatal: tag 'v1' already exists\n")
+			return exitStatus(128)
+		}
+		return fmt.Errorf("unexpected command %v", inv.Args)
+	})
+
+	gitInstance, err := git.NewGit(git.GitWithExecutor(executor))
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory("/remote/repo")
+
+	files, err := gitInstance.Status()
+	require.NoError(t, err)
+	assert.Equal(t, []types.File{
+		{Status: types.FileStatusUntracked, Name: "new.txt"},
+		{Status: types.FileStatusAdded, Name: "added.txt"},
+	}, files)
+
+	err = gitInstance.Tag("v1")
+	var gitErr *errors.GitError
+	require.True(t, stderrors.As(err, &gitErr), "expected GitError, got %T", err)
+	assert.Equal(t, 128, gitErr.ExitCode)
+	assert.Contains(t, gitErr.Stderr, "already exists")
+
+	assert.Equal(t, []string{"status --porcelain=v2 --branch --show-stash -z", "tag v1"}, invocations)
+}
+
+// Test that sessions route every command through the configured executor
+func TestSessionWithExecutor(t *testing.T) {
+	local, err := git.NewLocalExecutor()
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var dirs []string
+	recorder := git.ExecutorFunc(func(ctx context.Context, inv *git.Invocation) error {
+		mu.Lock()
+		dirs = append(dirs, inv.Dir)
+		mu.Unlock()
+		return local.Run(ctx, inv)
+	})
+
+	sessionDir := filepath.Join(t.TempDir(), "session")
+	session, err := git.NewSession(sessionDir,
+		git.SessionWithUser("Executor User", "executor@example.com"),
+		git.SessionWithExecutor(recorder),
+	)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(sessionDir, "file.txt"), []byte("content"), 0644)
+	require.NoError(t, err)
+	require.NoError(t, session.Add([]string{"file.txt"}))
+	require.NoError(t, session.Commit("Commit through executor"))
+
+	require.NotEmpty(t, dirs)
+	for _, dir := range dirs {
+		assert.Equal(t, sessionDir, dir)
+	}
+
+	loaded, err := git.LoadSession(sessionDir, git.SessionWithExecutor(recorder))
+	require.NoError(t, err)
+	assert.Equal(t, "Executor User", loaded.GetSessionConfig().UserName)
+}
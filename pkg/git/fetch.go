@@ -1,20 +1,357 @@
 package git
 
 import (
+	stderrors "errors"
+	"regexp"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/errors"
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
-// Fetch fetches changes from the remote repository
+// Fetch fetches changes from the remote repository. The returned remotes are
+// populated with the refs git updated, including rejected ones; when git fails
+// because refs were rejected the results are returned together with the error.
+// To and From are full ref names. Summary and Reason are only known with git
+// before 2.41, which prints a ref table instead of porcelain output
 func (g *gitImpl) Fetch(opts ...Option) ([]types.Remote, error) {
+	cmd := g.newCommand("fetch", "--porcelain")
+	cmd.ApplyOptions(opts...)
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+	output, err := cmd.Execute()
+	if isUnknownOption(err, "porcelain") {
+		// git before 2.41 has no porcelain fetch output
+		return g.fetchTable(opts...)
+	}
+	if err != nil {
+		var gitErr *errors.GitError
+		if !stderrors.As(err, &gitErr) {
+			return nil, err
+		}
+		output = []byte(gitErr.Stdout)
+	}
+
+	remotes := g.resolveRemoteNames([]types.Remote{{Refs: parseFetchPorcelain(string(output))}})
+	g.fillFetchSources(remotes)
+	return remotes, err
+}
+
+// fetchTable runs fetch with the classic human-readable ref table on stderr
+func (g *gitImpl) fetchTable(opts ...Option) ([]types.Remote, error) {
 	cmd := g.newCommand("fetch")
 	cmd.ApplyOptions(opts...)
+	cmd.ApplyOptions(WithConfig("fetch.output", "full"), WithEnv("LC_ALL", "C"))
 	output, err := cmd.ExecuteWithStderr()
 	if err != nil {
-		return nil, err
+		var gitErr *errors.GitError
+		if !stderrors.As(err, &gitErr) {
+			return nil, err
+		}
+		output = []byte(gitErr.Stderr)
+	}
+
+	remotes := g.resolveRemoteNames(parseFetchTable(string(output)))
+	g.expandFetchRefNames(remotes)
+	return remotes, err
+}
+
+// fillFetchSources fills in From for porcelain fetch output, which only names
+// the local ref. The remote ref is found by mapping To back through the fetch
+// refspecs of the remote; tags are fetched under their own name. From stays
+// empty when no refspec matches, e.g. for refspecs given on the command line
+func (g *gitImpl) fillFetchSources(remotes []types.Remote) {
+	refspecs := g.fetchRefspecs()
+	for i := range remotes {
+		for j := range remotes[i].Refs {
+			ref := &remotes[i].Refs[j]
+			ref.From = mapRefspecsBack(refspecs[remotes[i].Name], ref.To)
+			if ref.From == "" && strings.HasPrefix(ref.To, tagPrefix) {
+				ref.From = ref.To
+			}
+		}
+	}
+}
+
+// fetchRefspecs returns the configured fetch refspecs by remote name
+func (g *gitImpl) fetchRefspecs() map[string][]string {
+	refspecs := map[string][]string{}
+	cmd := g.newCommand("config", "--get-regexp", `^remote\..*\.fetch$`)
+	output, err := cmd.Execute()
+	if err != nil {
+		return refspecs
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		key, refspec, found := strings.Cut(line, " ")
+		if !found {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(key, "remote."), ".fetch")
+		refspecs[name] = append(refspecs[name], refspec)
+	}
+	return refspecs
+}
+
+// mapRefspecsBack returns the remote ref that refspecs such as
+// "+refs/heads/*:refs/remotes/origin/*" store as local, or "" when none does
+func mapRefspecsBack(refspecs []string, local string) string {
+	for _, refspec := range refspecs {
+		src, dst, found := strings.Cut(strings.TrimPrefix(refspec, "+"), ":")
+		if !found || strings.HasPrefix(src, "^") {
+			continue
+		}
+		prefix, suffix, glob := strings.Cut(dst, "*")
+		if !glob {
+			if dst == local {
+				return src
+			}
+			continue
+		}
+		if len(local) >= len(prefix)+len(suffix) && strings.HasPrefix(local, prefix) && strings.HasSuffix(local, suffix) {
+			return strings.Replace(src, "*", local[len(prefix):len(local)-len(suffix)], 1)
+		}
+	}
+	return ""
+}
+
+// expandFetchRefNames turns the names of the fetch ref table, which git
+// prints without refs/heads/, refs/tags/ or refs/remotes/, back into full ref
+// names. Local names are resolved against the refs that exist after the
+// fetch; pruned refs no longer exist and were remote-tracking branches
+func (g *gitImpl) expandFetchRefNames(remotes []types.Remote) {
+	existing := map[string]bool{}
+	cmd := g.newCommand("for-each-ref", "--format=%(refname)")
+	if output, err := cmd.Execute(); err == nil {
+		for _, name := range strings.Fields(string(output)) {
+			existing[name] = true
+		}
+	}
+
+	for i := range remotes {
+		for j := range remotes[i].Refs {
+			ref := &remotes[i].Refs[j]
+			ref.To = expandRefName(ref.To, existing)
+			if ref.From == "" || ref.From == "HEAD" || strings.HasPrefix(ref.From, "refs/") {
+				continue
+			}
+			if strings.HasPrefix(ref.To, tagPrefix) {
+				ref.From = tagPrefix + ref.From
+			} else {
+				ref.From = "refs/heads/" + ref.From
+			}
+		}
+	}
+}
+
+// expandRefName resolves a short local ref name the way git does, trying
+// tags, branches and remote-tracking branches in turn
+func expandRefName(name string, existing map[string]bool) string {
+	if name == "" || name == "FETCH_HEAD" || strings.HasPrefix(name, "refs/") {
+		return name
+	}
+	for _, prefix := range []string{tagPrefix, "refs/heads/", "refs/remotes/"} {
+		if existing[prefix+name] {
+			return prefix + name
+		}
+	}
+	return "refs/remotes/" + name
+}
+
+var (
+	fetchPorcelainPattern = regexp.MustCompile(`^(.) ([0-9a-f]+) ([0-9a-f]+) (.+)$`)
+	refTablePattern       = regexp.MustCompile(`^ (.) (\[[^\]]+\]|[0-9a-f]+\.\.\.?[0-9a-f]+)\s+(\S+)\s+-> (\S+)(?:\s+\((.+)\))?$`)
+	zeroSHAPattern        = regexp.MustCompile(`^0+$`)
+)
+
+// parseFetchPorcelain parses `git fetch --porcelain` lines:
+// <flag> <old-object-id> <new-object-id> <local-reference>
+func parseFetchPorcelain(output string) []types.Ref {
+	refs := []types.Ref{}
+	for _, line := range strings.Split(output, "\n") {
+		m := fetchPorcelainPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		ref := types.Ref{
+			Status: refStatusFromFlag(m[1]),
+			To:     m[4],
+		}
+		if !zeroSHAPattern.MatchString(m[2]) {
+			ref.OldSHA = m[2]
+		}
+		if !zeroSHAPattern.MatchString(m[3]) {
+			ref.NewSHA = m[3]
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// parseFetchTable parses the ref table git fetch prints to stderr, starting a
+// new remote for every "From <url>" line
+func parseFetchTable(output string) []types.Remote {
+	remotes := []types.Remote{}
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "From ") {
+			remotes = append(remotes, types.Remote{URL: strings.TrimPrefix(line, "From ")})
+			continue
+		}
+		ref, ok := parseRefTableLine(line)
+		if !ok {
+			continue
+		}
+		if len(remotes) == 0 {
+			remotes = append(remotes, types.Remote{})
+		}
+		remotes[len(remotes)-1].Refs = append(remotes[len(remotes)-1].Refs, ref)
+	}
+	return remotes
+}
+
+// parseRefTableLine parses a single ref line as printed by fetch:
+// " <flag> <summary> <from> -> <to> (<reason>)"
+func parseRefTableLine(line string) (types.Ref, bool) {
+	m := refTablePattern.FindStringSubmatch(line)
+	if m == nil {
+		return types.Ref{}, false
+	}
+
+	ref := types.Ref{
+		Status:  refStatusFromFlag(m[1]),
+		Summary: m[2],
+		From:    m[3],
+		To:      m[4],
+	}
+	if ref.From == "(none)" {
+		ref.From = ""
+	}
+	ref.OldSHA, ref.NewSHA = summarySHAs(m[2])
+	if m[5] != "" {
+		reason := m[5]
+		ref.Reason = &reason
+	}
+	return ref, true
+}
+
+// summarySHAs extracts the abbreviated object names from an "old..new" or
+// "old...new" summary; bracketed summaries such as "[new branch]" yield none
+func summarySHAs(summary string) (string, string) {
+	if strings.HasPrefix(summary, "[") {
+		return "", ""
+	}
+	shas := strings.Split(strings.Replace(summary, "...", "..", 1), "..")
+	if len(shas) != 2 {
+		return "", ""
+	}
+	return shas[0], shas[1]
+}
+
+// refStatusFromFlag maps the single-character flag git prints for each ref
+func refStatusFromFlag(flag string) types.RefStatus {
+	switch flag {
+	case " ":
+		return types.RefStatusFastForward
+	case "+":
+		return types.RefStatusForcedUpdate
+	case "-":
+		return types.RefStatusPruned
+	case "t":
+		return types.RefStatusTagUpdate
+	case "*":
+		return types.RefStatusNew
+	case "!":
+		return types.RefStatusRejected
+	case "=":
+		return types.RefStatusUpToDate
+	default:
+		return types.RefStatusUnspecified
+	}
+}
+
+// resolveRemoteNames fills in remote names from the configured remotes. Remotes
+// are matched by URL, or by refs/remotes/<name>/ prefix when git did not print a URL
+func (g *gitImpl) resolveRemoteNames(fetched []types.Remote) []types.Remote {
+	configured, err := g.ListRemotes()
+	if err != nil {
+		return fetched
+	}
+
+	result := []types.Remote{}
+	for _, remote := range fetched {
+		if remote.URL != "" {
+			for _, candidate := range configured {
+				if displayURL(candidate.URL) == displayURL(remote.URL) {
+					remote.Name = candidate.Name
+					remote.URL = candidate.URL
+				}
+			}
+			result = append(result, remote)
+			continue
+		}
+
+		// Porcelain output: split the refs by the remote they track
+		byName := map[string]*types.Remote{}
+		order := []string{}
+		var other []types.Ref
+		for _, ref := range remote.Refs {
+			name := ""
+			for _, candidate := range configured {
+				if strings.HasPrefix(ref.To, "refs/remotes/"+candidate.Name+"/") {
+					name = candidate.Name
+				}
+			}
+			if name == "" {
+				other = append(other, ref)
+				continue
+			}
+			if _, ok := byName[name]; !ok {
+				url := ""
+				for _, candidate := range configured {
+					if candidate.Name == name {
+						url = candidate.URL
+					}
+				}
+				byName[name] = &types.Remote{Name: name, URL: url}
+				order = append(order, name)
+			}
+			byName[name].Refs = append(byName[name].Refs, ref)
+		}
+
+		// Refs outside refs/remotes (tags, explicit refspecs) belong to the
+		// fetched remote when there is only one
+		if len(order) == 1 {
+			byName[order[0]].Refs = append(byName[order[0]].Refs, other...)
+			other = nil
+		}
+		for _, name := range order {
+			result = append(result, *byName[name])
+		}
+		if len(other) > 0 {
+			result = append(result, types.Remote{Refs: other})
+		}
 	}
-	if len(output) == 0 {
-		return []types.Remote{}, nil
+	return result
+}
+
+// displayURL normalises a remote URL the way git shortens it in fetch output:
+// credentials, trailing slashes and the .git suffix are removed
+func displayURL(url string) string {
+	if scheme := strings.Index(url, "://"); scheme >= 0 {
+		rest := url[scheme+3:]
+		if at := strings.Index(rest, "@"); at >= 0 && at < strings.IndexAny(rest+"/", "/") {
+			rest = rest[at+1:]
+		}
+		url = url[:scheme+3] + rest
+	}
+	url = strings.TrimRight(url, "/")
+	return strings.TrimSuffix(url, ".git")
+}
+
+// isUnknownOption reports whether git rejected the command because it does not know the option
+func isUnknownOption(err error, option string) bool {
+	var gitErr *errors.GitError
+	if !stderrors.As(err, &gitErr) {
+		return false
 	}
-	// Simplified - would need proper parsing
-	return []types.Remote{}, nil
-}
\ No newline at end of file
+	return strings.Contains(gitErr.Stderr, "unknown option `"+option+"'") ||
+		strings.Contains(gitErr.Stderr, "unknown option '"+option+"'")
+}
@@ -0,0 +1,196 @@
+package git_test
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRemotePair creates a bare remote with one commit on main, a clone that
+// publishes to it (upstream) and a clone that fetches from it (downstream)
+func setupRemotePair(t *testing.T) (remoteDir, upstreamDir, downstreamDir string) {
+	tempDir := t.TempDir()
+	remoteDir = filepath.Join(tempDir, "remote.git")
+	upstreamDir = filepath.Join(tempDir, "upstream")
+	downstreamDir = filepath.Join(tempDir, "downstream")
+
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Init(remoteDir, git.InitWithBare()))
+
+	require.NoError(t, gitInstance.Clone(remoteDir, upstreamDir))
+	upstream := openTestRepo(t, upstreamDir)
+	require.NoError(t, upstream.Commit("Initial commit", git.CommitWithAllowEmpty()))
+	_, err = upstream.Push(git.WithArgs("origin", "HEAD:main"))
+	require.NoError(t, err)
+
+	require.NoError(t, gitInstance.Clone(remoteDir, downstreamDir))
+	openTestRepo(t, downstreamDir)
+	return remoteDir, upstreamDir, downstreamDir
+}
+
+// openTestRepo returns a Git instance for dir with a test user configured
+func openTestRepo(t *testing.T, dir string) git.Git {
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(dir)
+	require.NoError(t, gitInstance.SetConfig("user.name", "Test User"))
+	require.NoError(t, gitInstance.SetConfig("user.email", "test@example.com"))
+	return gitInstance
+}
+
+// prepareFetchResults sets up a downstream clone whose next fetch from origin
+// fast-forwards main, adds a branch and rejects a moved tag
+func prepareFetchResults(t *testing.T) (remoteDir, downstreamDir string) {
+	remoteDir, upstreamDir, downstreamDir := setupRemotePair(t)
+	upstream := openTestRepo(t, upstreamDir)
+	downstream := openTestRepo(t, downstreamDir)
+
+	require.NoError(t, upstream.CreateBranch("feature"))
+	require.NoError(t, upstream.Tag("v1"))
+	_, err := upstream.Push(git.WithArgs("origin", "feature", "v1"))
+	require.NoError(t, err)
+	_, err = downstream.Fetch(git.FetchWithTags())
+	require.NoError(t, err)
+
+	// Advance main, rewrite the tag and add a new branch
+	require.NoError(t, upstream.Commit("Second commit", git.CommitWithAllowEmpty()))
+	require.NoError(t, upstream.Tag("v1", git.WithArgs("--force")))
+	require.NoError(t, upstream.CreateBranch("other"))
+	_, err = upstream.Push(git.WithArgs("--force", "origin", "main", "other", "v1"))
+	require.NoError(t, err)
+	return remoteDir, downstreamDir
+}
+
+// tableFetchExecutor runs git locally but rejects porcelain fetches like git
+// before 2.41, so that Fetch parses the ref table
+func tableFetchExecutor(t *testing.T) git.Executor {
+	local, err := git.NewLocalExecutor()
+	require.NoError(t, err)
+	return git.ExecutorFunc(func(ctx context.Context, inv *git.Invocation) error {
+		if len(inv.Args) > 1 && inv.Args[0] == "fetch" && inv.Args[1] == "--porcelain" {
+			fmt.Fprintln(inv.Stderr, "error: unknown option `porcelain'")
+			return exitStatus(129)
+		}
+		return local.Run(ctx, inv)
+	})
+}
+
+// requireGitVersion skips the test when git is older than major.minor
+func requireGitVersion(t *testing.T, major, minor int) {
+	output, err := exec.Command("git", "version").Output()
+	require.NoError(t, err)
+	var gotMajor, gotMinor int
+	_, err = fmt.Sscanf(string(output), "git version %d.%d", &gotMajor, &gotMinor)
+	require.NoError(t, err)
+	if gotMajor < major || gotMajor == major && gotMinor < minor {
+		t.Skipf("requires git %d.%d, found %s", major, minor, strings.TrimSpace(string(output)))
+	}
+}
+
+// fetchRefsByName fetches from origin and indexes the refs by To
+func fetchRefsByName(t *testing.T, gitInstance git.Git, remoteDir string) map[string]types.Ref {
+	remotes, err := gitInstance.Fetch(git.FetchWithRemote("origin"), git.FetchWithTags())
+	// The moved tag is rejected, which makes git exit non-zero
+	require.Error(t, err)
+	require.Len(t, remotes, 1)
+	assert.Equal(t, "origin", remotes[0].Name)
+	assert.Equal(t, remoteDir, remotes[0].URL)
+
+	refs := make(map[string]types.Ref)
+	for _, ref := range remotes[0].Refs {
+		refs[ref.To] = ref
+	}
+	return refs
+}
+
+// Test fetch results with new, updated, forced and rejected refs from the ref
+// table of git before 2.41
+func TestFetchResults(t *testing.T) {
+	remoteDir, downstreamDir := prepareFetchResults(t)
+	gitInstance, err := git.NewGit(git.GitWithExecutor(tableFetchExecutor(t)))
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(downstreamDir)
+
+	refs := fetchRefsByName(t, gitInstance, remoteDir)
+
+	main := refs["refs/remotes/origin/main"]
+	assert.Equal(t, types.RefStatusFastForward, main.Status)
+	assert.Equal(t, "refs/heads/main", main.From)
+	assert.NotEmpty(t, main.OldSHA)
+	assert.NotEmpty(t, main.NewSHA)
+
+	other := refs["refs/remotes/origin/other"]
+	assert.Equal(t, types.RefStatusNew, other.Status)
+	assert.Equal(t, "refs/heads/other", other.From)
+	assert.Equal(t, "[new branch]", other.Summary)
+
+	tag := refs["refs/tags/v1"]
+	assert.Equal(t, types.RefStatusRejected, tag.Status)
+	assert.Equal(t, "refs/tags/v1", tag.From)
+	require.NotNil(t, tag.Reason)
+	assert.Equal(t, "would clobber existing tag", *tag.Reason)
+}
+
+// Test real porcelain fetch output has the same shape as the ref table
+func TestFetchPorcelainGit(t *testing.T) {
+	requireGitVersion(t, 2, 41)
+	remoteDir, downstreamDir := prepareFetchResults(t)
+
+	refs := fetchRefsByName(t, openTestRepo(t, downstreamDir), remoteDir)
+
+	main := refs["refs/remotes/origin/main"]
+	assert.Equal(t, types.RefStatusFastForward, main.Status)
+	assert.Equal(t, "refs/heads/main", main.From)
+	assert.Len(t, main.OldSHA, 40)
+	assert.Len(t, main.NewSHA, 40)
+
+	other := refs["refs/remotes/origin/other"]
+	assert.Equal(t, types.RefStatusNew, other.Status)
+	assert.Equal(t, "refs/heads/other", other.From)
+
+	tag := refs["refs/tags/v1"]
+	assert.Equal(t, types.RefStatusRejected, tag.Status)
+	assert.Equal(t, "refs/tags/v1", tag.From)
+}
+
+// Test parsing of porcelain fetch output from newer git versions
+func TestFetchPorcelain(t *testing.T) {
+	_, _, downstreamDir := setupRemotePair(t)
+
+	local, err := git.NewLocalExecutor()
+	require.NoError(t, err)
+	old := strings.Repeat("1", 40)
+	updated := strings.Repeat("2", 40)
+	executor := git.ExecutorFunc(func(ctx context.Context, inv *git.Invocation) error {
+		if len(inv.Args) > 1 && inv.Args[0] == "fetch" && inv.Args[1] == "--porcelain" {
+			fmt.Fprintf(inv.Stdout, "  %s %s refs/remotes/origin/main\n", old, updated)
+			fmt.Fprintf(inv.Stdout, "- %s %s refs/remotes/origin/gone\n", old, strings.Repeat("0", 40))
+			fmt.Fprintf(inv.Stdout, "* %s %s refs/tags/v2\n", strings.Repeat("0", 40), updated)
+			return nil
+		}
+		return local.Run(ctx, inv)
+	})
+
+	gitInstance, err := git.NewGit(git.GitWithExecutor(executor))
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(downstreamDir)
+
+	remotes, err := gitInstance.Fetch()
+	require.NoError(t, err)
+	require.Len(t, remotes, 1)
+	assert.Equal(t, "origin", remotes[0].Name)
+	assert.Equal(t, []types.Ref{
+		{Status: types.RefStatusFastForward, From: "refs/heads/main", To: "refs/remotes/origin/main", OldSHA: old, NewSHA: updated},
+		{Status: types.RefStatusPruned, From: "refs/heads/gone", To: "refs/remotes/origin/gone", OldSHA: old},
+		{Status: types.RefStatusNew, From: "refs/tags/v2", To: "refs/tags/v2", NewSHA: updated},
+	}, remotes[0].Refs)
+}
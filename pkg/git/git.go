@@ -1,7 +1,9 @@
 package git
 
 import (
-	"os/exec"
+	"context"
+	"io"
+	"path/filepath"
 	"time"
 
 	"github.com/instruqt/git-exec/pkg/git/types"
@@ -9,6 +11,7 @@ import (
 
 type Git interface {
 	SetWorkingDirectory(wd string)
+	WithContext(ctx context.Context) Git
 
 	Init(path string, options ...Option) error
 	AddRemote(name, url string, options ...Option) error
@@ -17,37 +20,103 @@ type Git interface {
 	ListRemotes(options ...Option) ([]types.Remote, error)
 	Clone(url, destination string, options ...Option) error
 	Status(options ...Option) ([]types.File, error)
+	DetailedStatus(options ...Option) (*types.StatusResult, error)
+	State() (*types.RepositoryState, error)
 	Add(files []string, options ...Option) error
 	Reset(files []string, options ...Option) error
 	Commit(message string, options ...Option) error
 	Diff(options ...Option) ([]types.Diff, error)
 	Show(object string, options ...Option) (*types.Log, error)
 	Log(options ...Option) ([]types.Log, error)
+	LogStream(options ...Option) (*LogIterator, error)
+	Blame(path string, options ...Option) ([]types.BlameLine, error)
 	Fetch(options ...Option) ([]types.Remote, error)
 	Pull(options ...Option) (*types.MergeResult, error)
 	Push(options ...Option) ([]types.Remote, error)
 	ListBranches(options ...Option) ([]types.Branch, error)
 	CreateBranch(branch string, options ...Option) error
 	DeleteBranch(branch string, options ...Option) error
+	DeleteRemoteBranch(remote, branch string, options ...Option) ([]types.Remote, error)
+	RenameBranch(oldName, newName string, options ...Option) error
+	CopyBranch(source, destination string, options ...Option) error
 	SetUpstream(branch string, remote string, options ...Option) error
+	SetUpstreamTo(branch, upstream string, options ...Option) error
+	UnsetUpstream(branch string, options ...Option) error
 	Checkout(options ...Option) (*types.CheckoutResult, error)
 	Tag(name string, options ...Option) error
-	ListTags(options ...Option) ([]string, error)
+	ListTags(options ...Option) ([]types.Tag, error)
+	VerifyTag(name string, options ...Option) (*types.CommitSignature, error)
 	DeleteTag(name string, options ...Option) error
 	PushTags(remote string, options ...Option) ([]types.Remote, error)
 	DeleteRemoteTag(remote, tagName string, options ...Option) error
-	Revert(options ...Option) error
+	CherryPick(commits []string, options ...Option) (*types.SequencerResult, error)
+	CherryPickContinue() (*types.SequencerResult, error)
+	CherryPickSkip() (*types.SequencerResult, error)
+	CherryPickAbort() error
+	CherryPickQuit() error
+	Revert(commits []string, options ...Option) (*types.SequencerResult, error)
+	RevertContinue() (*types.SequencerResult, error)
+	RevertSkip() (*types.SequencerResult, error)
+	RevertAbort() error
+	RevertQuit() error
 	Merge(options ...Option) (*types.MergeResult, error)
 	MergeAbort() error
 	MergeContinue() error
-	ResolveConflicts(resolutions []types.ConflictResolution) error
-	Rebase(options ...Option) error
-	Reflog(options ...Option) error
+	ResolveConflicts(resolutions []types.ConflictResolution) ([]types.ConflictResolutionResult, error)
+	Conflicts(options ...Option) ([]types.ConflictFile, error)
+	Rebase(options ...Option) (*types.RebaseResult, error)
+	RebaseInteractive(upstream string, todo []types.RebaseTodo, options ...Option) (*types.RebaseResult, error)
+	RebaseContinue() (*types.RebaseResult, error)
+	RebaseSkip() (*types.RebaseResult, error)
+	RebaseAbort() error
+	RebaseEditTodo(todo []types.RebaseTodo) error
+	BisectStart(bad string, good []string, options ...Option) (*types.BisectResult, error)
+	BisectGood(revs ...string) (*types.BisectResult, error)
+	BisectBad(revs ...string) (*types.BisectResult, error)
+	BisectSkip(revs ...string) (*types.BisectResult, error)
+	BisectStatus() (*types.BisectResult, error)
+	BisectRun(command string, opts ...Option) (*types.BisectResult, error)
+	BisectRunFunc(test func(commit string) (types.BisectVerdict, error)) (*types.BisectResult, error)
+	BisectLog() (string, error)
+	BisectReplay(logFile string) (*types.BisectResult, error)
+	BisectReset(commit string) error
+	Reflog(ref string, options ...Option) ([]types.ReflogEntry, error)
+	ReflogExpire(options ...Option) error
+	ReflogDelete(selector string, options ...Option) error
+	StashPush(options ...Option) (*types.StashEntry, error)
+	StashList(options ...Option) ([]types.StashEntry, error)
+	StashShow(stash string, options ...Option) ([]types.Diff, error)
+	StashApply(stash string, options ...Option) (*types.MergeResult, error)
+	StashPop(stash string, options ...Option) (*types.MergeResult, error)
+	StashDrop(stash string, options ...Option) error
+	StashClear(options ...Option) error
+	StashBranch(branch, stash string, options ...Option) error
+	WorktreeAdd(path, commitish string, options ...Option) error
+	WorktreeList(options ...Option) ([]types.Worktree, error)
+	WorktreeRemove(path string, options ...Option) error
+	WorktreeLock(path string, options ...Option) error
+	WorktreeUnlock(path string, options ...Option) error
+	WorktreeMove(path, newPath string, options ...Option) error
+	WorktreePrune(options ...Option) error
+	WithWorktree(path string) Git
+	SubmoduleAdd(url, path string, options ...Option) error
+	SubmoduleInit(options ...Option) error
+	SubmoduleUpdate(options ...Option) error
+	SubmoduleStatus(options ...Option) ([]types.Submodule, error)
+	SubmoduleSync(options ...Option) error
+	SubmoduleDeinit(paths []string, options ...Option) error
+	SubmoduleForeach(command string, options ...Option) (string, error)
 	SetConfig(key string, value string, options ...Option) error
 	GetConfig(key string, options ...Option) (string, error)
 	ListConfig(options ...Option) ([]types.ConfigEntry, error)
 	UnsetConfig(key string, options ...Option) error
 	Remove(options ...Option) error
+	Archive(treeish string, w io.Writer, options ...Option) error
+	BundleCreate(file string, revs []string, options ...Option) error
+	BundleCreateTo(w io.Writer, revs []string, options ...Option) error
+	BundleVerify(file string, options ...Option) (*types.BundleInfo, error)
+	BundleListHeads(file string, options ...Option) ([]types.BundleRef, error)
+	BundleUnbundle(file string, options ...Option) ([]types.BundleRef, error)
 	
 	// Bare repository operations
 	IsBareRepository() (bool, error)
@@ -58,6 +127,7 @@ type Command interface {
 	Execute() ([]byte, error)
 	ExecuteCombined() ([]byte, error)
 	ExecuteWithStderr() ([]byte, error)
+	ExecuteTo(stdout io.Writer) error
 	ApplyOptions(opts ...Option)
 	// Internal methods for option configuration
 	SetTimeout(timeout time.Duration)
@@ -75,28 +145,78 @@ type Option func(Command)
 
 // gitImpl implements the Git interface
 type gitImpl struct {
-	path string
-	wd   string
+	executor Executor
+	wd       string
+	ctx      context.Context
+}
+
+// GitOption is a functional option for configuring Git instances
+type GitOption func(*gitImpl)
+
+// GitWithExecutor runs all git commands through the given executor instead of
+// the local git binary
+func GitWithExecutor(executor Executor) GitOption {
+	return func(g *gitImpl) {
+		g.executor = executor
+	}
 }
 
 // NewGit creates a new git implementation
-func NewGit() (*gitImpl, error) {
-	path, err := exec.LookPath("git")
-	if err != nil {
-		return nil, err
+func NewGit(opts ...GitOption) (*gitImpl, error) {
+	g := &gitImpl{}
+	for _, opt := range opts {
+		opt(g)
 	}
 
-	return &gitImpl{
-		path: path,
-	}, nil
+	if g.executor == nil {
+		executor, err := NewLocalExecutor()
+		if err != nil {
+			return nil, err
+		}
+		g.executor = executor
+	}
+
+	return g, nil
 }
 
 // NewGitInstance creates a new Git instance (basic, no session)
-func NewGitInstance() (Git, error) {
-	return NewGit()
+func NewGitInstance(opts ...GitOption) (Git, error) {
+	return NewGit(opts...)
 }
 
 // SetWorkingDirectory sets the working directory for git operations
 func (g *gitImpl) SetWorkingDirectory(wd string) {
 	g.wd = wd
-}
\ No newline at end of file
+}
+
+// WithContext returns a copy of the Git instance whose commands run under ctx.
+// Cancelling ctx kills the running git process group and fails the operation
+// with an errors.CanceledError
+func (g *gitImpl) WithContext(ctx context.Context) Git {
+	return g.withContext(ctx)
+}
+
+// WithWorktree returns a copy of the Git instance that runs its commands in
+// the worktree at path. Relative paths are resolved against the current
+// working directory of the instance
+func (g *gitImpl) WithWorktree(path string) Git {
+	return g.withWorktree(path)
+}
+
+// withContext returns a shallow copy of the implementation bound to ctx
+func (g *gitImpl) withContext(ctx context.Context) *gitImpl {
+	clone := *g
+	clone.ctx = ctx
+	return &clone
+}
+
+// withWorktree returns a shallow copy of the implementation working in the
+// worktree at path
+func (g *gitImpl) withWorktree(path string) *gitImpl {
+	clone := *g
+	if !filepath.IsAbs(path) && g.wd != "" {
+		path = filepath.Join(g.wd, path)
+	}
+	clone.wd = path
+	return &clone
+}
@@ -0,0 +1,34 @@
+package git
+
+// readGitFilesScript resolves each argument inside the git directory and
+// prints it like readFilesScript, printing directories as empty files
+const readGitFilesScript = `for name; do path=$(git rev-parse --git-path "$name") || exit 1; ` +
+	`if [ -d "$path" ]; then printf '0 %s\000' "$name"; ` +
+	`elif [ -f "$path" ]; then printf '%s %s\000' "$(wc -c < "$path")" "$name" && cat -- "$path" || exit 1; fi; done`
+
+// readGitFiles reads files inside the git directory, taking worktrees into
+// account, through the executor in a single git invocation. Missing files
+// are left out and directories are present with empty content
+func (g *gitImpl) readGitFiles(names ...string) (map[string]string, error) {
+	output, err := g.shellCommand(readGitFilesScript, names...).Execute()
+	if err != nil {
+		return nil, err
+	}
+	return parseFileRecords(string(output))
+}
+
+// readGitFile reads a file inside the git directory
+func (g *gitImpl) readGitFile(name string) (string, bool) {
+	files, err := g.readGitFiles(name)
+	if err != nil {
+		return "", false
+	}
+	content, found := files[name]
+	return content, found
+}
+
+// gitPathExists reports whether a file or directory exists inside the git directory
+func (g *gitImpl) gitPathExists(name string) bool {
+	_, found := g.readGitFile(name)
+	return found
+}
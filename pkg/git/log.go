@@ -1,79 +1,171 @@
 package git
 
 import (
+	"bytes"
+	"fmt"
 	"strings"
 	"time"
+
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
+// logFields are the placeholders of a commit record. Fields are separated by
+// NUL, which cannot occur in commit messages, and each record starts with NUL
+// so that patch output following a record can be told apart from the next one
+var logFields = []string{
+	"%H", "%T", "%P",
+	"%an <%ae>", "%aI", "%cn <%ce>", "%cI",
+	"%s", "%b", "%B", "%N", "%(trailers:unfold)",
+	"%G?", "%GS", "%GK", "%GF",
+	"%D",
+}
+
+// logFormat prints a commit as "\x00<field>\x00<field>...\x00". Checking
+// signatures runs gpg or ssh-keygen for every signed commit, so the signature
+// fields stay empty unless LogWithSignatures switches to logSignatureFormat
+var (
+	logFormat          = formatLogFields(false)
+	logSignatureFormat = formatLogFields(true)
+)
+
+// formatLogFields builds the format of a commit record, leaving the %G
+// signature fields empty unless signatures are requested
+func formatLogFields(signatures bool) string {
+	fields := make([]string, len(logFields))
+	for i, field := range logFields {
+		if strings.HasPrefix(field, "%G") && !signatures {
+			field = ""
+		}
+		fields[i] = field
+	}
+	return "--format=%x00" + strings.Join(fields, "%x00") + "%x00"
+}
+
+// signatureStatuses maps the %G? codes of signed commits
+var signatureStatuses = map[string]types.SignatureStatus{
+	"G": types.SignatureStatusGood,
+	"B": types.SignatureStatusBad,
+	"U": types.SignatureStatusUnknownValidity,
+	"X": types.SignatureStatusExpired,
+	"Y": types.SignatureStatusExpiredKey,
+	"R": types.SignatureStatusRevokedKey,
+	"E": types.SignatureStatusUnverifiable,
+}
+
 // Log shows the commit logs
 func (g *gitImpl) Log(opts ...Option) ([]types.Log, error) {
-	// Use a custom format for easier parsing
-	format := "--pretty=format:COMMIT:%H%nTREE:%T%nPARENT:%P%nAUTHOR:%an <%ae>%nAUTHOR_DATE:%ai%nCOMMITTER:%cn <%ce>%nCOMMITTER_DATE:%ci%nMESSAGE:%s%n---END---"
-	cmd := g.newCommand("log", format)
+	cmd := g.newCommand("log", logFormat, "--decorate=full")
+	cmd.AddArgs(diffPrefixArgs...)
 	
 	// Apply all provided options
 	cmd.ApplyOptions(opts...)
+	args, err := logCursorArgs(cmd.GetArgs())
+	if err != nil {
+		return nil, err
+	}
+	cmd.SetArgs(args)
 	
 	output, err := cmd.Execute()
 	if err != nil {
 		return nil, err
 	}
+	if err := checkLogRecords(output); err != nil {
+		return nil, err
+	}
 	
 	return parseLogOutput(string(output))
 }
 
-// parseLogOutput parses git log output into Log structs
-func parseLogOutput(output string) ([]types.Log, error) {
-	if output == "" {
-		return []types.Log{}, nil
+// checkLogRecords fails on log output that has no logFormat records, as
+// printed when an option such as --pretty replaced the format
+func checkLogRecords(output []byte) error {
+	if len(bytes.TrimSpace(output)) == 0 || bytes.IndexByte(output, 0) >= 0 {
+		return nil
 	}
-	
+	line, _, _ := strings.Cut(string(output), "\n")
+	return fmt.Errorf("git log output has no records, an option may have replaced the format: %q", line)
+}
+
+// parseLogOutput parses records written with logFormat, along with the patch
+// or numstat output git prints after each record
+func parseLogOutput(output string) ([]types.Log, error) {
 	logs := []types.Log{}
-	entries := strings.Split(output, "---END---")
-	
-	for _, entry := range entries {
-		entry = strings.TrimSpace(entry)
-		if entry == "" {
-			continue
-		}
-		
-		log := types.Log{}
-		lines := strings.Split(entry, "\n")
-		
-		for _, line := range lines {
-			line = strings.TrimSpace(line)
-			if line == "" {
-				continue
-			}
-			
-			if strings.HasPrefix(line, "COMMIT:") {
-				log.Commit = strings.TrimPrefix(line, "COMMIT:")
-			} else if strings.HasPrefix(line, "TREE:") {
-				log.Tree = strings.TrimPrefix(line, "TREE:")
-			} else if strings.HasPrefix(line, "PARENT:") {
-				log.Parent = strings.TrimPrefix(line, "PARENT:")
-			} else if strings.HasPrefix(line, "AUTHOR:") {
-				log.Author = strings.TrimPrefix(line, "AUTHOR:")
-			} else if strings.HasPrefix(line, "AUTHOR_DATE:") {
-				dateStr := strings.TrimPrefix(line, "AUTHOR_DATE:")
-				if date, err := time.Parse("2006-01-02 15:04:05 -0700", dateStr); err == nil {
-					log.AuthorDate = date
-				}
-			} else if strings.HasPrefix(line, "COMMITTER:") {
-				log.Committer = strings.TrimPrefix(line, "COMMITTER:")
-			} else if strings.HasPrefix(line, "COMMITTER_DATE:") {
-				dateStr := strings.TrimPrefix(line, "COMMITTER_DATE:")
-				if date, err := time.Parse("2006-01-02 15:04:05 -0700", dateStr); err == nil {
-					log.CommitterDate = date
-				}
-			} else if strings.HasPrefix(line, "MESSAGE:") {
-				log.Message = strings.TrimPrefix(line, "MESSAGE:")
-			}
+
+	// Anything before the first record (e.g. the tag Show prints for annotated tags) is skipped
+	tokens := strings.Split(output, "\x00")
+	record := len(logFields) + 1
+	for i := 1; i+record <= len(tokens); i += record {
+		log := parseLogRecord(tokens[i : i+len(logFields)])
+		if diff := tokens[i+len(logFields)]; strings.TrimSpace(diff) != "" {
+			log.Diffs = parseDiffOutput(diff)
 		}
-		
 		logs = append(logs, log)
 	}
-	
+
 	return logs, nil
-}
\ No newline at end of file
+}
+
+// parseLogRecord parses the fields of a record in logFields order
+func parseLogRecord(fields []string) types.Log {
+	log := types.Log{
+		Commit:      fields[0],
+		Tree:        fields[1],
+		Parent:      fields[2],
+		Parents:     strings.Fields(fields[2]),
+		Author:      fields[3],
+		Committer:   fields[5],
+		Message:     fields[7],
+		Subject:     fields[7],
+		Body:        strings.TrimSpace(fields[8]),
+		FullMessage: strings.TrimSpace(fields[9]),
+		Notes:       strings.TrimSpace(fields[10]),
+		Trailers:    parseTrailers(fields[11]),
+		Refs:        parseDecorations(fields[16]),
+	}
+	if date, err := time.Parse(time.RFC3339, fields[4]); err == nil {
+		log.AuthorDate = date
+	}
+	if date, err := time.Parse(time.RFC3339, fields[6]); err == nil {
+		log.CommitterDate = date
+	}
+	if status, signed := signatureStatuses[fields[12]]; signed {
+		log.Signature = &types.CommitSignature{
+			Status:      status,
+			Signer:      fields[13],
+			Key:         fields[14],
+			Fingerprint: fields[15],
+		}
+	}
+	return log
+}
+
+// parseTrailers parses unfolded "Key: value" trailer lines
+func parseTrailers(output string) []types.Trailer {
+	trailers := []types.Trailer{}
+	for _, line := range strings.Split(output, "\n") {
+		key, value, found := strings.Cut(line, ": ")
+		if !found {
+			continue
+		}
+		trailers = append(trailers, types.Trailer{Key: key, Value: value})
+	}
+	return trailers
+}
+
+// parseDecorations parses full decorations such as
+// "HEAD -> refs/heads/main, tag: refs/tags/v1, refs/remotes/origin/main"
+func parseDecorations(output string) []string {
+	refs := []string{}
+	for _, decoration := range strings.Split(output, ", ") {
+		decoration = strings.TrimPrefix(strings.TrimSpace(decoration), "tag: ")
+		if decoration == "" {
+			continue
+		}
+		if head, target, found := strings.Cut(decoration, " -> "); found {
+			refs = append(refs, head, target)
+			continue
+		}
+		refs = append(refs, decoration)
+	}
+	return refs
+}
@@ -0,0 +1,200 @@
+package git_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runGit runs a git command the library has no method for
+func runGit(t *testing.T, dir string, args ...string) {
+	executor, err := git.NewLocalExecutor()
+	require.NoError(t, err)
+	var output bytes.Buffer
+	err = executor.Run(context.Background(), &git.Invocation{Args: args, Dir: dir, Stdout: &output, Stderr: &output})
+	require.NoError(t, err, output.String())
+}
+
+// Test full messages, trailers, notes, parents and decorations
+func TestLogMetadata(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	message := "Add feature\n\nThe body mentions ---END--- and spans\ntwo lines.\n\nSigned-off-by: Test User <test@example.com>\nCo-authored-by: Other Dev\n <other@example.com>"
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Commit(message, git.CommitWithAllowEmpty()))
+	runGit(t, tempDir, "notes", "add", "-m", "Reviewed", "HEAD")
+
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Commit("Main change", git.CommitWithAllowEmpty()))
+	_, err = gitInstance.Merge(git.MergeWithBranch("feature"), git.MergeWithNoFF())
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Tag("v1"))
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("3"), git.WithArgs("--topo-order"))
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	merge := logs[0]
+	assert.Len(t, merge.Parents, 2)
+	assert.Equal(t, merge.Parents[0]+" "+merge.Parents[1], merge.Parent)
+	assert.Equal(t, []string{"HEAD", "refs/heads/main", "refs/tags/v1"}, merge.Refs)
+	assert.Equal(t, "Merge branch 'feature'", merge.Subject)
+	assert.Empty(t, merge.Trailers)
+	assert.Nil(t, merge.Signature)
+
+	var feature types.Log
+	for _, log := range logs {
+		if log.Subject == "Add feature" {
+			feature = log
+		}
+	}
+	require.NotEmpty(t, feature.Commit)
+	assert.Equal(t, "Add feature", feature.Subject)
+	assert.Contains(t, feature.Body, "---END---")
+	assert.Equal(t, "Add feature", feature.Message)
+	assert.Equal(t, message, feature.FullMessage)
+	assert.Equal(t, []types.Trailer{
+		{Key: "Signed-off-by", Value: "Test User <test@example.com>"},
+		{Key: "Co-authored-by", Value: "Other Dev <other@example.com>"},
+	}, feature.Trailers)
+	assert.Equal(t, "Reviewed", feature.Notes)
+	assert.Equal(t, []string{"refs/heads/feature"}, feature.Refs)
+	assert.Len(t, feature.Parents, 1)
+	assert.False(t, feature.AuthorDate.IsZero())
+}
+
+// Test diffs and numstat of Show and Log
+func TestShowDiffs(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("# Test Repo\nMore\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "new.txt"), []byte("one\ntwo\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"."}))
+	require.NoError(t, gitInstance.Commit("Change files"))
+
+	show, err := gitInstance.Show("HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "Change files", show.Message)
+	require.Len(t, show.Diffs, 2)
+	assert.Equal(t, "README.md", show.Diffs[0].NewFile)
+	require.Len(t, show.Diffs[0].Hunks, 1)
+	assert.Equal(t, types.FileStatusAdded, show.Diffs[1].Status)
+
+	show, err = gitInstance.Show("HEAD", git.ShowWithNumstat())
+	require.NoError(t, err)
+	require.Len(t, show.Diffs, 2)
+	require.NotNil(t, show.Diffs[1].Stat)
+	assert.Equal(t, 2, show.Diffs[1].Stat.Insertions)
+
+	show, err = gitInstance.Show("HEAD", git.ShowWithNoPatch())
+	require.NoError(t, err)
+	assert.Empty(t, show.Diffs)
+
+	logs, err := gitInstance.Log(git.LogWithPatch())
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Len(t, logs[0].Diffs, 2)
+	require.Len(t, logs[1].Diffs, 1)
+	assert.Equal(t, "README.md", logs[1].Diffs[0].NewFile)
+
+	logs, err = gitInstance.Log(git.LogWithNumstat(), git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	require.Len(t, logs[0].Diffs, 2)
+	assert.Equal(t, "README.md", logs[0].Diffs[0].NewFile)
+	assert.Equal(t, 2, logs[0].Diffs[0].Stat.Insertions)
+	assert.Equal(t, 1, logs[0].Diffs[0].Stat.Deletions)
+}
+
+// Test every format-affecting option still yields parsed commits
+func TestLogFormatOptions(t *testing.T) {
+	gitInstance := setupHistory(t, 1)
+
+	options := map[string]git.Option{
+		"oneline":    git.LogWithOneline(),
+		"graph":      git.LogWithGraph(),
+		"stat":       git.LogWithStat(),
+		"patch":      git.LogWithPatch(),
+		"numstat":    git.LogWithNumstat(),
+		"signatures": git.LogWithSignatures(),
+	}
+	for name, option := range options {
+		t.Run(name, func(t *testing.T) {
+			logs, err := gitInstance.Log(option)
+			require.NoError(t, err)
+			require.Len(t, logs, 2)
+			assert.Equal(t, "Commit 1", logs[0].Subject)
+			assert.Len(t, logs[0].Commit, 40)
+
+			streamed, _ := collectLog(t, gitInstance, option)
+			assert.Equal(t, logs, streamed)
+		})
+	}
+
+	// A format replaced through WithArgs is an error rather than an empty log
+	_, err := gitInstance.Log(git.WithArgs("--pretty=oneline"))
+	assert.ErrorContains(t, err, "no records")
+
+	it, err := gitInstance.LogStream(git.WithArgs("--pretty=oneline"))
+	require.NoError(t, err)
+	defer it.Close()
+	assert.False(t, it.Next())
+	assert.ErrorContains(t, it.Err(), "no records")
+}
+
+// Test the signature of commits signed with an SSH key
+func TestLogSignature(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is not available")
+	}
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	key := filepath.Join(t.TempDir(), "id_ed25519")
+	output, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "test@example.com", "-f", key).CombinedOutput()
+	require.NoError(t, err, string(output))
+	publicKey, err := os.ReadFile(key + ".pub")
+	require.NoError(t, err)
+	allowedSigners := filepath.Join(t.TempDir(), "allowed_signers")
+	require.NoError(t, os.WriteFile(allowedSigners, append([]byte("test@example.com "), publicKey...), 0644))
+
+	require.NoError(t, gitInstance.SetConfig("gpg.format", "ssh"))
+	require.NoError(t, gitInstance.SetConfig("gpg.ssh.allowedSignersFile", allowedSigners))
+	require.NoError(t, gitInstance.Commit("Signed commit", git.CommitWithAllowEmpty(), git.CommitWithGPGSign(key)))
+
+	// Signatures are only checked on request
+	logs, err := gitInstance.Log(git.LogWithMaxCount("2"))
+	require.NoError(t, err)
+	assert.Nil(t, logs[0].Signature)
+
+	logs, err = gitInstance.Log(git.LogWithMaxCount("2"), git.LogWithSignatures())
+	require.NoError(t, err)
+	require.NotNil(t, logs[0].Signature)
+	assert.Equal(t, types.SignatureStatusGood, logs[0].Signature.Status)
+	assert.Equal(t, "test@example.com", logs[0].Signature.Signer)
+	assert.Contains(t, logs[0].Signature.Fingerprint, "SHA256:")
+	assert.Nil(t, logs[1].Signature)
+
+	show, err := gitInstance.Show("HEAD", git.ShowWithNoPatch(), git.LogWithSignatures())
+	require.NoError(t, err)
+	require.NotNil(t, show.Signature)
+	assert.Equal(t, types.SignatureStatusGood, show.Signature.Status)
+}
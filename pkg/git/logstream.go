@@ -0,0 +1,191 @@
+package git
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// LogIterator streams commits from a running git log. git only produces
+// output as fast as commits are read, so memory use does not grow with the
+// size of the history. Always call Close when done
+type LogIterator struct {
+	reader *bufio.Reader
+	pipe   *io.PipeReader
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	commit  types.Log
+	pending []string // Fields of the record being read
+	started bool     // The first record has been found
+	cursor  string
+	err     error
+	closed  bool
+}
+
+// LogStream starts git log and returns an iterator over its commits. The
+// options of Log apply, along with the filters and pagination options
+// (LogWithSkip, LogWithCursor). No timeout applies unless one is set with
+// WithTimeout; use WithContext or Close to stop git early
+func (g *gitImpl) LogStream(opts ...Option) (*LogIterator, error) {
+	ctx := g.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	cmd := g.withContext(ctx).newCommand("log", logFormat, "--decorate=full")
+	cmd.AddArgs(diffPrefixArgs...)
+	cmd.SetTimeout(0)
+	cmd.ApplyOptions(opts...)
+	args, err := logCursorArgs(cmd.GetArgs())
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	cmd.SetArgs(args)
+
+	pipeReader, pipeWriter := io.Pipe()
+	it := &LogIterator{
+		reader: bufio.NewReader(pipeReader),
+		pipe:   pipeReader,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(it.done)
+		pipeWriter.CloseWithError(cmd.ExecuteTo(pipeWriter))
+	}()
+
+	return it, nil
+}
+
+// logCursorArg marks the commit added by LogWithCursor, as in
+// "--cursor=<sha>". logCursorArgs turns it into a revision
+const logCursorArg = "--cursor="
+
+// logCursorArgs replaces a LogWithCursor marker with the cursor commit and a
+// --skip that also passes the cursor itself, adding any LogWithSkip count.
+// Other ancestors of a merge can sort before the commits of a page, so
+// cursors are only accepted with --first-parent
+func logCursorArgs(args []string) ([]string, error) {
+	sub := subcommandArgs(args)
+	result := append([]string{}, args[:len(args)-len(sub)]...)
+
+	// Paths follow "--"; the cursor is a revision and goes before them
+	var paths []string
+	for i, arg := range sub {
+		if arg == "--" {
+			sub, paths = sub[:i], sub[i:]
+			break
+		}
+	}
+
+	var cursor string
+	skip, firstParent := 0, false
+	for _, arg := range sub {
+		switch {
+		case strings.HasPrefix(arg, logCursorArg):
+			if cursor != "" {
+				return nil, fmt.Errorf("log cursor given more than once")
+			}
+			cursor = strings.TrimPrefix(arg, logCursorArg)
+			continue
+		case strings.HasPrefix(arg, "--skip="):
+			count, err := strconv.Atoi(strings.TrimPrefix(arg, "--skip="))
+			if err != nil {
+				return nil, fmt.Errorf("invalid log skip %q", arg)
+			}
+			skip += count
+			continue
+		case arg == "--first-parent":
+			firstParent = true
+		}
+		result = append(result, arg)
+	}
+	if cursor == "" {
+		return args, nil
+	}
+	if !firstParent {
+		return nil, fmt.Errorf("log cursor %s requires LogWithFirstParent, history with merges cannot be paged by ancestry", cursor)
+	}
+
+	result = append(result, cursor, fmt.Sprintf("--skip=%d", skip+1))
+	return append(result, paths...), nil
+}
+
+// Next advances to the next commit, returning false at the end of the log or
+// on errors, which Err reports
+func (it *LogIterator) Next() bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+
+	for {
+		token, err := it.reader.ReadString('\x00')
+		if err != nil && err != io.EOF {
+			it.err = err
+			return false
+		}
+		token = strings.TrimSuffix(token, "\x00")
+
+		switch {
+		case !it.started:
+			// Output before the first record, or output without records
+			if err == io.EOF {
+				it.err = checkLogRecords([]byte(token))
+			}
+			it.started = err == nil
+		case len(it.pending) < len(logFields):
+			it.pending = append(it.pending, token)
+		default:
+			// The text between two records holds the patch or numstat of the first
+			it.commit = parseLogRecord(it.pending)
+			if strings.TrimSpace(token) != "" {
+				it.commit.Diffs = parseDiffOutput(token)
+			}
+			it.cursor = it.commit.Commit
+			it.pending = nil
+			return true
+		}
+
+		if err == io.EOF {
+			return false
+		}
+	}
+}
+
+// Commit returns the commit Next advanced to
+func (it *LogIterator) Commit() types.Log {
+	return it.commit
+}
+
+// Cursor returns the SHA of the last commit read, which LogWithCursor
+// continues from
+func (it *LogIterator) Cursor() string {
+	return it.cursor
+}
+
+// Err returns the error that stopped the iteration, if any. Stopping the
+// iteration with Close is not an error
+func (it *LogIterator) Err() error {
+	return it.err
+}
+
+// Close stops git if it is still running and releases the iterator
+func (it *LogIterator) Close() error {
+	if it.closed {
+		return nil
+	}
+	it.closed = true
+	it.cancel()
+	it.pipe.Close()
+	<-it.done
+	return nil
+}
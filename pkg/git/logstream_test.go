@@ -0,0 +1,194 @@
+package git_test
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// collectLog reads every commit of a stream
+func collectLog(t *testing.T, gitInstance git.Git, opts ...git.Option) ([]types.Log, string) {
+	it, err := gitInstance.LogStream(opts...)
+	require.NoError(t, err)
+	defer it.Close()
+
+	logs := []types.Log{}
+	for it.Next() {
+		logs = append(logs, it.Commit())
+	}
+	require.NoError(t, it.Err())
+	return logs, it.Cursor()
+}
+
+// setupHistory adds count empty commits to a test repository
+func setupHistory(t *testing.T, count int) git.Git {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	for i := 1; i <= count; i++ {
+		require.NoError(t, gitInstance.Commit(fmt.Sprintf("Commit %d", i), git.CommitWithAllowEmpty()))
+	}
+	return gitInstance
+}
+
+// Test streaming matches Log and paging through history with cursors
+func TestLogStreamPagination(t *testing.T) {
+	gitInstance := setupHistory(t, 24)
+
+	expected, err := gitInstance.Log()
+	require.NoError(t, err)
+	require.Len(t, expected, 25)
+
+	streamed, cursor := collectLog(t, gitInstance)
+	assert.Equal(t, expected, streamed)
+	assert.Equal(t, expected[24].Commit, cursor)
+
+	// Pages of 10 commits, continued from the cursor
+	var paged []types.Log
+	page, cursor := collectLog(t, gitInstance, git.LogWithFirstParent(), git.LogWithMaxCount("10"))
+	paged = append(paged, page...)
+	for len(page) == 10 {
+		page, cursor = collectLog(t, gitInstance, git.LogWithFirstParent(), git.LogWithCursor(cursor), git.LogWithMaxCount("10"))
+		paged = append(paged, page...)
+	}
+	assert.Equal(t, expected, paged)
+
+	// Skipping continues after the cursor
+	page, _ = collectLog(t, gitInstance, git.LogWithFirstParent(), git.LogWithSkip(2), git.LogWithCursor(expected[9].Commit), git.LogWithMaxCount("3"))
+	assert.Equal(t, expected[12:15], page)
+
+	skipped, _ := collectLog(t, gitInstance, git.LogWithSkip(20))
+	assert.Equal(t, expected[20:], skipped)
+
+	// Streams with patches carry the diffs of each commit
+	withPatch, _ := collectLog(t, gitInstance, git.LogWithPatch(), git.LogWithRevisions("HEAD~1", "^HEAD~1"), git.LogWithMaxCount("1"))
+	assert.Empty(t, withPatch)
+	withPatch, _ = collectLog(t, gitInstance, git.LogWithPatch(), git.LogWithSkip(24))
+	require.Len(t, withPatch, 1)
+	require.Len(t, withPatch[0].Diffs, 1)
+	assert.Equal(t, "README.md", withPatch[0].Diffs[0].NewFile)
+}
+
+// Test typed rev-list filters
+func TestLogStreamFilters(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "feature.txt"), []byte("feature\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"feature.txt"}))
+	require.NoError(t, gitInstance.Commit("Add feature", git.CommitWithAuthor("Feature Dev", "dev@example.com")))
+
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Commit("Fix bug #12", git.CommitWithAllowEmpty()))
+	_, err = gitInstance.Merge(git.MergeWithBranch("feature"), git.MergeWithNoFF())
+	require.NoError(t, err)
+
+	subjects := func(opts ...git.Option) []string {
+		logs, _ := collectLog(t, gitInstance, opts...)
+		result := []string{}
+		for _, log := range logs {
+			result = append(result, log.Subject)
+		}
+		return result
+	}
+
+	assert.Equal(t, []string{"Add feature"}, subjects(git.LogWithAuthor("Feature Dev")))
+	assert.Equal(t, []string{"Fix bug #12"}, subjects(git.LogWithGrep("bug #[0-9]+"), git.WithArgs("--extended-regexp")))
+	assert.Equal(t, []string{"Add feature"}, subjects(git.LogWithPaths("feature.txt")))
+	assert.Equal(t, []string{"Merge branch 'feature'", "Fix bug #12", "Initial commit"}, subjects(git.LogWithFirstParent()))
+	assert.ElementsMatch(t, []string{"Fix bug #12", "Add feature", "Initial commit"}, subjects(git.LogWithNoMerges()))
+	assert.ElementsMatch(t, []string{"Merge branch 'feature'", "Fix bug #12"}, subjects(git.LogWithRevisions("feature..main")))
+	assert.Equal(t, []string{"Merge branch 'feature'"}, subjects(git.LogWithRevisions("feature..main"), git.LogWithAncestryPath()))
+	assert.Len(t, subjects(git.LogWithSince(time.Now().Add(-time.Hour))), 4)
+	assert.Empty(t, subjects(git.LogWithUntil(time.Now().Add(-time.Hour))))
+}
+
+// Test cursors on history with merges, which only page along first parents
+func TestLogStreamCursorMerges(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	require.NoError(t, gitInstance.Commit("Main 1", git.CommitWithAllowEmpty()))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Commit("Feature 1", git.CommitWithAllowEmpty()))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+	_, err = gitInstance.Merge(git.MergeWithBranch("feature"), git.MergeWithNoFF())
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Commit("Main 2", git.CommitWithAllowEmpty()))
+
+	first, cursor := collectLog(t, gitInstance, git.LogWithMaxCount("2"))
+	require.Len(t, first, 2)
+
+	// Ancestors of the cursor would leave out commits of the merged branch
+	_, err = gitInstance.LogStream(git.LogWithCursor(cursor))
+	assert.ErrorContains(t, err, "requires LogWithFirstParent")
+	_, err = gitInstance.Log(git.LogWithCursor(cursor))
+	assert.ErrorContains(t, err, "requires LogWithFirstParent")
+
+	expected, err := gitInstance.Log(git.LogWithFirstParent())
+	require.NoError(t, err)
+	require.Len(t, expected, 4)
+	var paged []types.Log
+	page, cursor := collectLog(t, gitInstance, git.LogWithFirstParent(), git.LogWithMaxCount("2"))
+	paged = append(paged, page...)
+	page, _ = collectLog(t, gitInstance, git.LogWithFirstParent(), git.LogWithMaxCount("2"), git.LogWithCursor(cursor))
+	paged = append(paged, page...)
+	assert.Equal(t, expected, paged)
+}
+
+// Test stopping a stream early, cancelling it and git errors
+func TestLogStreamCancel(t *testing.T) {
+	gitInstance := setupHistory(t, 50)
+
+	// Reading only part of the log
+	it, err := gitInstance.LogStream()
+	require.NoError(t, err)
+	require.True(t, it.Next())
+	assert.Equal(t, "Commit 50", it.Commit().Subject)
+	require.NoError(t, it.Close())
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it, err = gitInstance.WithContext(ctx).LogStream()
+	require.NoError(t, err)
+	require.True(t, it.Next())
+	cancel()
+	for it.Next() {
+	}
+	var canceledErr *errors.CanceledError
+	if it.Err() != nil {
+		assert.True(t, stderrors.As(it.Err(), &canceledErr), "expected CanceledError, got %T", it.Err())
+	}
+	require.NoError(t, it.Close())
+
+	it, err = gitInstance.LogStream(git.LogWithRevisions("missing"))
+	require.NoError(t, err)
+	assert.False(t, it.Next())
+	var gitErr *errors.GitError
+	require.True(t, stderrors.As(it.Err(), &gitErr), "expected GitError, got %T", it.Err())
+	require.NoError(t, it.Close())
+}
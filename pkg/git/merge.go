@@ -1,37 +1,163 @@
 package git
 
 import (
+	stderrors "errors"
+	"regexp"
+	"strconv"
 	"strings"
+
 	"github.com/instruqt/git-exec/pkg/git/errors"
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
-// Merge merges branches
+var (
+	mergeStrategyRegex = regexp.MustCompile(`Merge made by the '([^']+)' strategy`)
+	shortStatRegex     = regexp.MustCompile(`(\d+) (file|insertion|deletion)`)
+)
+
+// Merge merges branches. Conflicts are not reported as an error; the result
+// lists the conflicted files instead
 func (g *gitImpl) Merge(opts ...Option) (*types.MergeResult, error) {
 	cmd := g.newCommand("merge")
 	cmd.ApplyOptions(opts...)
-	
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+
 	result := &types.MergeResult{}
+	result.MergedBranch, result.Strategy = parseMergeArgs(subcommandArgs(cmd.GetArgs()))
+	result.BaseBranch = g.currentBranch()
+	base := g.revParse("HEAD")
+
 	output, err := cmd.Execute()
-	
 	if err != nil {
-		if gitErr, ok := err.(*errors.GitError); ok {
-			if strings.Contains(gitErr.Stderr, "CONFLICT") || strings.Contains(gitErr.Stdout, "CONFLICT") {
-				result.Success = false
-				return result, nil
-			}
+		if g.collectConflicts(result, err) {
+			return result, nil
+		}
+		var gitErr *errors.GitError
+		if stderrors.As(err, &gitErr) {
 			result.AbortReason = gitErr.Stderr
 		}
-		result.Success = false
 		return result, err
 	}
-	
+
+	g.completeMerge(result, base, string(output))
+	return result, nil
+}
+
+// collectConflicts fills the conflicted files of a failed merge, returning
+// false when the failure was not caused by conflicts
+func (g *gitImpl) collectConflicts(result *types.MergeResult, err error) bool {
+	// Unmerged entries left over from an earlier operation are not conflicts of this one
+	var gitErr *errors.GitError
+	if !stderrors.As(err, &gitErr) ||
+		!(strings.Contains(gitErr.Stdout, "CONFLICT") || strings.Contains(gitErr.Stderr, "CONFLICT")) {
+		return false
+	}
+
+	conflicts, listErr := g.listConflicts()
+	if listErr != nil || len(conflicts) == 0 {
+		return false
+	}
+
+	result.Success = false
+	g.loadConflictSections(conflicts)
+	result.Conflicts = conflicts
+	for _, conflict := range conflicts {
+		result.ConflictedFiles = append(result.ConflictedFiles, conflict.Path)
+	}
+	if matches := mergeStrategyRegex.FindStringSubmatch(gitErr.Stdout); matches != nil && result.Strategy == "" {
+		result.Strategy = matches[1]
+	}
+	return true
+}
+
+// completeMerge fills the result of a successful merge from its output and
+// the commit it produced
+func (g *gitImpl) completeMerge(result *types.MergeResult, base, output string) {
 	result.Success = true
-	outputStr := string(output)
-	if strings.Contains(outputStr, "Fast-forward") {
-		result.FastForward = true
+	result.FastForward = strings.Contains(output, "Fast-forward")
+	if matches := mergeStrategyRegex.FindStringSubmatch(output); matches != nil {
+		result.Strategy = matches[1]
+	}
+
+	if base == "" {
+		return
+	}
+
+	// Squashed and uncommitted merges leave HEAD where it was
+	if head := g.revParse("HEAD"); head != base {
+		result.MergeCommit = head
+	}
+
+	// Compare against the index so squashed merges are counted as well
+	statCmd := g.newCommand("diff", "--shortstat", "--cached", base)
+	statCmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+	if statOutput, err := statCmd.Execute(); err == nil {
+		result.Stats = parseShortStat(string(statOutput))
 	}
-	return result, nil
+}
+
+// parseShortStat parses a "N files changed, N insertions(+), N deletions(-)" summary
+func parseShortStat(output string) types.MergeStats {
+	stats := types.MergeStats{}
+	for _, match := range shortStatRegex.FindAllStringSubmatch(output, -1) {
+		count, _ := strconv.Atoi(match[1])
+		switch match[2] {
+		case "file":
+			stats.FilesChanged = count
+		case "insertion":
+			stats.Insertions = count
+		case "deletion":
+			stats.Deletions = count
+		}
+	}
+	return stats
+}
+
+// parseMergeArgs extracts the merged commits and the requested strategy from
+// merge arguments
+func parseMergeArgs(args []string) (string, string) {
+	var heads []string
+	var strategy string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "-s" || arg == "--strategy":
+			if i+1 < len(args) {
+				i++
+				strategy = args[i]
+			}
+		case strings.HasPrefix(arg, "--strategy="):
+			strategy = strings.TrimPrefix(arg, "--strategy=")
+		case arg == "-m" || arg == "-F" || arg == "-X" || arg == "--file" || arg == "--strategy-option":
+			i++
+		case strings.HasPrefix(arg, "-"):
+		default:
+			heads = append(heads, arg)
+		}
+	}
+
+	return strings.Join(heads, " "), strategy
+}
+
+// revParse resolves a revision to its SHA, returning "" when it does not exist
+func (g *gitImpl) revParse(rev string) string {
+	cmd := g.newCommand("rev-parse", "--verify", "--quiet", rev)
+	output, err := cmd.Execute()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// currentBranch returns the checked out branch, or "" when HEAD is detached
+func (g *gitImpl) currentBranch() string {
+	cmd := g.newCommand("symbolic-ref", "--quiet", "--short", "HEAD")
+	output, err := cmd.Execute()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
 }
 
 // MergeAbort aborts a merge in progress
@@ -47,25 +173,3 @@ func (g *gitImpl) MergeContinue() error {
 	_, err := cmd.Execute()
 	return err
 }
-
-// ResolveConflicts resolves merge conflicts using specified resolutions
-func (g *gitImpl) ResolveConflicts(resolutions []types.ConflictResolution) error {
-	for _, resolution := range resolutions {
-		if resolution.UseOurs {
-			cmd := g.newCommand("checkout", "--ours", resolution.FilePath)
-			if _, err := cmd.Execute(); err != nil {
-				return err
-			}
-		} else if resolution.UseTheirs {
-			cmd := g.newCommand("checkout", "--theirs", resolution.FilePath)
-			if _, err := cmd.Execute(); err != nil {
-				return err
-			}
-		}
-		addCmd := g.newCommand("add", resolution.FilePath)
-		if _, err := addCmd.Execute(); err != nil {
-			return err
-		}
-	}
-	return nil
-}
\ No newline at end of file
@@ -89,7 +89,7 @@ footer`
 	}
 	
 	// Note: This tests the interface, actual conflict resolution depends on Git state
-	err = gitInstance.ResolveConflicts(resolutions)
+	_, err = gitInstance.ResolveConflicts(resolutions)
 	// Don't require this to succeed as Git state may vary, but it should not panic
 	
 	// Test merge abort functionality
@@ -215,4 +215,117 @@ func TestMergeAbortAndContinue(t *testing.T) {
 	
 	// These tests verify the methods exist and handle edge cases without panicking
 	// The actual merge workflow testing is covered in other tests
-}
\ No newline at end of file
+}
+
+// Test merge results report the merge commit, strategy and diffstat
+func TestMergeResultDetails(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "feature.txt"), []byte("one\ntwo\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("changed\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"feature.txt", "README.md"}))
+	require.NoError(t, gitInstance.Commit("Feature work"))
+
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+
+	result, err := gitInstance.Merge(git.MergeWithBranch("feature"), git.MergeWithNoFF())
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.False(t, result.FastForward)
+	assert.Equal(t, "feature", result.MergedBranch)
+	assert.Equal(t, "main", result.BaseBranch)
+	assert.Equal(t, "ort", result.Strategy)
+	assert.Equal(t, types.MergeStats{FilesChanged: 2, Insertions: 3, Deletions: 1}, result.Stats)
+	assert.Empty(t, result.ConflictedFiles)
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, logs[0].Commit, result.MergeCommit)
+
+	// Nothing left to merge
+	result, err = gitInstance.Merge(git.MergeWithBranch("feature"))
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Empty(t, result.MergeCommit)
+	assert.Equal(t, types.MergeStats{}, result.Stats)
+}
+
+// Test conflicted merges list each conflicted path with its conflict type
+func TestMergeConflictDetails(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "removed.txt"), []byte("base\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"removed.txt"}))
+	require.NoError(t, gitInstance.Commit("Add removed.txt"))
+
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("feature\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "added.txt"), []byte("feature\n"), 0644))
+	require.NoError(t, gitInstance.Remove(git.WithArgs("removed.txt")))
+	require.NoError(t, gitInstance.Add([]string{}, git.AddWithAll()))
+	require.NoError(t, gitInstance.Commit("Feature changes"))
+
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("main"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("main\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "added.txt"), []byte("main\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "removed.txt"), []byte("changed\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{}, git.AddWithAll()))
+	require.NoError(t, gitInstance.Commit("Main changes"))
+
+	result, err := gitInstance.Merge(git.MergeWithBranch("feature"))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, "feature", result.MergedBranch)
+	assert.Empty(t, result.MergeCommit)
+	assert.ElementsMatch(t, []string{"README.md", "added.txt", "removed.txt"}, result.ConflictedFiles)
+
+	statuses := make(map[string]types.ConflictStatus)
+	for _, conflict := range result.Conflicts {
+		statuses[conflict.Path] = conflict.Status
+	}
+	assert.Equal(t, map[string]types.ConflictStatus{
+		"README.md":   types.ConflictStatusBothModified,
+		"added.txt":   types.ConflictStatusBothAdded,
+		"removed.txt": types.ConflictStatusDeletedByThem,
+	}, statuses)
+
+	require.NoError(t, gitInstance.MergeAbort())
+}
+
+// Test conflicted pulls report the conflicted paths
+func TestPullConflicts(t *testing.T) {
+	_, upstreamDir, downstreamDir := setupRemotePair(t)
+	upstream := openTestRepo(t, upstreamDir)
+	downstream := openTestRepo(t, downstreamDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(upstreamDir, "shared.txt"), []byte("upstream\n"), 0644))
+	require.NoError(t, upstream.Add([]string{"shared.txt"}))
+	require.NoError(t, upstream.Commit("Upstream change"))
+	_, err := upstream.Push()
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(downstreamDir, "shared.txt"), []byte("downstream\n"), 0644))
+	require.NoError(t, downstream.Add([]string{"shared.txt"}))
+	require.NoError(t, downstream.Commit("Downstream change"))
+
+	result, err := downstream.Pull(git.WithArgs("--no-rebase"))
+	require.Error(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, []string{"shared.txt"}, result.ConflictedFiles)
+	require.Len(t, result.Conflicts, 1)
+	assert.Equal(t, types.ConflictStatusBothAdded, result.Conflicts[0].Status)
+}
@@ -6,6 +6,7 @@ import (
 	git "github.com/instruqt/git-exec/pkg/git"
 	mock "github.com/stretchr/testify/mock"
 
+	io "io"
 	time "time"
 )
 
@@ -228,6 +229,52 @@ func (_c *MockCommand_ExecuteCombined_Call) RunAndReturn(run func() ([]byte, err
 	return _c
 }
 
+// ExecuteTo provides a mock function with given fields: stdout
+func (_m *MockCommand) ExecuteTo(stdout io.Writer) error {
+	ret := _m.Called(stdout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer) error); ok {
+		r0 = rf(stdout)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCommand_ExecuteTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteTo'
+type MockCommand_ExecuteTo_Call struct {
+	*mock.Call
+}
+
+// ExecuteTo is a helper method to define mock.On call
+//   - stdout io.Writer
+func (_e *MockCommand_Expecter) ExecuteTo(stdout interface{}) *MockCommand_ExecuteTo_Call {
+	return &MockCommand_ExecuteTo_Call{Call: _e.mock.On("ExecuteTo", stdout)}
+}
+
+func (_c *MockCommand_ExecuteTo_Call) Run(run func(stdout io.Writer)) *MockCommand_ExecuteTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockCommand_ExecuteTo_Call) Return(_a0 error) *MockCommand_ExecuteTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCommand_ExecuteTo_Call) RunAndReturn(run func(io.Writer) error) *MockCommand_ExecuteTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExecuteWithStderr provides a mock function with no fields
 func (_m *MockCommand) ExecuteWithStderr() ([]byte, error) {
 	ret := _m.Called()
@@ -6,7 +6,9 @@ import (
 	git "github.com/instruqt/git-exec/pkg/git"
 	mock "github.com/stretchr/testify/mock"
 
+	context "context"
 	types "github.com/instruqt/git-exec/pkg/git/types"
+	io "io"
 )
 
 // MockGit is an autogenerated mock type for the Git type
@@ -145,6 +147,1118 @@ func (_c *MockGit_AddRemote_Call) RunAndReturn(run func(string, string, ...git.O
 	return _c
 }
 
+// Archive provides a mock function with given fields: treeish, w, options
+func (_m *MockGit) Archive(treeish string, w io.Writer, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, treeish, w)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Archive")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Writer, ...git.Option) error); ok {
+		r0 = rf(treeish, w, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockGit_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - treeish string
+//   - w io.Writer
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Archive(treeish interface{}, w interface{}, options ...interface{}) *MockGit_Archive_Call {
+	return &MockGit_Archive_Call{Call: _e.mock.On("Archive",
+		append([]interface{}{treeish, w}, options...)...)}
+}
+
+func (_c *MockGit_Archive_Call) Run(run func(treeish string, w io.Writer, options ...git.Option)) *MockGit_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(io.Writer), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Archive_Call) Return(_a0 error) *MockGit_Archive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_Archive_Call) RunAndReturn(run func(string, io.Writer, ...git.Option) error) *MockGit_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectBad provides a mock function with given fields: revs
+func (_m *MockGit) BisectBad(revs ...string) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(revs))
+	for _i := range revs {
+		_va[_i] = revs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectBad")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (*types.BisectResult, error)); ok {
+		return rf(revs...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) *types.BisectResult); ok {
+		r0 = rf(revs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(revs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectBad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectBad'
+type MockGit_BisectBad_Call struct {
+	*mock.Call
+}
+
+// BisectBad is a helper method to define mock.On call
+//   - revs ...string
+func (_e *MockGit_Expecter) BisectBad(revs ...interface{}) *MockGit_BisectBad_Call {
+	return &MockGit_BisectBad_Call{Call: _e.mock.On("BisectBad",
+		append([]interface{}{}, revs...)...)}
+}
+
+func (_c *MockGit_BisectBad_Call) Run(run func(revs ...string)) *MockGit_BisectBad_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectBad_Call) Return(_a0 *types.BisectResult, _a1 error) *MockGit_BisectBad_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectBad_Call) RunAndReturn(run func(...string) (*types.BisectResult, error)) *MockGit_BisectBad_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectGood provides a mock function with given fields: revs
+func (_m *MockGit) BisectGood(revs ...string) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(revs))
+	for _i := range revs {
+		_va[_i] = revs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectGood")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (*types.BisectResult, error)); ok {
+		return rf(revs...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) *types.BisectResult); ok {
+		r0 = rf(revs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(revs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectGood_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectGood'
+type MockGit_BisectGood_Call struct {
+	*mock.Call
+}
+
+// BisectGood is a helper method to define mock.On call
+//   - revs ...string
+func (_e *MockGit_Expecter) BisectGood(revs ...interface{}) *MockGit_BisectGood_Call {
+	return &MockGit_BisectGood_Call{Call: _e.mock.On("BisectGood",
+		append([]interface{}{}, revs...)...)}
+}
+
+func (_c *MockGit_BisectGood_Call) Run(run func(revs ...string)) *MockGit_BisectGood_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectGood_Call) Return(_a0 *types.BisectResult, _a1 error) *MockGit_BisectGood_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectGood_Call) RunAndReturn(run func(...string) (*types.BisectResult, error)) *MockGit_BisectGood_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectLog provides a mock function with no fields
+func (_m *MockGit) BisectLog() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectLog")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectLog'
+type MockGit_BisectLog_Call struct {
+	*mock.Call
+}
+
+// BisectLog is a helper method to define mock.On call
+func (_e *MockGit_Expecter) BisectLog() *MockGit_BisectLog_Call {
+	return &MockGit_BisectLog_Call{Call: _e.mock.On("BisectLog")}
+}
+
+func (_c *MockGit_BisectLog_Call) Run(run func()) *MockGit_BisectLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectLog_Call) Return(_a0 string, _a1 error) *MockGit_BisectLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectLog_Call) RunAndReturn(run func() (string, error)) *MockGit_BisectLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectReplay provides a mock function with given fields: logFile
+func (_m *MockGit) BisectReplay(logFile string) (*types.BisectResult, error) {
+	ret := _m.Called(logFile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectReplay")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*types.BisectResult, error)); ok {
+		return rf(logFile)
+	}
+	if rf, ok := ret.Get(0).(func(string) *types.BisectResult); ok {
+		r0 = rf(logFile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(logFile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectReplay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectReplay'
+type MockGit_BisectReplay_Call struct {
+	*mock.Call
+}
+
+// BisectReplay is a helper method to define mock.On call
+//   - logFile string
+func (_e *MockGit_Expecter) BisectReplay(logFile interface{}) *MockGit_BisectReplay_Call {
+	return &MockGit_BisectReplay_Call{Call: _e.mock.On("BisectReplay", logFile)}
+}
+
+func (_c *MockGit_BisectReplay_Call) Run(run func(logFile string)) *MockGit_BisectReplay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectReplay_Call) Return(_a0 *types.BisectResult, _a1 error) *MockGit_BisectReplay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectReplay_Call) RunAndReturn(run func(string) (*types.BisectResult, error)) *MockGit_BisectReplay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectReset provides a mock function with given fields: commit
+func (_m *MockGit) BisectReset(commit string) error {
+	ret := _m.Called(commit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectReset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(commit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_BisectReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectReset'
+type MockGit_BisectReset_Call struct {
+	*mock.Call
+}
+
+// BisectReset is a helper method to define mock.On call
+//   - commit string
+func (_e *MockGit_Expecter) BisectReset(commit interface{}) *MockGit_BisectReset_Call {
+	return &MockGit_BisectReset_Call{Call: _e.mock.On("BisectReset", commit)}
+}
+
+func (_c *MockGit_BisectReset_Call) Run(run func(commit string)) *MockGit_BisectReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectReset_Call) Return(_a0 error) *MockGit_BisectReset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_BisectReset_Call) RunAndReturn(run func(string) error) *MockGit_BisectReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectRun provides a mock function with given fields: command, opts
+func (_m *MockGit) BisectRun(command string, opts ...git.Option) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, command)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectRun")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.BisectResult, error)); ok {
+		return rf(command, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.BisectResult); ok {
+		r0 = rf(command, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(command, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectRun'
+type MockGit_BisectRun_Call struct {
+	*mock.Call
+}
+
+// BisectRun is a helper method to define mock.On call
+//   - command string
+//   - opts ...git.Option
+func (_e *MockGit_Expecter) BisectRun(command interface{}, opts ...interface{}) *MockGit_BisectRun_Call {
+	return &MockGit_BisectRun_Call{Call: _e.mock.On("BisectRun",
+		append([]interface{}{command}, opts...)...)}
+}
+
+func (_c *MockGit_BisectRun_Call) Run(run func(command string, opts ...git.Option)) *MockGit_BisectRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectRun_Call) Return(_a0 *types.BisectResult, _a1 error) *MockGit_BisectRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectRun_Call) RunAndReturn(run func(string, ...git.Option) (*types.BisectResult, error)) *MockGit_BisectRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectRunFunc provides a mock function with given fields: test
+func (_m *MockGit) BisectRunFunc(test func(string) (types.BisectVerdict, error)) (*types.BisectResult, error) {
+	ret := _m.Called(test)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectRunFunc")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(func(string) (types.BisectVerdict, error)) (*types.BisectResult, error)); ok {
+		return rf(test)
+	}
+	if rf, ok := ret.Get(0).(func(func(string) (types.BisectVerdict, error)) *types.BisectResult); ok {
+		r0 = rf(test)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(func(string) (types.BisectVerdict, error)) error); ok {
+		r1 = rf(test)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectRunFunc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectRunFunc'
+type MockGit_BisectRunFunc_Call struct {
+	*mock.Call
+}
+
+// BisectRunFunc is a helper method to define mock.On call
+//   - test func(string) (types.BisectVerdict, error)
+func (_e *MockGit_Expecter) BisectRunFunc(test interface{}) *MockGit_BisectRunFunc_Call {
+	return &MockGit_BisectRunFunc_Call{Call: _e.mock.On("BisectRunFunc", test)}
+}
+
+func (_c *MockGit_BisectRunFunc_Call) Run(run func(test func(string) (types.BisectVerdict, error))) *MockGit_BisectRunFunc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(string) (types.BisectVerdict, error)))
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectRunFunc_Call) Return(_a0 *types.BisectResult, _a1 error) *MockGit_BisectRunFunc_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectRunFunc_Call) RunAndReturn(run func(func(string) (types.BisectVerdict, error)) (*types.BisectResult, error)) *MockGit_BisectRunFunc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectSkip provides a mock function with given fields: revs
+func (_m *MockGit) BisectSkip(revs ...string) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(revs))
+	for _i := range revs {
+		_va[_i] = revs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectSkip")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (*types.BisectResult, error)); ok {
+		return rf(revs...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) *types.BisectResult); ok {
+		r0 = rf(revs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(revs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectSkip'
+type MockGit_BisectSkip_Call struct {
+	*mock.Call
+}
+
+// BisectSkip is a helper method to define mock.On call
+//   - revs ...string
+func (_e *MockGit_Expecter) BisectSkip(revs ...interface{}) *MockGit_BisectSkip_Call {
+	return &MockGit_BisectSkip_Call{Call: _e.mock.On("BisectSkip",
+		append([]interface{}{}, revs...)...)}
+}
+
+func (_c *MockGit_BisectSkip_Call) Run(run func(revs ...string)) *MockGit_BisectSkip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectSkip_Call) Return(_a0 *types.BisectResult, _a1 error) *MockGit_BisectSkip_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectSkip_Call) RunAndReturn(run func(...string) (*types.BisectResult, error)) *MockGit_BisectSkip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectStart provides a mock function with given fields: bad, good, options
+func (_m *MockGit) BisectStart(bad string, good []string, options ...git.Option) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, bad, good)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectStart")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string, ...git.Option) (*types.BisectResult, error)); ok {
+		return rf(bad, good, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, ...git.Option) *types.BisectResult); ok {
+		r0 = rf(bad, good, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, ...git.Option) error); ok {
+		r1 = rf(bad, good, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectStart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectStart'
+type MockGit_BisectStart_Call struct {
+	*mock.Call
+}
+
+// BisectStart is a helper method to define mock.On call
+//   - bad string
+//   - good []string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) BisectStart(bad interface{}, good interface{}, options ...interface{}) *MockGit_BisectStart_Call {
+	return &MockGit_BisectStart_Call{Call: _e.mock.On("BisectStart",
+		append([]interface{}{bad, good}, options...)...)}
+}
+
+func (_c *MockGit_BisectStart_Call) Run(run func(bad string, good []string, options ...git.Option)) *MockGit_BisectStart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectStart_Call) Return(_a0 *types.BisectResult, _a1 error) *MockGit_BisectStart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectStart_Call) RunAndReturn(run func(string, []string, ...git.Option) (*types.BisectResult, error)) *MockGit_BisectStart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectStatus provides a mock function with no fields
+func (_m *MockGit) BisectStatus() (*types.BisectResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectStatus")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.BisectResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.BisectResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BisectStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectStatus'
+type MockGit_BisectStatus_Call struct {
+	*mock.Call
+}
+
+// BisectStatus is a helper method to define mock.On call
+func (_e *MockGit_Expecter) BisectStatus() *MockGit_BisectStatus_Call {
+	return &MockGit_BisectStatus_Call{Call: _e.mock.On("BisectStatus")}
+}
+
+func (_c *MockGit_BisectStatus_Call) Run(run func()) *MockGit_BisectStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_BisectStatus_Call) Return(_a0 *types.BisectResult, _a1 error) *MockGit_BisectStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BisectStatus_Call) RunAndReturn(run func() (*types.BisectResult, error)) *MockGit_BisectStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Blame provides a mock function with given fields: path, options
+func (_m *MockGit) Blame(path string, options ...git.Option) ([]types.BlameLine, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, path)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Blame")
+	}
+
+	var r0 []types.BlameLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.BlameLine, error)); ok {
+		return rf(path, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.BlameLine); ok {
+		r0 = rf(path, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.BlameLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(path, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Blame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Blame'
+type MockGit_Blame_Call struct {
+	*mock.Call
+}
+
+// Blame is a helper method to define mock.On call
+//   - path string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Blame(path interface{}, options ...interface{}) *MockGit_Blame_Call {
+	return &MockGit_Blame_Call{Call: _e.mock.On("Blame",
+		append([]interface{}{path}, options...)...)}
+}
+
+func (_c *MockGit_Blame_Call) Run(run func(path string, options ...git.Option)) *MockGit_Blame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Blame_Call) Return(_a0 []types.BlameLine, _a1 error) *MockGit_Blame_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Blame_Call) RunAndReturn(run func(string, ...git.Option) ([]types.BlameLine, error)) *MockGit_Blame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleCreate provides a mock function with given fields: file, revs, options
+func (_m *MockGit) BundleCreate(file string, revs []string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file, revs)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleCreate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string, ...git.Option) error); ok {
+		r0 = rf(file, revs, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_BundleCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleCreate'
+type MockGit_BundleCreate_Call struct {
+	*mock.Call
+}
+
+// BundleCreate is a helper method to define mock.On call
+//   - file string
+//   - revs []string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) BundleCreate(file interface{}, revs interface{}, options ...interface{}) *MockGit_BundleCreate_Call {
+	return &MockGit_BundleCreate_Call{Call: _e.mock.On("BundleCreate",
+		append([]interface{}{file, revs}, options...)...)}
+}
+
+func (_c *MockGit_BundleCreate_Call) Run(run func(file string, revs []string, options ...git.Option)) *MockGit_BundleCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BundleCreate_Call) Return(_a0 error) *MockGit_BundleCreate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_BundleCreate_Call) RunAndReturn(run func(string, []string, ...git.Option) error) *MockGit_BundleCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleCreateTo provides a mock function with given fields: w, revs, options
+func (_m *MockGit) BundleCreateTo(w io.Writer, revs []string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, w, revs)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleCreateTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer, []string, ...git.Option) error); ok {
+		r0 = rf(w, revs, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_BundleCreateTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleCreateTo'
+type MockGit_BundleCreateTo_Call struct {
+	*mock.Call
+}
+
+// BundleCreateTo is a helper method to define mock.On call
+//   - w io.Writer
+//   - revs []string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) BundleCreateTo(w interface{}, revs interface{}, options ...interface{}) *MockGit_BundleCreateTo_Call {
+	return &MockGit_BundleCreateTo_Call{Call: _e.mock.On("BundleCreateTo",
+		append([]interface{}{w, revs}, options...)...)}
+}
+
+func (_c *MockGit_BundleCreateTo_Call) Run(run func(w io.Writer, revs []string, options ...git.Option)) *MockGit_BundleCreateTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(io.Writer), args[1].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BundleCreateTo_Call) Return(_a0 error) *MockGit_BundleCreateTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_BundleCreateTo_Call) RunAndReturn(run func(io.Writer, []string, ...git.Option) error) *MockGit_BundleCreateTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleListHeads provides a mock function with given fields: file, options
+func (_m *MockGit) BundleListHeads(file string, options ...git.Option) ([]types.BundleRef, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleListHeads")
+	}
+
+	var r0 []types.BundleRef
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.BundleRef, error)); ok {
+		return rf(file, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.BundleRef); ok {
+		r0 = rf(file, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.BundleRef)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(file, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BundleListHeads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleListHeads'
+type MockGit_BundleListHeads_Call struct {
+	*mock.Call
+}
+
+// BundleListHeads is a helper method to define mock.On call
+//   - file string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) BundleListHeads(file interface{}, options ...interface{}) *MockGit_BundleListHeads_Call {
+	return &MockGit_BundleListHeads_Call{Call: _e.mock.On("BundleListHeads",
+		append([]interface{}{file}, options...)...)}
+}
+
+func (_c *MockGit_BundleListHeads_Call) Run(run func(file string, options ...git.Option)) *MockGit_BundleListHeads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BundleListHeads_Call) Return(_a0 []types.BundleRef, _a1 error) *MockGit_BundleListHeads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BundleListHeads_Call) RunAndReturn(run func(string, ...git.Option) ([]types.BundleRef, error)) *MockGit_BundleListHeads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleUnbundle provides a mock function with given fields: file, options
+func (_m *MockGit) BundleUnbundle(file string, options ...git.Option) ([]types.BundleRef, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleUnbundle")
+	}
+
+	var r0 []types.BundleRef
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.BundleRef, error)); ok {
+		return rf(file, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.BundleRef); ok {
+		r0 = rf(file, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.BundleRef)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(file, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BundleUnbundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleUnbundle'
+type MockGit_BundleUnbundle_Call struct {
+	*mock.Call
+}
+
+// BundleUnbundle is a helper method to define mock.On call
+//   - file string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) BundleUnbundle(file interface{}, options ...interface{}) *MockGit_BundleUnbundle_Call {
+	return &MockGit_BundleUnbundle_Call{Call: _e.mock.On("BundleUnbundle",
+		append([]interface{}{file}, options...)...)}
+}
+
+func (_c *MockGit_BundleUnbundle_Call) Run(run func(file string, options ...git.Option)) *MockGit_BundleUnbundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BundleUnbundle_Call) Return(_a0 []types.BundleRef, _a1 error) *MockGit_BundleUnbundle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BundleUnbundle_Call) RunAndReturn(run func(string, ...git.Option) ([]types.BundleRef, error)) *MockGit_BundleUnbundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleVerify provides a mock function with given fields: file, options
+func (_m *MockGit) BundleVerify(file string, options ...git.Option) (*types.BundleInfo, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleVerify")
+	}
+
+	var r0 *types.BundleInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.BundleInfo, error)); ok {
+		return rf(file, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.BundleInfo); ok {
+		r0 = rf(file, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BundleInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(file, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_BundleVerify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleVerify'
+type MockGit_BundleVerify_Call struct {
+	*mock.Call
+}
+
+// BundleVerify is a helper method to define mock.On call
+//   - file string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) BundleVerify(file interface{}, options ...interface{}) *MockGit_BundleVerify_Call {
+	return &MockGit_BundleVerify_Call{Call: _e.mock.On("BundleVerify",
+		append([]interface{}{file}, options...)...)}
+}
+
+func (_c *MockGit_BundleVerify_Call) Run(run func(file string, options ...git.Option)) *MockGit_BundleVerify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_BundleVerify_Call) Return(_a0 *types.BundleInfo, _a1 error) *MockGit_BundleVerify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_BundleVerify_Call) RunAndReturn(run func(string, ...git.Option) (*types.BundleInfo, error)) *MockGit_BundleVerify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Checkout provides a mock function with given fields: options
 func (_m *MockGit) Checkout(options ...git.Option) (*types.CheckoutResult, error) {
 	_va := make([]interface{}, len(options))
@@ -156,24 +1270,3105 @@ func (_m *MockGit) Checkout(options ...git.Option) (*types.CheckoutResult, error
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Checkout")
+		panic("no return value specified for Checkout")
+	}
+
+	var r0 *types.CheckoutResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.CheckoutResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.CheckoutResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CheckoutResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Checkout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Checkout'
+type MockGit_Checkout_Call struct {
+	*mock.Call
+}
+
+// Checkout is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Checkout(options ...interface{}) *MockGit_Checkout_Call {
+	return &MockGit_Checkout_Call{Call: _e.mock.On("Checkout",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Checkout_Call) Run(run func(options ...git.Option)) *MockGit_Checkout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Checkout_Call) Return(_a0 *types.CheckoutResult, _a1 error) *MockGit_Checkout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Checkout_Call) RunAndReturn(run func(...git.Option) (*types.CheckoutResult, error)) *MockGit_Checkout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPick provides a mock function with given fields: commits, options
+func (_m *MockGit) CherryPick(commits []string, options ...git.Option) (*types.SequencerResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, commits)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPick")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) (*types.SequencerResult, error)); ok {
+		return rf(commits, options...)
+	}
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) *types.SequencerResult); ok {
+		r0 = rf(commits, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, ...git.Option) error); ok {
+		r1 = rf(commits, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_CherryPick_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPick'
+type MockGit_CherryPick_Call struct {
+	*mock.Call
+}
+
+// CherryPick is a helper method to define mock.On call
+//   - commits []string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) CherryPick(commits interface{}, options ...interface{}) *MockGit_CherryPick_Call {
+	return &MockGit_CherryPick_Call{Call: _e.mock.On("CherryPick",
+		append([]interface{}{commits}, options...)...)}
+}
+
+func (_c *MockGit_CherryPick_Call) Run(run func(commits []string, options ...git.Option)) *MockGit_CherryPick_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_CherryPick_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockGit_CherryPick_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_CherryPick_Call) RunAndReturn(run func([]string, ...git.Option) (*types.SequencerResult, error)) *MockGit_CherryPick_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPickAbort provides a mock function with no fields
+func (_m *MockGit) CherryPickAbort() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPickAbort")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_CherryPickAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPickAbort'
+type MockGit_CherryPickAbort_Call struct {
+	*mock.Call
+}
+
+// CherryPickAbort is a helper method to define mock.On call
+func (_e *MockGit_Expecter) CherryPickAbort() *MockGit_CherryPickAbort_Call {
+	return &MockGit_CherryPickAbort_Call{Call: _e.mock.On("CherryPickAbort")}
+}
+
+func (_c *MockGit_CherryPickAbort_Call) Run(run func()) *MockGit_CherryPickAbort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_CherryPickAbort_Call) Return(_a0 error) *MockGit_CherryPickAbort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_CherryPickAbort_Call) RunAndReturn(run func() error) *MockGit_CherryPickAbort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPickContinue provides a mock function with no fields
+func (_m *MockGit) CherryPickContinue() (*types.SequencerResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPickContinue")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.SequencerResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.SequencerResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_CherryPickContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPickContinue'
+type MockGit_CherryPickContinue_Call struct {
+	*mock.Call
+}
+
+// CherryPickContinue is a helper method to define mock.On call
+func (_e *MockGit_Expecter) CherryPickContinue() *MockGit_CherryPickContinue_Call {
+	return &MockGit_CherryPickContinue_Call{Call: _e.mock.On("CherryPickContinue")}
+}
+
+func (_c *MockGit_CherryPickContinue_Call) Run(run func()) *MockGit_CherryPickContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_CherryPickContinue_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockGit_CherryPickContinue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_CherryPickContinue_Call) RunAndReturn(run func() (*types.SequencerResult, error)) *MockGit_CherryPickContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPickQuit provides a mock function with no fields
+func (_m *MockGit) CherryPickQuit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPickQuit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_CherryPickQuit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPickQuit'
+type MockGit_CherryPickQuit_Call struct {
+	*mock.Call
+}
+
+// CherryPickQuit is a helper method to define mock.On call
+func (_e *MockGit_Expecter) CherryPickQuit() *MockGit_CherryPickQuit_Call {
+	return &MockGit_CherryPickQuit_Call{Call: _e.mock.On("CherryPickQuit")}
+}
+
+func (_c *MockGit_CherryPickQuit_Call) Run(run func()) *MockGit_CherryPickQuit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_CherryPickQuit_Call) Return(_a0 error) *MockGit_CherryPickQuit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_CherryPickQuit_Call) RunAndReturn(run func() error) *MockGit_CherryPickQuit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPickSkip provides a mock function with no fields
+func (_m *MockGit) CherryPickSkip() (*types.SequencerResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPickSkip")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.SequencerResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.SequencerResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_CherryPickSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPickSkip'
+type MockGit_CherryPickSkip_Call struct {
+	*mock.Call
+}
+
+// CherryPickSkip is a helper method to define mock.On call
+func (_e *MockGit_Expecter) CherryPickSkip() *MockGit_CherryPickSkip_Call {
+	return &MockGit_CherryPickSkip_Call{Call: _e.mock.On("CherryPickSkip")}
+}
+
+func (_c *MockGit_CherryPickSkip_Call) Run(run func()) *MockGit_CherryPickSkip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_CherryPickSkip_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockGit_CherryPickSkip_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_CherryPickSkip_Call) RunAndReturn(run func() (*types.SequencerResult, error)) *MockGit_CherryPickSkip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Clone provides a mock function with given fields: url, destination, options
+func (_m *MockGit) Clone(url string, destination string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, url, destination)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Clone")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(url, destination, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_Clone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clone'
+type MockGit_Clone_Call struct {
+	*mock.Call
+}
+
+// Clone is a helper method to define mock.On call
+//   - url string
+//   - destination string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Clone(url interface{}, destination interface{}, options ...interface{}) *MockGit_Clone_Call {
+	return &MockGit_Clone_Call{Call: _e.mock.On("Clone",
+		append([]interface{}{url, destination}, options...)...)}
+}
+
+func (_c *MockGit_Clone_Call) Run(run func(url string, destination string, options ...git.Option)) *MockGit_Clone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Clone_Call) Return(_a0 error) *MockGit_Clone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_Clone_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_Clone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Commit provides a mock function with given fields: message, options
+func (_m *MockGit) Commit(message string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, message)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(message, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MockGit_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - message string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Commit(message interface{}, options ...interface{}) *MockGit_Commit_Call {
+	return &MockGit_Commit_Call{Call: _e.mock.On("Commit",
+		append([]interface{}{message}, options...)...)}
+}
+
+func (_c *MockGit_Commit_Call) Run(run func(message string, options ...git.Option)) *MockGit_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Commit_Call) Return(_a0 error) *MockGit_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_Commit_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Conflicts provides a mock function with given fields: options
+func (_m *MockGit) Conflicts(options ...git.Option) ([]types.ConflictFile, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Conflicts")
+	}
+
+	var r0 []types.ConflictFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.ConflictFile, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.ConflictFile); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ConflictFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Conflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Conflicts'
+type MockGit_Conflicts_Call struct {
+	*mock.Call
+}
+
+// Conflicts is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Conflicts(options ...interface{}) *MockGit_Conflicts_Call {
+	return &MockGit_Conflicts_Call{Call: _e.mock.On("Conflicts",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Conflicts_Call) Run(run func(options ...git.Option)) *MockGit_Conflicts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Conflicts_Call) Return(_a0 []types.ConflictFile, _a1 error) *MockGit_Conflicts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Conflicts_Call) RunAndReturn(run func(...git.Option) ([]types.ConflictFile, error)) *MockGit_Conflicts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyBranch provides a mock function with given fields: source, destination, options
+func (_m *MockGit) CopyBranch(source string, destination string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, source, destination)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(source, destination, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_CopyBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyBranch'
+type MockGit_CopyBranch_Call struct {
+	*mock.Call
+}
+
+// CopyBranch is a helper method to define mock.On call
+//   - source string
+//   - destination string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) CopyBranch(source interface{}, destination interface{}, options ...interface{}) *MockGit_CopyBranch_Call {
+	return &MockGit_CopyBranch_Call{Call: _e.mock.On("CopyBranch",
+		append([]interface{}{source, destination}, options...)...)}
+}
+
+func (_c *MockGit_CopyBranch_Call) Run(run func(source string, destination string, options ...git.Option)) *MockGit_CopyBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_CopyBranch_Call) Return(_a0 error) *MockGit_CopyBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_CopyBranch_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_CopyBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBranch provides a mock function with given fields: branch, options
+func (_m *MockGit) CreateBranch(branch string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, branch)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(branch, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_CreateBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBranch'
+type MockGit_CreateBranch_Call struct {
+	*mock.Call
+}
+
+// CreateBranch is a helper method to define mock.On call
+//   - branch string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) CreateBranch(branch interface{}, options ...interface{}) *MockGit_CreateBranch_Call {
+	return &MockGit_CreateBranch_Call{Call: _e.mock.On("CreateBranch",
+		append([]interface{}{branch}, options...)...)}
+}
+
+func (_c *MockGit_CreateBranch_Call) Run(run func(branch string, options ...git.Option)) *MockGit_CreateBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_CreateBranch_Call) Return(_a0 error) *MockGit_CreateBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_CreateBranch_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_CreateBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBranch provides a mock function with given fields: branch, options
+func (_m *MockGit) DeleteBranch(branch string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, branch)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(branch, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
+type MockGit_DeleteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteBranch is a helper method to define mock.On call
+//   - branch string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) DeleteBranch(branch interface{}, options ...interface{}) *MockGit_DeleteBranch_Call {
+	return &MockGit_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch",
+		append([]interface{}{branch}, options...)...)}
+}
+
+func (_c *MockGit_DeleteBranch_Call) Run(run func(branch string, options ...git.Option)) *MockGit_DeleteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_DeleteBranch_Call) Return(_a0 error) *MockGit_DeleteBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_DeleteBranch_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_DeleteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRemoteBranch provides a mock function with given fields: remote, branch, options
+func (_m *MockGit) DeleteRemoteBranch(remote string, branch string, options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, remote, branch)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRemoteBranch")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) ([]types.Remote, error)); ok {
+		return rf(remote, branch, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) []types.Remote); ok {
+		r0 = rf(remote, branch, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...git.Option) error); ok {
+		r1 = rf(remote, branch, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_DeleteRemoteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRemoteBranch'
+type MockGit_DeleteRemoteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteRemoteBranch is a helper method to define mock.On call
+//   - remote string
+//   - branch string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) DeleteRemoteBranch(remote interface{}, branch interface{}, options ...interface{}) *MockGit_DeleteRemoteBranch_Call {
+	return &MockGit_DeleteRemoteBranch_Call{Call: _e.mock.On("DeleteRemoteBranch",
+		append([]interface{}{remote, branch}, options...)...)}
+}
+
+func (_c *MockGit_DeleteRemoteBranch_Call) Run(run func(remote string, branch string, options ...git.Option)) *MockGit_DeleteRemoteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_DeleteRemoteBranch_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_DeleteRemoteBranch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_DeleteRemoteBranch_Call) RunAndReturn(run func(string, string, ...git.Option) ([]types.Remote, error)) *MockGit_DeleteRemoteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRemoteTag provides a mock function with given fields: remote, tagName, options
+func (_m *MockGit) DeleteRemoteTag(remote string, tagName string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, remote, tagName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRemoteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(remote, tagName, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_DeleteRemoteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRemoteTag'
+type MockGit_DeleteRemoteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteRemoteTag is a helper method to define mock.On call
+//   - remote string
+//   - tagName string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) DeleteRemoteTag(remote interface{}, tagName interface{}, options ...interface{}) *MockGit_DeleteRemoteTag_Call {
+	return &MockGit_DeleteRemoteTag_Call{Call: _e.mock.On("DeleteRemoteTag",
+		append([]interface{}{remote, tagName}, options...)...)}
+}
+
+func (_c *MockGit_DeleteRemoteTag_Call) Run(run func(remote string, tagName string, options ...git.Option)) *MockGit_DeleteRemoteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_DeleteRemoteTag_Call) Return(_a0 error) *MockGit_DeleteRemoteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_DeleteRemoteTag_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_DeleteRemoteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: name, options
+func (_m *MockGit) DeleteTag(name string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(name, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockGit_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - name string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) DeleteTag(name interface{}, options ...interface{}) *MockGit_DeleteTag_Call {
+	return &MockGit_DeleteTag_Call{Call: _e.mock.On("DeleteTag",
+		append([]interface{}{name}, options...)...)}
+}
+
+func (_c *MockGit_DeleteTag_Call) Run(run func(name string, options ...git.Option)) *MockGit_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_DeleteTag_Call) Return(_a0 error) *MockGit_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_DeleteTag_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetailedStatus provides a mock function with given fields: options
+func (_m *MockGit) DetailedStatus(options ...git.Option) (*types.StatusResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetailedStatus")
+	}
+
+	var r0 *types.StatusResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.StatusResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.StatusResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StatusResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_DetailedStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetailedStatus'
+type MockGit_DetailedStatus_Call struct {
+	*mock.Call
+}
+
+// DetailedStatus is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) DetailedStatus(options ...interface{}) *MockGit_DetailedStatus_Call {
+	return &MockGit_DetailedStatus_Call{Call: _e.mock.On("DetailedStatus",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_DetailedStatus_Call) Run(run func(options ...git.Option)) *MockGit_DetailedStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_DetailedStatus_Call) Return(_a0 *types.StatusResult, _a1 error) *MockGit_DetailedStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_DetailedStatus_Call) RunAndReturn(run func(...git.Option) (*types.StatusResult, error)) *MockGit_DetailedStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Diff provides a mock function with given fields: options
+func (_m *MockGit) Diff(options ...git.Option) ([]types.Diff, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Diff")
+	}
+
+	var r0 []types.Diff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Diff, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Diff); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Diff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type MockGit_Diff_Call struct {
+	*mock.Call
+}
+
+// Diff is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Diff(options ...interface{}) *MockGit_Diff_Call {
+	return &MockGit_Diff_Call{Call: _e.mock.On("Diff",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Diff_Call) Run(run func(options ...git.Option)) *MockGit_Diff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Diff_Call) Return(_a0 []types.Diff, _a1 error) *MockGit_Diff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Diff_Call) RunAndReturn(run func(...git.Option) ([]types.Diff, error)) *MockGit_Diff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fetch provides a mock function with given fields: options
+func (_m *MockGit) Fetch(options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fetch")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Fetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fetch'
+type MockGit_Fetch_Call struct {
+	*mock.Call
+}
+
+// Fetch is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Fetch(options ...interface{}) *MockGit_Fetch_Call {
+	return &MockGit_Fetch_Call{Call: _e.mock.On("Fetch",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Fetch_Call) Run(run func(options ...git.Option)) *MockGit_Fetch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Fetch_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_Fetch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Fetch_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockGit_Fetch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: key, options
+func (_m *MockGit) GetConfig(key string, options ...git.Option) (string, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, key)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (string, error)); ok {
+		return rf(key, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) string); ok {
+		r0 = rf(key, options...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(key, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type MockGit_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - key string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) GetConfig(key interface{}, options ...interface{}) *MockGit_GetConfig_Call {
+	return &MockGit_GetConfig_Call{Call: _e.mock.On("GetConfig",
+		append([]interface{}{key}, options...)...)}
+}
+
+func (_c *MockGit_GetConfig_Call) Run(run func(key string, options ...git.Option)) *MockGit_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_GetConfig_Call) Return(_a0 string, _a1 error) *MockGit_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_GetConfig_Call) RunAndReturn(run func(string, ...git.Option) (string, error)) *MockGit_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Init provides a mock function with given fields: path, options
+func (_m *MockGit) Init(path string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, path)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Init")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(path, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
+type MockGit_Init_Call struct {
+	*mock.Call
+}
+
+// Init is a helper method to define mock.On call
+//   - path string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Init(path interface{}, options ...interface{}) *MockGit_Init_Call {
+	return &MockGit_Init_Call{Call: _e.mock.On("Init",
+		append([]interface{}{path}, options...)...)}
+}
+
+func (_c *MockGit_Init_Call) Run(run func(path string, options ...git.Option)) *MockGit_Init_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Init_Call) Return(_a0 error) *MockGit_Init_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_Init_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_Init_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBareRepository provides a mock function with no fields
+func (_m *MockGit) IsBareRepository() (bool, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBareRepository")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (bool, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_IsBareRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBareRepository'
+type MockGit_IsBareRepository_Call struct {
+	*mock.Call
+}
+
+// IsBareRepository is a helper method to define mock.On call
+func (_e *MockGit_Expecter) IsBareRepository() *MockGit_IsBareRepository_Call {
+	return &MockGit_IsBareRepository_Call{Call: _e.mock.On("IsBareRepository")}
+}
+
+func (_c *MockGit_IsBareRepository_Call) Run(run func()) *MockGit_IsBareRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_IsBareRepository_Call) Return(_a0 bool, _a1 error) *MockGit_IsBareRepository_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_IsBareRepository_Call) RunAndReturn(run func() (bool, error)) *MockGit_IsBareRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBranches provides a mock function with given fields: options
+func (_m *MockGit) ListBranches(options ...git.Option) ([]types.Branch, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBranches")
+	}
+
+	var r0 []types.Branch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Branch, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Branch); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Branch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_ListBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBranches'
+type MockGit_ListBranches_Call struct {
+	*mock.Call
+}
+
+// ListBranches is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) ListBranches(options ...interface{}) *MockGit_ListBranches_Call {
+	return &MockGit_ListBranches_Call{Call: _e.mock.On("ListBranches",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_ListBranches_Call) Run(run func(options ...git.Option)) *MockGit_ListBranches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_ListBranches_Call) Return(_a0 []types.Branch, _a1 error) *MockGit_ListBranches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_ListBranches_Call) RunAndReturn(run func(...git.Option) ([]types.Branch, error)) *MockGit_ListBranches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConfig provides a mock function with given fields: options
+func (_m *MockGit) ListConfig(options ...git.Option) ([]types.ConfigEntry, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConfig")
+	}
+
+	var r0 []types.ConfigEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.ConfigEntry, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.ConfigEntry); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ConfigEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_ListConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfig'
+type MockGit_ListConfig_Call struct {
+	*mock.Call
+}
+
+// ListConfig is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) ListConfig(options ...interface{}) *MockGit_ListConfig_Call {
+	return &MockGit_ListConfig_Call{Call: _e.mock.On("ListConfig",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_ListConfig_Call) Run(run func(options ...git.Option)) *MockGit_ListConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_ListConfig_Call) Return(_a0 []types.ConfigEntry, _a1 error) *MockGit_ListConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_ListConfig_Call) RunAndReturn(run func(...git.Option) ([]types.ConfigEntry, error)) *MockGit_ListConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRemotes provides a mock function with given fields: options
+func (_m *MockGit) ListRemotes(options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRemotes")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_ListRemotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRemotes'
+type MockGit_ListRemotes_Call struct {
+	*mock.Call
+}
+
+// ListRemotes is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) ListRemotes(options ...interface{}) *MockGit_ListRemotes_Call {
+	return &MockGit_ListRemotes_Call{Call: _e.mock.On("ListRemotes",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_ListRemotes_Call) Run(run func(options ...git.Option)) *MockGit_ListRemotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_ListRemotes_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_ListRemotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_ListRemotes_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockGit_ListRemotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields: options
+func (_m *MockGit) ListTags(options ...git.Option) ([]types.Tag, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []types.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Tag, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Tag); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type MockGit_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) ListTags(options ...interface{}) *MockGit_ListTags_Call {
+	return &MockGit_ListTags_Call{Call: _e.mock.On("ListTags",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_ListTags_Call) Run(run func(options ...git.Option)) *MockGit_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_ListTags_Call) Return(_a0 []types.Tag, _a1 error) *MockGit_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_ListTags_Call) RunAndReturn(run func(...git.Option) ([]types.Tag, error)) *MockGit_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Log provides a mock function with given fields: options
+func (_m *MockGit) Log(options ...git.Option) ([]types.Log, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Log")
+	}
+
+	var r0 []types.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Log, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Log); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
+type MockGit_Log_Call struct {
+	*mock.Call
+}
+
+// Log is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Log(options ...interface{}) *MockGit_Log_Call {
+	return &MockGit_Log_Call{Call: _e.mock.On("Log",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Log_Call) Run(run func(options ...git.Option)) *MockGit_Log_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Log_Call) Return(_a0 []types.Log, _a1 error) *MockGit_Log_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Log_Call) RunAndReturn(run func(...git.Option) ([]types.Log, error)) *MockGit_Log_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogStream provides a mock function with given fields: options
+func (_m *MockGit) LogStream(options ...git.Option) (*git.LogIterator, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogStream")
+	}
+
+	var r0 *git.LogIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*git.LogIterator, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *git.LogIterator); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*git.LogIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_LogStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogStream'
+type MockGit_LogStream_Call struct {
+	*mock.Call
+}
+
+// LogStream is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) LogStream(options ...interface{}) *MockGit_LogStream_Call {
+	return &MockGit_LogStream_Call{Call: _e.mock.On("LogStream",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_LogStream_Call) Run(run func(options ...git.Option)) *MockGit_LogStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_LogStream_Call) Return(_a0 *git.LogIterator, _a1 error) *MockGit_LogStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_LogStream_Call) RunAndReturn(run func(...git.Option) (*git.LogIterator, error)) *MockGit_LogStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function with given fields: options
+func (_m *MockGit) Merge(options ...git.Option) (*types.MergeResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 *types.MergeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.MergeResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.MergeResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MergeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type MockGit_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Merge(options ...interface{}) *MockGit_Merge_Call {
+	return &MockGit_Merge_Call{Call: _e.mock.On("Merge",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Merge_Call) Run(run func(options ...git.Option)) *MockGit_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Merge_Call) Return(_a0 *types.MergeResult, _a1 error) *MockGit_Merge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Merge_Call) RunAndReturn(run func(...git.Option) (*types.MergeResult, error)) *MockGit_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeAbort provides a mock function with no fields
+func (_m *MockGit) MergeAbort() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeAbort")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_MergeAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeAbort'
+type MockGit_MergeAbort_Call struct {
+	*mock.Call
+}
+
+// MergeAbort is a helper method to define mock.On call
+func (_e *MockGit_Expecter) MergeAbort() *MockGit_MergeAbort_Call {
+	return &MockGit_MergeAbort_Call{Call: _e.mock.On("MergeAbort")}
+}
+
+func (_c *MockGit_MergeAbort_Call) Run(run func()) *MockGit_MergeAbort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_MergeAbort_Call) Return(_a0 error) *MockGit_MergeAbort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_MergeAbort_Call) RunAndReturn(run func() error) *MockGit_MergeAbort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeContinue provides a mock function with no fields
+func (_m *MockGit) MergeContinue() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeContinue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_MergeContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeContinue'
+type MockGit_MergeContinue_Call struct {
+	*mock.Call
+}
+
+// MergeContinue is a helper method to define mock.On call
+func (_e *MockGit_Expecter) MergeContinue() *MockGit_MergeContinue_Call {
+	return &MockGit_MergeContinue_Call{Call: _e.mock.On("MergeContinue")}
+}
+
+func (_c *MockGit_MergeContinue_Call) Run(run func()) *MockGit_MergeContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_MergeContinue_Call) Return(_a0 error) *MockGit_MergeContinue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_MergeContinue_Call) RunAndReturn(run func() error) *MockGit_MergeContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pull provides a mock function with given fields: options
+func (_m *MockGit) Pull(options ...git.Option) (*types.MergeResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pull")
+	}
+
+	var r0 *types.MergeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.MergeResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.MergeResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MergeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Pull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pull'
+type MockGit_Pull_Call struct {
+	*mock.Call
+}
+
+// Pull is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Pull(options ...interface{}) *MockGit_Pull_Call {
+	return &MockGit_Pull_Call{Call: _e.mock.On("Pull",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Pull_Call) Run(run func(options ...git.Option)) *MockGit_Pull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Pull_Call) Return(_a0 *types.MergeResult, _a1 error) *MockGit_Pull_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Pull_Call) RunAndReturn(run func(...git.Option) (*types.MergeResult, error)) *MockGit_Pull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Push provides a mock function with given fields: options
+func (_m *MockGit) Push(options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Push")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
+type MockGit_Push_Call struct {
+	*mock.Call
+}
+
+// Push is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Push(options ...interface{}) *MockGit_Push_Call {
+	return &MockGit_Push_Call{Call: _e.mock.On("Push",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Push_Call) Run(run func(options ...git.Option)) *MockGit_Push_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Push_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_Push_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Push_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockGit_Push_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PushTags provides a mock function with given fields: remote, options
+func (_m *MockGit) PushTags(remote string, options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, remote)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PushTags")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.Remote, error)); ok {
+		return rf(remote, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.Remote); ok {
+		r0 = rf(remote, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(remote, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_PushTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushTags'
+type MockGit_PushTags_Call struct {
+	*mock.Call
+}
+
+// PushTags is a helper method to define mock.On call
+//   - remote string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) PushTags(remote interface{}, options ...interface{}) *MockGit_PushTags_Call {
+	return &MockGit_PushTags_Call{Call: _e.mock.On("PushTags",
+		append([]interface{}{remote}, options...)...)}
+}
+
+func (_c *MockGit_PushTags_Call) Run(run func(remote string, options ...git.Option)) *MockGit_PushTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_PushTags_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_PushTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_PushTags_Call) RunAndReturn(run func(string, ...git.Option) ([]types.Remote, error)) *MockGit_PushTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rebase provides a mock function with given fields: options
+func (_m *MockGit) Rebase(options ...git.Option) (*types.RebaseResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rebase")
+	}
+
+	var r0 *types.RebaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.RebaseResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.RebaseResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RebaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Rebase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebase'
+type MockGit_Rebase_Call struct {
+	*mock.Call
+}
+
+// Rebase is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Rebase(options ...interface{}) *MockGit_Rebase_Call {
+	return &MockGit_Rebase_Call{Call: _e.mock.On("Rebase",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Rebase_Call) Run(run func(options ...git.Option)) *MockGit_Rebase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Rebase_Call) Return(_a0 *types.RebaseResult, _a1 error) *MockGit_Rebase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Rebase_Call) RunAndReturn(run func(...git.Option) (*types.RebaseResult, error)) *MockGit_Rebase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseAbort provides a mock function with no fields
+func (_m *MockGit) RebaseAbort() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseAbort")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_RebaseAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseAbort'
+type MockGit_RebaseAbort_Call struct {
+	*mock.Call
+}
+
+// RebaseAbort is a helper method to define mock.On call
+func (_e *MockGit_Expecter) RebaseAbort() *MockGit_RebaseAbort_Call {
+	return &MockGit_RebaseAbort_Call{Call: _e.mock.On("RebaseAbort")}
+}
+
+func (_c *MockGit_RebaseAbort_Call) Run(run func()) *MockGit_RebaseAbort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_RebaseAbort_Call) Return(_a0 error) *MockGit_RebaseAbort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_RebaseAbort_Call) RunAndReturn(run func() error) *MockGit_RebaseAbort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseContinue provides a mock function with no fields
+func (_m *MockGit) RebaseContinue() (*types.RebaseResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseContinue")
+	}
+
+	var r0 *types.RebaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.RebaseResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.RebaseResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RebaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_RebaseContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseContinue'
+type MockGit_RebaseContinue_Call struct {
+	*mock.Call
+}
+
+// RebaseContinue is a helper method to define mock.On call
+func (_e *MockGit_Expecter) RebaseContinue() *MockGit_RebaseContinue_Call {
+	return &MockGit_RebaseContinue_Call{Call: _e.mock.On("RebaseContinue")}
+}
+
+func (_c *MockGit_RebaseContinue_Call) Run(run func()) *MockGit_RebaseContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_RebaseContinue_Call) Return(_a0 *types.RebaseResult, _a1 error) *MockGit_RebaseContinue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_RebaseContinue_Call) RunAndReturn(run func() (*types.RebaseResult, error)) *MockGit_RebaseContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseEditTodo provides a mock function with given fields: todo
+func (_m *MockGit) RebaseEditTodo(todo []types.RebaseTodo) error {
+	ret := _m.Called(todo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseEditTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]types.RebaseTodo) error); ok {
+		r0 = rf(todo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_RebaseEditTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseEditTodo'
+type MockGit_RebaseEditTodo_Call struct {
+	*mock.Call
+}
+
+// RebaseEditTodo is a helper method to define mock.On call
+//   - todo []types.RebaseTodo
+func (_e *MockGit_Expecter) RebaseEditTodo(todo interface{}) *MockGit_RebaseEditTodo_Call {
+	return &MockGit_RebaseEditTodo_Call{Call: _e.mock.On("RebaseEditTodo", todo)}
+}
+
+func (_c *MockGit_RebaseEditTodo_Call) Run(run func(todo []types.RebaseTodo)) *MockGit_RebaseEditTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]types.RebaseTodo))
+	})
+	return _c
+}
+
+func (_c *MockGit_RebaseEditTodo_Call) Return(_a0 error) *MockGit_RebaseEditTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_RebaseEditTodo_Call) RunAndReturn(run func([]types.RebaseTodo) error) *MockGit_RebaseEditTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseInteractive provides a mock function with given fields: upstream, todo, options
+func (_m *MockGit) RebaseInteractive(upstream string, todo []types.RebaseTodo, options ...git.Option) (*types.RebaseResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, upstream, todo)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseInteractive")
+	}
+
+	var r0 *types.RebaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []types.RebaseTodo, ...git.Option) (*types.RebaseResult, error)); ok {
+		return rf(upstream, todo, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, []types.RebaseTodo, ...git.Option) *types.RebaseResult); ok {
+		r0 = rf(upstream, todo, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RebaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []types.RebaseTodo, ...git.Option) error); ok {
+		r1 = rf(upstream, todo, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_RebaseInteractive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseInteractive'
+type MockGit_RebaseInteractive_Call struct {
+	*mock.Call
+}
+
+// RebaseInteractive is a helper method to define mock.On call
+//   - upstream string
+//   - todo []types.RebaseTodo
+//   - options ...git.Option
+func (_e *MockGit_Expecter) RebaseInteractive(upstream interface{}, todo interface{}, options ...interface{}) *MockGit_RebaseInteractive_Call {
+	return &MockGit_RebaseInteractive_Call{Call: _e.mock.On("RebaseInteractive",
+		append([]interface{}{upstream, todo}, options...)...)}
+}
+
+func (_c *MockGit_RebaseInteractive_Call) Run(run func(upstream string, todo []types.RebaseTodo, options ...git.Option)) *MockGit_RebaseInteractive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].([]types.RebaseTodo), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_RebaseInteractive_Call) Return(_a0 *types.RebaseResult, _a1 error) *MockGit_RebaseInteractive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_RebaseInteractive_Call) RunAndReturn(run func(string, []types.RebaseTodo, ...git.Option) (*types.RebaseResult, error)) *MockGit_RebaseInteractive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseSkip provides a mock function with no fields
+func (_m *MockGit) RebaseSkip() (*types.RebaseResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseSkip")
+	}
+
+	var r0 *types.RebaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.RebaseResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.RebaseResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RebaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_RebaseSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseSkip'
+type MockGit_RebaseSkip_Call struct {
+	*mock.Call
+}
+
+// RebaseSkip is a helper method to define mock.On call
+func (_e *MockGit_Expecter) RebaseSkip() *MockGit_RebaseSkip_Call {
+	return &MockGit_RebaseSkip_Call{Call: _e.mock.On("RebaseSkip")}
+}
+
+func (_c *MockGit_RebaseSkip_Call) Run(run func()) *MockGit_RebaseSkip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_RebaseSkip_Call) Return(_a0 *types.RebaseResult, _a1 error) *MockGit_RebaseSkip_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_RebaseSkip_Call) RunAndReturn(run func() (*types.RebaseResult, error)) *MockGit_RebaseSkip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reflog provides a mock function with given fields: ref, options
+func (_m *MockGit) Reflog(ref string, options ...git.Option) ([]types.ReflogEntry, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ref)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reflog")
+	}
+
+	var r0 []types.ReflogEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.ReflogEntry, error)); ok {
+		return rf(ref, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.ReflogEntry); ok {
+		r0 = rf(ref, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ReflogEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(ref, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Reflog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reflog'
+type MockGit_Reflog_Call struct {
+	*mock.Call
+}
+
+// Reflog is a helper method to define mock.On call
+//   - ref string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Reflog(ref interface{}, options ...interface{}) *MockGit_Reflog_Call {
+	return &MockGit_Reflog_Call{Call: _e.mock.On("Reflog",
+		append([]interface{}{ref}, options...)...)}
+}
+
+func (_c *MockGit_Reflog_Call) Run(run func(ref string, options ...git.Option)) *MockGit_Reflog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Reflog_Call) Return(_a0 []types.ReflogEntry, _a1 error) *MockGit_Reflog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Reflog_Call) RunAndReturn(run func(string, ...git.Option) ([]types.ReflogEntry, error)) *MockGit_Reflog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReflogDelete provides a mock function with given fields: selector, options
+func (_m *MockGit) ReflogDelete(selector string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, selector)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReflogDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(selector, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_ReflogDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReflogDelete'
+type MockGit_ReflogDelete_Call struct {
+	*mock.Call
+}
+
+// ReflogDelete is a helper method to define mock.On call
+//   - selector string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) ReflogDelete(selector interface{}, options ...interface{}) *MockGit_ReflogDelete_Call {
+	return &MockGit_ReflogDelete_Call{Call: _e.mock.On("ReflogDelete",
+		append([]interface{}{selector}, options...)...)}
+}
+
+func (_c *MockGit_ReflogDelete_Call) Run(run func(selector string, options ...git.Option)) *MockGit_ReflogDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_ReflogDelete_Call) Return(_a0 error) *MockGit_ReflogDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_ReflogDelete_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_ReflogDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReflogExpire provides a mock function with given fields: options
+func (_m *MockGit) ReflogExpire(options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReflogExpire")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
+		r0 = rf(options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_ReflogExpire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReflogExpire'
+type MockGit_ReflogExpire_Call struct {
+	*mock.Call
+}
+
+// ReflogExpire is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) ReflogExpire(options ...interface{}) *MockGit_ReflogExpire_Call {
+	return &MockGit_ReflogExpire_Call{Call: _e.mock.On("ReflogExpire",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_ReflogExpire_Call) Run(run func(options ...git.Option)) *MockGit_ReflogExpire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_ReflogExpire_Call) Return(_a0 error) *MockGit_ReflogExpire_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_ReflogExpire_Call) RunAndReturn(run func(...git.Option) error) *MockGit_ReflogExpire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: options
+func (_m *MockGit) Remove(options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
+		r0 = rf(options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockGit_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Remove(options ...interface{}) *MockGit_Remove_Call {
+	return &MockGit_Remove_Call{Call: _e.mock.On("Remove",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockGit_Remove_Call) Run(run func(options ...git.Option)) *MockGit_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Remove_Call) Return(_a0 error) *MockGit_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_Remove_Call) RunAndReturn(run func(...git.Option) error) *MockGit_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRemote provides a mock function with given fields: name, options
+func (_m *MockGit) RemoveRemote(name string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRemote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(name, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_RemoveRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRemote'
+type MockGit_RemoveRemote_Call struct {
+	*mock.Call
+}
+
+// RemoveRemote is a helper method to define mock.On call
+//   - name string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) RemoveRemote(name interface{}, options ...interface{}) *MockGit_RemoveRemote_Call {
+	return &MockGit_RemoveRemote_Call{Call: _e.mock.On("RemoveRemote",
+		append([]interface{}{name}, options...)...)}
+}
+
+func (_c *MockGit_RemoveRemote_Call) Run(run func(name string, options ...git.Option)) *MockGit_RemoveRemote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_RemoveRemote_Call) Return(_a0 error) *MockGit_RemoveRemote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_RemoveRemote_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_RemoveRemote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameBranch provides a mock function with given fields: oldName, newName, options
+func (_m *MockGit) RenameBranch(oldName string, newName string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, oldName, newName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(oldName, newName, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_RenameBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameBranch'
+type MockGit_RenameBranch_Call struct {
+	*mock.Call
+}
+
+// RenameBranch is a helper method to define mock.On call
+//   - oldName string
+//   - newName string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) RenameBranch(oldName interface{}, newName interface{}, options ...interface{}) *MockGit_RenameBranch_Call {
+	return &MockGit_RenameBranch_Call{Call: _e.mock.On("RenameBranch",
+		append([]interface{}{oldName, newName}, options...)...)}
+}
+
+func (_c *MockGit_RenameBranch_Call) Run(run func(oldName string, newName string, options ...git.Option)) *MockGit_RenameBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_RenameBranch_Call) Return(_a0 error) *MockGit_RenameBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_RenameBranch_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_RenameBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: files, options
+func (_m *MockGit) Reset(files []string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, files)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) error); ok {
+		r0 = rf(files, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type MockGit_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - files []string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Reset(files interface{}, options ...interface{}) *MockGit_Reset_Call {
+	return &MockGit_Reset_Call{Call: _e.mock.On("Reset",
+		append([]interface{}{files}, options...)...)}
+}
+
+func (_c *MockGit_Reset_Call) Run(run func(files []string, options ...git.Option)) *MockGit_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Reset_Call) Return(_a0 error) *MockGit_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_Reset_Call) RunAndReturn(run func([]string, ...git.Option) error) *MockGit_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveConflicts provides a mock function with given fields: resolutions
+func (_m *MockGit) ResolveConflicts(resolutions []types.ConflictResolution) ([]types.ConflictResolutionResult, error) {
+	ret := _m.Called(resolutions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveConflicts")
+	}
+
+	var r0 []types.ConflictResolutionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]types.ConflictResolution) ([]types.ConflictResolutionResult, error)); ok {
+		return rf(resolutions)
+	}
+	if rf, ok := ret.Get(0).(func([]types.ConflictResolution) []types.ConflictResolutionResult); ok {
+		r0 = rf(resolutions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ConflictResolutionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]types.ConflictResolution) error); ok {
+		r1 = rf(resolutions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_ResolveConflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveConflicts'
+type MockGit_ResolveConflicts_Call struct {
+	*mock.Call
+}
+
+// ResolveConflicts is a helper method to define mock.On call
+//   - resolutions []types.ConflictResolution
+func (_e *MockGit_Expecter) ResolveConflicts(resolutions interface{}) *MockGit_ResolveConflicts_Call {
+	return &MockGit_ResolveConflicts_Call{Call: _e.mock.On("ResolveConflicts", resolutions)}
+}
+
+func (_c *MockGit_ResolveConflicts_Call) Run(run func(resolutions []types.ConflictResolution)) *MockGit_ResolveConflicts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]types.ConflictResolution))
+	})
+	return _c
+}
+
+func (_c *MockGit_ResolveConflicts_Call) Return(_a0 []types.ConflictResolutionResult, _a1 error) *MockGit_ResolveConflicts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_ResolveConflicts_Call) RunAndReturn(run func([]types.ConflictResolution) ([]types.ConflictResolutionResult, error)) *MockGit_ResolveConflicts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revert provides a mock function with given fields: commits, options
+func (_m *MockGit) Revert(commits []string, options ...git.Option) (*types.SequencerResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, commits)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revert")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) (*types.SequencerResult, error)); ok {
+		return rf(commits, options...)
+	}
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) *types.SequencerResult); ok {
+		r0 = rf(commits, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, ...git.Option) error); ok {
+		r1 = rf(commits, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type MockGit_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - commits []string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) Revert(commits interface{}, options ...interface{}) *MockGit_Revert_Call {
+	return &MockGit_Revert_Call{Call: _e.mock.On("Revert",
+		append([]interface{}{commits}, options...)...)}
+}
+
+func (_c *MockGit_Revert_Call) Run(run func(commits []string, options ...git.Option)) *MockGit_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_Revert_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockGit_Revert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_Revert_Call) RunAndReturn(run func([]string, ...git.Option) (*types.SequencerResult, error)) *MockGit_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertAbort provides a mock function with no fields
+func (_m *MockGit) RevertAbort() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertAbort")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_RevertAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertAbort'
+type MockGit_RevertAbort_Call struct {
+	*mock.Call
+}
+
+// RevertAbort is a helper method to define mock.On call
+func (_e *MockGit_Expecter) RevertAbort() *MockGit_RevertAbort_Call {
+	return &MockGit_RevertAbort_Call{Call: _e.mock.On("RevertAbort")}
+}
+
+func (_c *MockGit_RevertAbort_Call) Run(run func()) *MockGit_RevertAbort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_RevertAbort_Call) Return(_a0 error) *MockGit_RevertAbort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_RevertAbort_Call) RunAndReturn(run func() error) *MockGit_RevertAbort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertContinue provides a mock function with no fields
+func (_m *MockGit) RevertContinue() (*types.SequencerResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertContinue")
 	}
 
-	var r0 *types.CheckoutResult
+	var r0 *types.SequencerResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) (*types.CheckoutResult, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func() (*types.SequencerResult, error)); ok {
+		return rf()
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) *types.CheckoutResult); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func() *types.SequencerResult); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.CheckoutResult)
+			r0 = ret.Get(0).(*types.SequencerResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -181,59 +4376,153 @@ func (_m *MockGit) Checkout(options ...git.Option) (*types.CheckoutResult, error
 	return r0, r1
 }
 
-// MockGit_Checkout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Checkout'
-type MockGit_Checkout_Call struct {
+// MockGit_RevertContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertContinue'
+type MockGit_RevertContinue_Call struct {
 	*mock.Call
 }
 
-// Checkout is a helper method to define mock.On call
-//   - options ...git.Option
-func (_e *MockGit_Expecter) Checkout(options ...interface{}) *MockGit_Checkout_Call {
-	return &MockGit_Checkout_Call{Call: _e.mock.On("Checkout",
-		append([]interface{}{}, options...)...)}
+// RevertContinue is a helper method to define mock.On call
+func (_e *MockGit_Expecter) RevertContinue() *MockGit_RevertContinue_Call {
+	return &MockGit_RevertContinue_Call{Call: _e.mock.On("RevertContinue")}
 }
 
-func (_c *MockGit_Checkout_Call) Run(run func(options ...git.Option)) *MockGit_Checkout_Call {
+func (_c *MockGit_RevertContinue_Call) Run(run func()) *MockGit_RevertContinue_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
-			if a != nil {
-				variadicArgs[i] = a.(git.Option)
-			}
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_RevertContinue_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockGit_RevertContinue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockGit_RevertContinue_Call) RunAndReturn(run func() (*types.SequencerResult, error)) *MockGit_RevertContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertQuit provides a mock function with no fields
+func (_m *MockGit) RevertQuit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertQuit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockGit_RevertQuit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertQuit'
+type MockGit_RevertQuit_Call struct {
+	*mock.Call
+}
+
+// RevertQuit is a helper method to define mock.On call
+func (_e *MockGit_Expecter) RevertQuit() *MockGit_RevertQuit_Call {
+	return &MockGit_RevertQuit_Call{Call: _e.mock.On("RevertQuit")}
+}
+
+func (_c *MockGit_RevertQuit_Call) Run(run func()) *MockGit_RevertQuit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockGit_RevertQuit_Call) Return(_a0 error) *MockGit_RevertQuit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_RevertQuit_Call) RunAndReturn(run func() error) *MockGit_RevertQuit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertSkip provides a mock function with no fields
+func (_m *MockGit) RevertSkip() (*types.SequencerResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertSkip")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.SequencerResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.SequencerResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
 		}
-		run(variadicArgs...)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockGit_RevertSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertSkip'
+type MockGit_RevertSkip_Call struct {
+	*mock.Call
+}
+
+// RevertSkip is a helper method to define mock.On call
+func (_e *MockGit_Expecter) RevertSkip() *MockGit_RevertSkip_Call {
+	return &MockGit_RevertSkip_Call{Call: _e.mock.On("RevertSkip")}
+}
+
+func (_c *MockGit_RevertSkip_Call) Run(run func()) *MockGit_RevertSkip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
 	})
 	return _c
 }
 
-func (_c *MockGit_Checkout_Call) Return(_a0 *types.CheckoutResult, _a1 error) *MockGit_Checkout_Call {
+func (_c *MockGit_RevertSkip_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockGit_RevertSkip_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_Checkout_Call) RunAndReturn(run func(...git.Option) (*types.CheckoutResult, error)) *MockGit_Checkout_Call {
+func (_c *MockGit_RevertSkip_Call) RunAndReturn(run func() (*types.SequencerResult, error)) *MockGit_RevertSkip_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Clone provides a mock function with given fields: url, destination, options
-func (_m *MockGit) Clone(url string, destination string, options ...git.Option) error {
+// SetConfig provides a mock function with given fields: key, value, options
+func (_m *MockGit) SetConfig(key string, value string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, url, destination)
+	_ca = append(_ca, key, value)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Clone")
+		panic("no return value specified for SetConfig")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(url, destination, options...)
+		r0 = rf(key, value, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -241,21 +4530,21 @@ func (_m *MockGit) Clone(url string, destination string, options ...git.Option)
 	return r0
 }
 
-// MockGit_Clone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clone'
-type MockGit_Clone_Call struct {
+// MockGit_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
+type MockGit_SetConfig_Call struct {
 	*mock.Call
 }
 
-// Clone is a helper method to define mock.On call
-//   - url string
-//   - destination string
+// SetConfig is a helper method to define mock.On call
+//   - key string
+//   - value string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Clone(url interface{}, destination interface{}, options ...interface{}) *MockGit_Clone_Call {
-	return &MockGit_Clone_Call{Call: _e.mock.On("Clone",
-		append([]interface{}{url, destination}, options...)...)}
+func (_e *MockGit_Expecter) SetConfig(key interface{}, value interface{}, options ...interface{}) *MockGit_SetConfig_Call {
+	return &MockGit_SetConfig_Call{Call: _e.mock.On("SetConfig",
+		append([]interface{}{key, value}, options...)...)}
 }
 
-func (_c *MockGit_Clone_Call) Run(run func(url string, destination string, options ...git.Option)) *MockGit_Clone_Call {
+func (_c *MockGit_SetConfig_Call) Run(run func(key string, value string, options ...git.Option)) *MockGit_SetConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-2)
 		for i, a := range args[2:] {
@@ -268,34 +4557,34 @@ func (_c *MockGit_Clone_Call) Run(run func(url string, destination string, optio
 	return _c
 }
 
-func (_c *MockGit_Clone_Call) Return(_a0 error) *MockGit_Clone_Call {
+func (_c *MockGit_SetConfig_Call) Return(_a0 error) *MockGit_SetConfig_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Clone_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_Clone_Call {
+func (_c *MockGit_SetConfig_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_SetConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Commit provides a mock function with given fields: message, options
-func (_m *MockGit) Commit(message string, options ...git.Option) error {
+// SetRemoteURL provides a mock function with given fields: name, url, options
+func (_m *MockGit) SetRemoteURL(name string, url string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, message)
+	_ca = append(_ca, name, url)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Commit")
+		panic("no return value specified for SetRemoteURL")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(message, options...)
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(name, url, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -303,60 +4592,61 @@ func (_m *MockGit) Commit(message string, options ...git.Option) error {
 	return r0
 }
 
-// MockGit_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
-type MockGit_Commit_Call struct {
+// MockGit_SetRemoteURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRemoteURL'
+type MockGit_SetRemoteURL_Call struct {
 	*mock.Call
 }
 
-// Commit is a helper method to define mock.On call
-//   - message string
+// SetRemoteURL is a helper method to define mock.On call
+//   - name string
+//   - url string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Commit(message interface{}, options ...interface{}) *MockGit_Commit_Call {
-	return &MockGit_Commit_Call{Call: _e.mock.On("Commit",
-		append([]interface{}{message}, options...)...)}
+func (_e *MockGit_Expecter) SetRemoteURL(name interface{}, url interface{}, options ...interface{}) *MockGit_SetRemoteURL_Call {
+	return &MockGit_SetRemoteURL_Call{Call: _e.mock.On("SetRemoteURL",
+		append([]interface{}{name, url}, options...)...)}
 }
 
-func (_c *MockGit_Commit_Call) Run(run func(message string, options ...git.Option)) *MockGit_Commit_Call {
+func (_c *MockGit_SetRemoteURL_Call) Run(run func(name string, url string, options ...git.Option)) *MockGit_SetRemoteURL_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_Commit_Call) Return(_a0 error) *MockGit_Commit_Call {
+func (_c *MockGit_SetRemoteURL_Call) Return(_a0 error) *MockGit_SetRemoteURL_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Commit_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_Commit_Call {
+func (_c *MockGit_SetRemoteURL_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_SetRemoteURL_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateBranch provides a mock function with given fields: branch, options
-func (_m *MockGit) CreateBranch(branch string, options ...git.Option) error {
+// SetUpstream provides a mock function with given fields: branch, remote, options
+func (_m *MockGit) SetUpstream(branch string, remote string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, branch)
+	_ca = append(_ca, branch, remote)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBranch")
+		panic("no return value specified for SetUpstream")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(branch, options...)
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(branch, remote, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -364,60 +4654,61 @@ func (_m *MockGit) CreateBranch(branch string, options ...git.Option) error {
 	return r0
 }
 
-// MockGit_CreateBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBranch'
-type MockGit_CreateBranch_Call struct {
+// MockGit_SetUpstream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpstream'
+type MockGit_SetUpstream_Call struct {
 	*mock.Call
 }
 
-// CreateBranch is a helper method to define mock.On call
+// SetUpstream is a helper method to define mock.On call
 //   - branch string
+//   - remote string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) CreateBranch(branch interface{}, options ...interface{}) *MockGit_CreateBranch_Call {
-	return &MockGit_CreateBranch_Call{Call: _e.mock.On("CreateBranch",
-		append([]interface{}{branch}, options...)...)}
+func (_e *MockGit_Expecter) SetUpstream(branch interface{}, remote interface{}, options ...interface{}) *MockGit_SetUpstream_Call {
+	return &MockGit_SetUpstream_Call{Call: _e.mock.On("SetUpstream",
+		append([]interface{}{branch, remote}, options...)...)}
 }
 
-func (_c *MockGit_CreateBranch_Call) Run(run func(branch string, options ...git.Option)) *MockGit_CreateBranch_Call {
+func (_c *MockGit_SetUpstream_Call) Run(run func(branch string, remote string, options ...git.Option)) *MockGit_SetUpstream_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_CreateBranch_Call) Return(_a0 error) *MockGit_CreateBranch_Call {
+func (_c *MockGit_SetUpstream_Call) Return(_a0 error) *MockGit_SetUpstream_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_CreateBranch_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_CreateBranch_Call {
+func (_c *MockGit_SetUpstream_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_SetUpstream_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteBranch provides a mock function with given fields: branch, options
-func (_m *MockGit) DeleteBranch(branch string, options ...git.Option) error {
+// SetUpstreamTo provides a mock function with given fields: branch, upstream, options
+func (_m *MockGit) SetUpstreamTo(branch string, upstream string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, branch)
+	_ca = append(_ca, branch, upstream)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteBranch")
+		panic("no return value specified for SetUpstreamTo")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(branch, options...)
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(branch, upstream, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -425,143 +4716,200 @@ func (_m *MockGit) DeleteBranch(branch string, options ...git.Option) error {
 	return r0
 }
 
-// MockGit_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
-type MockGit_DeleteBranch_Call struct {
+// MockGit_SetUpstreamTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpstreamTo'
+type MockGit_SetUpstreamTo_Call struct {
 	*mock.Call
 }
 
-// DeleteBranch is a helper method to define mock.On call
+// SetUpstreamTo is a helper method to define mock.On call
 //   - branch string
+//   - upstream string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) DeleteBranch(branch interface{}, options ...interface{}) *MockGit_DeleteBranch_Call {
-	return &MockGit_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch",
-		append([]interface{}{branch}, options...)...)}
+func (_e *MockGit_Expecter) SetUpstreamTo(branch interface{}, upstream interface{}, options ...interface{}) *MockGit_SetUpstreamTo_Call {
+	return &MockGit_SetUpstreamTo_Call{Call: _e.mock.On("SetUpstreamTo",
+		append([]interface{}{branch, upstream}, options...)...)}
+}
+
+func (_c *MockGit_SetUpstreamTo_Call) Run(run func(branch string, upstream string, options ...git.Option)) *MockGit_SetUpstreamTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockGit_SetUpstreamTo_Call) Return(_a0 error) *MockGit_SetUpstreamTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockGit_SetUpstreamTo_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_SetUpstreamTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetWorkingDirectory provides a mock function with given fields: wd
+func (_m *MockGit) SetWorkingDirectory(wd string) {
+	_m.Called(wd)
+}
+
+// MockGit_SetWorkingDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkingDirectory'
+type MockGit_SetWorkingDirectory_Call struct {
+	*mock.Call
+}
+
+// SetWorkingDirectory is a helper method to define mock.On call
+//   - wd string
+func (_e *MockGit_Expecter) SetWorkingDirectory(wd interface{}) *MockGit_SetWorkingDirectory_Call {
+	return &MockGit_SetWorkingDirectory_Call{Call: _e.mock.On("SetWorkingDirectory", wd)}
 }
 
-func (_c *MockGit_DeleteBranch_Call) Run(run func(branch string, options ...git.Option)) *MockGit_DeleteBranch_Call {
+func (_c *MockGit_SetWorkingDirectory_Call) Run(run func(wd string)) *MockGit_SetWorkingDirectory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
-			if a != nil {
-				variadicArgs[i] = a.(git.Option)
-			}
-		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockGit_DeleteBranch_Call) Return(_a0 error) *MockGit_DeleteBranch_Call {
-	_c.Call.Return(_a0)
+func (_c *MockGit_SetWorkingDirectory_Call) Return() *MockGit_SetWorkingDirectory_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockGit_DeleteBranch_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_DeleteBranch_Call {
-	_c.Call.Return(run)
+func (_c *MockGit_SetWorkingDirectory_Call) RunAndReturn(run func(string)) *MockGit_SetWorkingDirectory_Call {
+	_c.Run(run)
 	return _c
 }
 
-// DeleteRemoteTag provides a mock function with given fields: remote, tagName, options
-func (_m *MockGit) DeleteRemoteTag(remote string, tagName string, options ...git.Option) error {
+// Show provides a mock function with given fields: object, options
+func (_m *MockGit) Show(object string, options ...git.Option) (*types.Log, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, remote, tagName)
+	_ca = append(_ca, object)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRemoteTag")
+		panic("no return value specified for Show")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(remote, tagName, options...)
+	var r0 *types.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.Log, error)); ok {
+		return rf(object, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.Log); ok {
+		r0 = rf(object, options...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Log)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(object, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockGit_DeleteRemoteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRemoteTag'
-type MockGit_DeleteRemoteTag_Call struct {
+// MockGit_Show_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Show'
+type MockGit_Show_Call struct {
 	*mock.Call
 }
 
-// DeleteRemoteTag is a helper method to define mock.On call
-//   - remote string
-//   - tagName string
+// Show is a helper method to define mock.On call
+//   - object string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) DeleteRemoteTag(remote interface{}, tagName interface{}, options ...interface{}) *MockGit_DeleteRemoteTag_Call {
-	return &MockGit_DeleteRemoteTag_Call{Call: _e.mock.On("DeleteRemoteTag",
-		append([]interface{}{remote, tagName}, options...)...)}
+func (_e *MockGit_Expecter) Show(object interface{}, options ...interface{}) *MockGit_Show_Call {
+	return &MockGit_Show_Call{Call: _e.mock.On("Show",
+		append([]interface{}{object}, options...)...)}
 }
 
-func (_c *MockGit_DeleteRemoteTag_Call) Run(run func(remote string, tagName string, options ...git.Option)) *MockGit_DeleteRemoteTag_Call {
+func (_c *MockGit_Show_Call) Run(run func(object string, options ...git.Option)) *MockGit_Show_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-2)
-		for i, a := range args[2:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), args[1].(string), variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_DeleteRemoteTag_Call) Return(_a0 error) *MockGit_DeleteRemoteTag_Call {
-	_c.Call.Return(_a0)
+func (_c *MockGit_Show_Call) Return(_a0 *types.Log, _a1 error) *MockGit_Show_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_DeleteRemoteTag_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_DeleteRemoteTag_Call {
+func (_c *MockGit_Show_Call) RunAndReturn(run func(string, ...git.Option) (*types.Log, error)) *MockGit_Show_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTag provides a mock function with given fields: name, options
-func (_m *MockGit) DeleteTag(name string, options ...git.Option) error {
+// StashApply provides a mock function with given fields: stash, options
+func (_m *MockGit) StashApply(stash string, options ...git.Option) (*types.MergeResult, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, name)
+	_ca = append(_ca, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTag")
+		panic("no return value specified for StashApply")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(name, options...)
+	var r0 *types.MergeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.MergeResult, error)); ok {
+		return rf(stash, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.MergeResult); ok {
+		r0 = rf(stash, options...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MergeResult)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(stash, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockGit_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
-type MockGit_DeleteTag_Call struct {
+// MockGit_StashApply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashApply'
+type MockGit_StashApply_Call struct {
 	*mock.Call
 }
 
-// DeleteTag is a helper method to define mock.On call
-//   - name string
+// StashApply is a helper method to define mock.On call
+//   - stash string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) DeleteTag(name interface{}, options ...interface{}) *MockGit_DeleteTag_Call {
-	return &MockGit_DeleteTag_Call{Call: _e.mock.On("DeleteTag",
-		append([]interface{}{name}, options...)...)}
+func (_e *MockGit_Expecter) StashApply(stash interface{}, options ...interface{}) *MockGit_StashApply_Call {
+	return &MockGit_StashApply_Call{Call: _e.mock.On("StashApply",
+		append([]interface{}{stash}, options...)...)}
 }
 
-func (_c *MockGit_DeleteTag_Call) Run(run func(name string, options ...git.Option)) *MockGit_DeleteTag_Call {
+func (_c *MockGit_StashApply_Call) Run(run func(stash string, options ...git.Option)) *MockGit_StashApply_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -574,89 +4922,80 @@ func (_c *MockGit_DeleteTag_Call) Run(run func(name string, options ...git.Optio
 	return _c
 }
 
-func (_c *MockGit_DeleteTag_Call) Return(_a0 error) *MockGit_DeleteTag_Call {
-	_c.Call.Return(_a0)
+func (_c *MockGit_StashApply_Call) Return(_a0 *types.MergeResult, _a1 error) *MockGit_StashApply_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_DeleteTag_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_DeleteTag_Call {
+func (_c *MockGit_StashApply_Call) RunAndReturn(run func(string, ...git.Option) (*types.MergeResult, error)) *MockGit_StashApply_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Diff provides a mock function with given fields: options
-func (_m *MockGit) Diff(options ...git.Option) ([]types.Diff, error) {
+// StashBranch provides a mock function with given fields: branch, stash, options
+func (_m *MockGit) StashBranch(branch string, stash string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, branch, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Diff")
-	}
-
-	var r0 []types.Diff
-	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Diff, error)); ok {
-		return rf(options...)
-	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Diff); ok {
-		r0 = rf(options...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Diff)
-		}
+		panic("no return value specified for StashBranch")
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(branch, stash, options...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockGit_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
-type MockGit_Diff_Call struct {
+// MockGit_StashBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashBranch'
+type MockGit_StashBranch_Call struct {
 	*mock.Call
 }
 
-// Diff is a helper method to define mock.On call
+// StashBranch is a helper method to define mock.On call
+//   - branch string
+//   - stash string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Diff(options ...interface{}) *MockGit_Diff_Call {
-	return &MockGit_Diff_Call{Call: _e.mock.On("Diff",
-		append([]interface{}{}, options...)...)}
+func (_e *MockGit_Expecter) StashBranch(branch interface{}, stash interface{}, options ...interface{}) *MockGit_StashBranch_Call {
+	return &MockGit_StashBranch_Call{Call: _e.mock.On("StashBranch",
+		append([]interface{}{branch, stash}, options...)...)}
 }
 
-func (_c *MockGit_Diff_Call) Run(run func(options ...git.Option)) *MockGit_Diff_Call {
+func (_c *MockGit_StashBranch_Call) Run(run func(branch string, stash string, options ...git.Option)) *MockGit_StashBranch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_Diff_Call) Return(_a0 []types.Diff, _a1 error) *MockGit_Diff_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockGit_StashBranch_Call) Return(_a0 error) *MockGit_StashBranch_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Diff_Call) RunAndReturn(run func(...git.Option) ([]types.Diff, error)) *MockGit_Diff_Call {
+func (_c *MockGit_StashBranch_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_StashBranch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Fetch provides a mock function with given fields: options
-func (_m *MockGit) Fetch(options ...git.Option) ([]types.Remote, error) {
+// StashClear provides a mock function with given fields: options
+func (_m *MockGit) StashClear(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -666,44 +5005,32 @@ func (_m *MockGit) Fetch(options ...git.Option) ([]types.Remote, error) {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Fetch")
+		panic("no return value specified for StashClear")
 	}
 
-	var r0 []types.Remote
-	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
-		return rf(options...)
-	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
 		r0 = rf(options...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Remote)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockGit_Fetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fetch'
-type MockGit_Fetch_Call struct {
+// MockGit_StashClear_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashClear'
+type MockGit_StashClear_Call struct {
 	*mock.Call
 }
 
-// Fetch is a helper method to define mock.On call
+// StashClear is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Fetch(options ...interface{}) *MockGit_Fetch_Call {
-	return &MockGit_Fetch_Call{Call: _e.mock.On("Fetch",
+func (_e *MockGit_Expecter) StashClear(options ...interface{}) *MockGit_StashClear_Call {
+	return &MockGit_StashClear_Call{Call: _e.mock.On("StashClear",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_Fetch_Call) Run(run func(options ...git.Option)) *MockGit_Fetch_Call {
+func (_c *MockGit_StashClear_Call) Run(run func(options ...git.Option)) *MockGit_StashClear_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -716,65 +5043,55 @@ func (_c *MockGit_Fetch_Call) Run(run func(options ...git.Option)) *MockGit_Fetc
 	return _c
 }
 
-func (_c *MockGit_Fetch_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_Fetch_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockGit_StashClear_Call) Return(_a0 error) *MockGit_StashClear_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Fetch_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockGit_Fetch_Call {
+func (_c *MockGit_StashClear_Call) RunAndReturn(run func(...git.Option) error) *MockGit_StashClear_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConfig provides a mock function with given fields: key, options
-func (_m *MockGit) GetConfig(key string, options ...git.Option) (string, error) {
+// StashDrop provides a mock function with given fields: stash, options
+func (_m *MockGit) StashDrop(stash string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, key)
+	_ca = append(_ca, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConfig")
-	}
-
-	var r0 string
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) (string, error)); ok {
-		return rf(key, options...)
-	}
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) string); ok {
-		r0 = rf(key, options...)
-	} else {
-		r0 = ret.Get(0).(string)
+		panic("no return value specified for StashDrop")
 	}
 
-	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
-		r1 = rf(key, options...)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(stash, options...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockGit_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
-type MockGit_GetConfig_Call struct {
+// MockGit_StashDrop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashDrop'
+type MockGit_StashDrop_Call struct {
 	*mock.Call
 }
 
-// GetConfig is a helper method to define mock.On call
-//   - key string
+// StashDrop is a helper method to define mock.On call
+//   - stash string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) GetConfig(key interface{}, options ...interface{}) *MockGit_GetConfig_Call {
-	return &MockGit_GetConfig_Call{Call: _e.mock.On("GetConfig",
-		append([]interface{}{key}, options...)...)}
+func (_e *MockGit_Expecter) StashDrop(stash interface{}, options ...interface{}) *MockGit_StashDrop_Call {
+	return &MockGit_StashDrop_Call{Call: _e.mock.On("StashDrop",
+		append([]interface{}{stash}, options...)...)}
 }
 
-func (_c *MockGit_GetConfig_Call) Run(run func(key string, options ...git.Option)) *MockGit_GetConfig_Call {
+func (_c *MockGit_StashDrop_Call) Run(run func(stash string, options ...git.Option)) *MockGit_StashDrop_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -787,106 +5104,117 @@ func (_c *MockGit_GetConfig_Call) Run(run func(key string, options ...git.Option
 	return _c
 }
 
-func (_c *MockGit_GetConfig_Call) Return(_a0 string, _a1 error) *MockGit_GetConfig_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockGit_StashDrop_Call) Return(_a0 error) *MockGit_StashDrop_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_GetConfig_Call) RunAndReturn(run func(string, ...git.Option) (string, error)) *MockGit_GetConfig_Call {
+func (_c *MockGit_StashDrop_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_StashDrop_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Init provides a mock function with given fields: path, options
-func (_m *MockGit) Init(path string, options ...git.Option) error {
+// StashList provides a mock function with given fields: options
+func (_m *MockGit) StashList(options ...git.Option) ([]types.StashEntry, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, path)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Init")
+		panic("no return value specified for StashList")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(path, options...)
+	var r0 []types.StashEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.StashEntry, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.StashEntry); ok {
+		r0 = rf(options...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.StashEntry)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockGit_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
-type MockGit_Init_Call struct {
+// MockGit_StashList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashList'
+type MockGit_StashList_Call struct {
 	*mock.Call
 }
 
-// Init is a helper method to define mock.On call
-//   - path string
+// StashList is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Init(path interface{}, options ...interface{}) *MockGit_Init_Call {
-	return &MockGit_Init_Call{Call: _e.mock.On("Init",
-		append([]interface{}{path}, options...)...)}
+func (_e *MockGit_Expecter) StashList(options ...interface{}) *MockGit_StashList_Call {
+	return &MockGit_StashList_Call{Call: _e.mock.On("StashList",
+		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_Init_Call) Run(run func(path string, options ...git.Option)) *MockGit_Init_Call {
+func (_c *MockGit_StashList_Call) Run(run func(options ...git.Option)) *MockGit_StashList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_Init_Call) Return(_a0 error) *MockGit_Init_Call {
-	_c.Call.Return(_a0)
+func (_c *MockGit_StashList_Call) Return(_a0 []types.StashEntry, _a1 error) *MockGit_StashList_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_Init_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_Init_Call {
+func (_c *MockGit_StashList_Call) RunAndReturn(run func(...git.Option) ([]types.StashEntry, error)) *MockGit_StashList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListBranches provides a mock function with given fields: options
-func (_m *MockGit) ListBranches(options ...git.Option) ([]types.Branch, error) {
+// StashPop provides a mock function with given fields: stash, options
+func (_m *MockGit) StashPop(stash string, options ...git.Option) (*types.MergeResult, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListBranches")
+		panic("no return value specified for StashPop")
 	}
 
-	var r0 []types.Branch
+	var r0 *types.MergeResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Branch, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.MergeResult, error)); ok {
+		return rf(stash, options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Branch); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.MergeResult); ok {
+		r0 = rf(stash, options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Branch)
+			r0 = ret.Get(0).(*types.MergeResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(stash, options...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -894,43 +5222,44 @@ func (_m *MockGit) ListBranches(options ...git.Option) ([]types.Branch, error) {
 	return r0, r1
 }
 
-// MockGit_ListBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBranches'
-type MockGit_ListBranches_Call struct {
+// MockGit_StashPop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashPop'
+type MockGit_StashPop_Call struct {
 	*mock.Call
 }
 
-// ListBranches is a helper method to define mock.On call
+// StashPop is a helper method to define mock.On call
+//   - stash string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) ListBranches(options ...interface{}) *MockGit_ListBranches_Call {
-	return &MockGit_ListBranches_Call{Call: _e.mock.On("ListBranches",
-		append([]interface{}{}, options...)...)}
+func (_e *MockGit_Expecter) StashPop(stash interface{}, options ...interface{}) *MockGit_StashPop_Call {
+	return &MockGit_StashPop_Call{Call: _e.mock.On("StashPop",
+		append([]interface{}{stash}, options...)...)}
 }
 
-func (_c *MockGit_ListBranches_Call) Run(run func(options ...git.Option)) *MockGit_ListBranches_Call {
+func (_c *MockGit_StashPop_Call) Run(run func(stash string, options ...git.Option)) *MockGit_StashPop_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_ListBranches_Call) Return(_a0 []types.Branch, _a1 error) *MockGit_ListBranches_Call {
+func (_c *MockGit_StashPop_Call) Return(_a0 *types.MergeResult, _a1 error) *MockGit_StashPop_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_ListBranches_Call) RunAndReturn(run func(...git.Option) ([]types.Branch, error)) *MockGit_ListBranches_Call {
+func (_c *MockGit_StashPop_Call) RunAndReturn(run func(string, ...git.Option) (*types.MergeResult, error)) *MockGit_StashPop_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListConfig provides a mock function with given fields: options
-func (_m *MockGit) ListConfig(options ...git.Option) ([]types.ConfigEntry, error) {
+// StashPush provides a mock function with given fields: options
+func (_m *MockGit) StashPush(options ...git.Option) (*types.StashEntry, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -940,19 +5269,19 @@ func (_m *MockGit) ListConfig(options ...git.Option) ([]types.ConfigEntry, error
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListConfig")
+		panic("no return value specified for StashPush")
 	}
 
-	var r0 []types.ConfigEntry
+	var r0 *types.StashEntry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.ConfigEntry, error)); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.StashEntry, error)); ok {
 		return rf(options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.ConfigEntry); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.StashEntry); ok {
 		r0 = rf(options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.ConfigEntry)
+			r0 = ret.Get(0).(*types.StashEntry)
 		}
 	}
 
@@ -965,19 +5294,19 @@ func (_m *MockGit) ListConfig(options ...git.Option) ([]types.ConfigEntry, error
 	return r0, r1
 }
 
-// MockGit_ListConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfig'
-type MockGit_ListConfig_Call struct {
+// MockGit_StashPush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashPush'
+type MockGit_StashPush_Call struct {
 	*mock.Call
 }
 
-// ListConfig is a helper method to define mock.On call
+// StashPush is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) ListConfig(options ...interface{}) *MockGit_ListConfig_Call {
-	return &MockGit_ListConfig_Call{Call: _e.mock.On("ListConfig",
+func (_e *MockGit_Expecter) StashPush(options ...interface{}) *MockGit_StashPush_Call {
+	return &MockGit_StashPush_Call{Call: _e.mock.On("StashPush",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_ListConfig_Call) Run(run func(options ...git.Option)) *MockGit_ListConfig_Call {
+func (_c *MockGit_StashPush_Call) Run(run func(options ...git.Option)) *MockGit_StashPush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -990,45 +5319,46 @@ func (_c *MockGit_ListConfig_Call) Run(run func(options ...git.Option)) *MockGit
 	return _c
 }
 
-func (_c *MockGit_ListConfig_Call) Return(_a0 []types.ConfigEntry, _a1 error) *MockGit_ListConfig_Call {
+func (_c *MockGit_StashPush_Call) Return(_a0 *types.StashEntry, _a1 error) *MockGit_StashPush_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_ListConfig_Call) RunAndReturn(run func(...git.Option) ([]types.ConfigEntry, error)) *MockGit_ListConfig_Call {
+func (_c *MockGit_StashPush_Call) RunAndReturn(run func(...git.Option) (*types.StashEntry, error)) *MockGit_StashPush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListRemotes provides a mock function with given fields: options
-func (_m *MockGit) ListRemotes(options ...git.Option) ([]types.Remote, error) {
+// StashShow provides a mock function with given fields: stash, options
+func (_m *MockGit) StashShow(stash string, options ...git.Option) ([]types.Diff, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListRemotes")
+		panic("no return value specified for StashShow")
 	}
 
-	var r0 []types.Remote
+	var r0 []types.Diff
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.Diff, error)); ok {
+		return rf(stash, options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.Diff); ok {
+		r0 = rf(stash, options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Remote)
+			r0 = ret.Get(0).([]types.Diff)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(stash, options...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1036,70 +5366,65 @@ func (_m *MockGit) ListRemotes(options ...git.Option) ([]types.Remote, error) {
 	return r0, r1
 }
 
-// MockGit_ListRemotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRemotes'
-type MockGit_ListRemotes_Call struct {
+// MockGit_StashShow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashShow'
+type MockGit_StashShow_Call struct {
 	*mock.Call
 }
 
-// ListRemotes is a helper method to define mock.On call
+// StashShow is a helper method to define mock.On call
+//   - stash string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) ListRemotes(options ...interface{}) *MockGit_ListRemotes_Call {
-	return &MockGit_ListRemotes_Call{Call: _e.mock.On("ListRemotes",
-		append([]interface{}{}, options...)...)}
+func (_e *MockGit_Expecter) StashShow(stash interface{}, options ...interface{}) *MockGit_StashShow_Call {
+	return &MockGit_StashShow_Call{Call: _e.mock.On("StashShow",
+		append([]interface{}{stash}, options...)...)}
 }
 
-func (_c *MockGit_ListRemotes_Call) Run(run func(options ...git.Option)) *MockGit_ListRemotes_Call {
+func (_c *MockGit_StashShow_Call) Run(run func(stash string, options ...git.Option)) *MockGit_StashShow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_ListRemotes_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_ListRemotes_Call {
+func (_c *MockGit_StashShow_Call) Return(_a0 []types.Diff, _a1 error) *MockGit_StashShow_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_ListRemotes_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockGit_ListRemotes_Call {
+func (_c *MockGit_StashShow_Call) RunAndReturn(run func(string, ...git.Option) ([]types.Diff, error)) *MockGit_StashShow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTags provides a mock function with given fields: options
-func (_m *MockGit) ListTags(options ...git.Option) ([]string, error) {
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// State provides a mock function with no fields
+func (_m *MockGit) State() (*types.RepositoryState, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTags")
+		panic("no return value specified for State")
 	}
 
-	var r0 []string
+	var r0 *types.RepositoryState
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]string, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func() (*types.RepositoryState, error)); ok {
+		return rf()
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []string); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func() *types.RepositoryState); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).(*types.RepositoryState)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1107,43 +5432,35 @@ func (_m *MockGit) ListTags(options ...git.Option) ([]string, error) {
 	return r0, r1
 }
 
-// MockGit_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
-type MockGit_ListTags_Call struct {
+// MockGit_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type MockGit_State_Call struct {
 	*mock.Call
 }
 
-// ListTags is a helper method to define mock.On call
-//   - options ...git.Option
-func (_e *MockGit_Expecter) ListTags(options ...interface{}) *MockGit_ListTags_Call {
-	return &MockGit_ListTags_Call{Call: _e.mock.On("ListTags",
-		append([]interface{}{}, options...)...)}
+// State is a helper method to define mock.On call
+func (_e *MockGit_Expecter) State() *MockGit_State_Call {
+	return &MockGit_State_Call{Call: _e.mock.On("State")}
 }
 
-func (_c *MockGit_ListTags_Call) Run(run func(options ...git.Option)) *MockGit_ListTags_Call {
+func (_c *MockGit_State_Call) Run(run func()) *MockGit_State_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
-			if a != nil {
-				variadicArgs[i] = a.(git.Option)
-			}
-		}
-		run(variadicArgs...)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockGit_ListTags_Call) Return(_a0 []string, _a1 error) *MockGit_ListTags_Call {
+func (_c *MockGit_State_Call) Return(_a0 *types.RepositoryState, _a1 error) *MockGit_State_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_ListTags_Call) RunAndReturn(run func(...git.Option) ([]string, error)) *MockGit_ListTags_Call {
+func (_c *MockGit_State_Call) RunAndReturn(run func() (*types.RepositoryState, error)) *MockGit_State_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Log provides a mock function with given fields: options
-func (_m *MockGit) Log(options ...git.Option) ([]types.Log, error) {
+// Status provides a mock function with given fields: options
+func (_m *MockGit) Status(options ...git.Option) ([]types.File, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1153,19 +5470,19 @@ func (_m *MockGit) Log(options ...git.Option) ([]types.Log, error) {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Log")
+		panic("no return value specified for Status")
 	}
 
-	var r0 []types.Log
+	var r0 []types.File
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Log, error)); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.File, error)); ok {
 		return rf(options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Log); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.File); ok {
 		r0 = rf(options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Log)
+			r0 = ret.Get(0).([]types.File)
 		}
 	}
 
@@ -1178,19 +5495,19 @@ func (_m *MockGit) Log(options ...git.Option) ([]types.Log, error) {
 	return r0, r1
 }
 
-// MockGit_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
-type MockGit_Log_Call struct {
+// MockGit_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockGit_Status_Call struct {
 	*mock.Call
 }
 
-// Log is a helper method to define mock.On call
+// Status is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Log(options ...interface{}) *MockGit_Log_Call {
-	return &MockGit_Log_Call{Call: _e.mock.On("Log",
+func (_e *MockGit_Expecter) Status(options ...interface{}) *MockGit_Status_Call {
+	return &MockGit_Status_Call{Call: _e.mock.On("Status",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_Log_Call) Run(run func(options ...git.Option)) *MockGit_Log_Call {
+func (_c *MockGit_Status_Call) Run(run func(options ...git.Option)) *MockGit_Status_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1203,98 +5520,96 @@ func (_c *MockGit_Log_Call) Run(run func(options ...git.Option)) *MockGit_Log_Ca
 	return _c
 }
 
-func (_c *MockGit_Log_Call) Return(_a0 []types.Log, _a1 error) *MockGit_Log_Call {
+func (_c *MockGit_Status_Call) Return(_a0 []types.File, _a1 error) *MockGit_Status_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_Log_Call) RunAndReturn(run func(...git.Option) ([]types.Log, error)) *MockGit_Log_Call {
+func (_c *MockGit_Status_Call) RunAndReturn(run func(...git.Option) ([]types.File, error)) *MockGit_Status_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Merge provides a mock function with given fields: options
-func (_m *MockGit) Merge(options ...git.Option) (*types.MergeResult, error) {
+// SubmoduleAdd provides a mock function with given fields: url, path, options
+func (_m *MockGit) SubmoduleAdd(url string, path string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, url, path)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Merge")
-	}
-
-	var r0 *types.MergeResult
-	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) (*types.MergeResult, error)); ok {
-		return rf(options...)
-	}
-	if rf, ok := ret.Get(0).(func(...git.Option) *types.MergeResult); ok {
-		r0 = rf(options...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.MergeResult)
-		}
+		panic("no return value specified for SubmoduleAdd")
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(url, path, options...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockGit_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
-type MockGit_Merge_Call struct {
+// MockGit_SubmoduleAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleAdd'
+type MockGit_SubmoduleAdd_Call struct {
 	*mock.Call
 }
 
-// Merge is a helper method to define mock.On call
+// SubmoduleAdd is a helper method to define mock.On call
+//   - url string
+//   - path string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Merge(options ...interface{}) *MockGit_Merge_Call {
-	return &MockGit_Merge_Call{Call: _e.mock.On("Merge",
-		append([]interface{}{}, options...)...)}
+func (_e *MockGit_Expecter) SubmoduleAdd(url interface{}, path interface{}, options ...interface{}) *MockGit_SubmoduleAdd_Call {
+	return &MockGit_SubmoduleAdd_Call{Call: _e.mock.On("SubmoduleAdd",
+		append([]interface{}{url, path}, options...)...)}
 }
 
-func (_c *MockGit_Merge_Call) Run(run func(options ...git.Option)) *MockGit_Merge_Call {
+func (_c *MockGit_SubmoduleAdd_Call) Run(run func(url string, path string, options ...git.Option)) *MockGit_SubmoduleAdd_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_Merge_Call) Return(_a0 *types.MergeResult, _a1 error) *MockGit_Merge_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockGit_SubmoduleAdd_Call) Return(_a0 error) *MockGit_SubmoduleAdd_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Merge_Call) RunAndReturn(run func(...git.Option) (*types.MergeResult, error)) *MockGit_Merge_Call {
+func (_c *MockGit_SubmoduleAdd_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_SubmoduleAdd_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MergeAbort provides a mock function with no fields
-func (_m *MockGit) MergeAbort() error {
-	ret := _m.Called()
+// SubmoduleDeinit provides a mock function with given fields: paths, options
+func (_m *MockGit) SubmoduleDeinit(paths []string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, paths)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MergeAbort")
+		panic("no return value specified for SubmoduleDeinit")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) error); ok {
+		r0 = rf(paths, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1302,80 +5617,115 @@ func (_m *MockGit) MergeAbort() error {
 	return r0
 }
 
-// MockGit_MergeAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeAbort'
-type MockGit_MergeAbort_Call struct {
+// MockGit_SubmoduleDeinit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleDeinit'
+type MockGit_SubmoduleDeinit_Call struct {
 	*mock.Call
 }
 
-// MergeAbort is a helper method to define mock.On call
-func (_e *MockGit_Expecter) MergeAbort() *MockGit_MergeAbort_Call {
-	return &MockGit_MergeAbort_Call{Call: _e.mock.On("MergeAbort")}
+// SubmoduleDeinit is a helper method to define mock.On call
+//   - paths []string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) SubmoduleDeinit(paths interface{}, options ...interface{}) *MockGit_SubmoduleDeinit_Call {
+	return &MockGit_SubmoduleDeinit_Call{Call: _e.mock.On("SubmoduleDeinit",
+		append([]interface{}{paths}, options...)...)}
 }
 
-func (_c *MockGit_MergeAbort_Call) Run(run func()) *MockGit_MergeAbort_Call {
+func (_c *MockGit_SubmoduleDeinit_Call) Run(run func(paths []string, options ...git.Option)) *MockGit_SubmoduleDeinit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].([]string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_MergeAbort_Call) Return(_a0 error) *MockGit_MergeAbort_Call {
+func (_c *MockGit_SubmoduleDeinit_Call) Return(_a0 error) *MockGit_SubmoduleDeinit_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_MergeAbort_Call) RunAndReturn(run func() error) *MockGit_MergeAbort_Call {
+func (_c *MockGit_SubmoduleDeinit_Call) RunAndReturn(run func([]string, ...git.Option) error) *MockGit_SubmoduleDeinit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MergeContinue provides a mock function with no fields
-func (_m *MockGit) MergeContinue() error {
-	ret := _m.Called()
+// SubmoduleForeach provides a mock function with given fields: command, options
+func (_m *MockGit) SubmoduleForeach(command string, options ...git.Option) (string, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, command)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MergeContinue")
+		panic("no return value specified for SubmoduleForeach")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (string, error)); ok {
+		return rf(command, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) string); ok {
+		r0 = rf(command, options...)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(command, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockGit_MergeContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeContinue'
-type MockGit_MergeContinue_Call struct {
+// MockGit_SubmoduleForeach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleForeach'
+type MockGit_SubmoduleForeach_Call struct {
 	*mock.Call
 }
 
-// MergeContinue is a helper method to define mock.On call
-func (_e *MockGit_Expecter) MergeContinue() *MockGit_MergeContinue_Call {
-	return &MockGit_MergeContinue_Call{Call: _e.mock.On("MergeContinue")}
+// SubmoduleForeach is a helper method to define mock.On call
+//   - command string
+//   - options ...git.Option
+func (_e *MockGit_Expecter) SubmoduleForeach(command interface{}, options ...interface{}) *MockGit_SubmoduleForeach_Call {
+	return &MockGit_SubmoduleForeach_Call{Call: _e.mock.On("SubmoduleForeach",
+		append([]interface{}{command}, options...)...)}
 }
 
-func (_c *MockGit_MergeContinue_Call) Run(run func()) *MockGit_MergeContinue_Call {
+func (_c *MockGit_SubmoduleForeach_Call) Run(run func(command string, options ...git.Option)) *MockGit_SubmoduleForeach_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_MergeContinue_Call) Return(_a0 error) *MockGit_MergeContinue_Call {
-	_c.Call.Return(_a0)
+func (_c *MockGit_SubmoduleForeach_Call) Return(_a0 string, _a1 error) *MockGit_SubmoduleForeach_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_MergeContinue_Call) RunAndReturn(run func() error) *MockGit_MergeContinue_Call {
+func (_c *MockGit_SubmoduleForeach_Call) RunAndReturn(run func(string, ...git.Option) (string, error)) *MockGit_SubmoduleForeach_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Pull provides a mock function with given fields: options
-func (_m *MockGit) Pull(options ...git.Option) (*types.MergeResult, error) {
+// SubmoduleInit provides a mock function with given fields: options
+func (_m *MockGit) SubmoduleInit(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1385,44 +5735,32 @@ func (_m *MockGit) Pull(options ...git.Option) (*types.MergeResult, error) {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Pull")
+		panic("no return value specified for SubmoduleInit")
 	}
 
-	var r0 *types.MergeResult
-	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) (*types.MergeResult, error)); ok {
-		return rf(options...)
-	}
-	if rf, ok := ret.Get(0).(func(...git.Option) *types.MergeResult); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
 		r0 = rf(options...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.MergeResult)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockGit_Pull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pull'
-type MockGit_Pull_Call struct {
+// MockGit_SubmoduleInit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleInit'
+type MockGit_SubmoduleInit_Call struct {
 	*mock.Call
 }
 
-// Pull is a helper method to define mock.On call
+// SubmoduleInit is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Pull(options ...interface{}) *MockGit_Pull_Call {
-	return &MockGit_Pull_Call{Call: _e.mock.On("Pull",
+func (_e *MockGit_Expecter) SubmoduleInit(options ...interface{}) *MockGit_SubmoduleInit_Call {
+	return &MockGit_SubmoduleInit_Call{Call: _e.mock.On("SubmoduleInit",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_Pull_Call) Run(run func(options ...git.Option)) *MockGit_Pull_Call {
+func (_c *MockGit_SubmoduleInit_Call) Run(run func(options ...git.Option)) *MockGit_SubmoduleInit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1435,18 +5773,18 @@ func (_c *MockGit_Pull_Call) Run(run func(options ...git.Option)) *MockGit_Pull_
 	return _c
 }
 
-func (_c *MockGit_Pull_Call) Return(_a0 *types.MergeResult, _a1 error) *MockGit_Pull_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockGit_SubmoduleInit_Call) Return(_a0 error) *MockGit_SubmoduleInit_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Pull_Call) RunAndReturn(run func(...git.Option) (*types.MergeResult, error)) *MockGit_Pull_Call {
+func (_c *MockGit_SubmoduleInit_Call) RunAndReturn(run func(...git.Option) error) *MockGit_SubmoduleInit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Push provides a mock function with given fields: options
-func (_m *MockGit) Push(options ...git.Option) ([]types.Remote, error) {
+// SubmoduleStatus provides a mock function with given fields: options
+func (_m *MockGit) SubmoduleStatus(options ...git.Option) ([]types.Submodule, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1456,19 +5794,19 @@ func (_m *MockGit) Push(options ...git.Option) ([]types.Remote, error) {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Push")
+		panic("no return value specified for SubmoduleStatus")
 	}
 
-	var r0 []types.Remote
+	var r0 []types.Submodule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Submodule, error)); ok {
 		return rf(options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Submodule); ok {
 		r0 = rf(options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Remote)
+			r0 = ret.Get(0).([]types.Submodule)
 		}
 	}
 
@@ -1481,19 +5819,19 @@ func (_m *MockGit) Push(options ...git.Option) ([]types.Remote, error) {
 	return r0, r1
 }
 
-// MockGit_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
-type MockGit_Push_Call struct {
+// MockGit_SubmoduleStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleStatus'
+type MockGit_SubmoduleStatus_Call struct {
 	*mock.Call
 }
 
-// Push is a helper method to define mock.On call
+// SubmoduleStatus is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Push(options ...interface{}) *MockGit_Push_Call {
-	return &MockGit_Push_Call{Call: _e.mock.On("Push",
+func (_e *MockGit_Expecter) SubmoduleStatus(options ...interface{}) *MockGit_SubmoduleStatus_Call {
+	return &MockGit_SubmoduleStatus_Call{Call: _e.mock.On("SubmoduleStatus",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_Push_Call) Run(run func(options ...git.Option)) *MockGit_Push_Call {
+func (_c *MockGit_SubmoduleStatus_Call) Run(run func(options ...git.Option)) *MockGit_SubmoduleStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1506,91 +5844,77 @@ func (_c *MockGit_Push_Call) Run(run func(options ...git.Option)) *MockGit_Push_
 	return _c
 }
 
-func (_c *MockGit_Push_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_Push_Call {
+func (_c *MockGit_SubmoduleStatus_Call) Return(_a0 []types.Submodule, _a1 error) *MockGit_SubmoduleStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_Push_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockGit_Push_Call {
+func (_c *MockGit_SubmoduleStatus_Call) RunAndReturn(run func(...git.Option) ([]types.Submodule, error)) *MockGit_SubmoduleStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PushTags provides a mock function with given fields: remote, options
-func (_m *MockGit) PushTags(remote string, options ...git.Option) ([]types.Remote, error) {
+// SubmoduleSync provides a mock function with given fields: options
+func (_m *MockGit) SubmoduleSync(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, remote)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PushTags")
-	}
-
-	var r0 []types.Remote
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.Remote, error)); ok {
-		return rf(remote, options...)
-	}
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.Remote); ok {
-		r0 = rf(remote, options...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Remote)
-		}
+		panic("no return value specified for SubmoduleSync")
 	}
 
-	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
-		r1 = rf(remote, options...)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
+		r0 = rf(options...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockGit_PushTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushTags'
-type MockGit_PushTags_Call struct {
+// MockGit_SubmoduleSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleSync'
+type MockGit_SubmoduleSync_Call struct {
 	*mock.Call
 }
 
-// PushTags is a helper method to define mock.On call
-//   - remote string
+// SubmoduleSync is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) PushTags(remote interface{}, options ...interface{}) *MockGit_PushTags_Call {
-	return &MockGit_PushTags_Call{Call: _e.mock.On("PushTags",
-		append([]interface{}{remote}, options...)...)}
+func (_e *MockGit_Expecter) SubmoduleSync(options ...interface{}) *MockGit_SubmoduleSync_Call {
+	return &MockGit_SubmoduleSync_Call{Call: _e.mock.On("SubmoduleSync",
+		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_PushTags_Call) Run(run func(remote string, options ...git.Option)) *MockGit_PushTags_Call {
+func (_c *MockGit_SubmoduleSync_Call) Run(run func(options ...git.Option)) *MockGit_SubmoduleSync_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_PushTags_Call) Return(_a0 []types.Remote, _a1 error) *MockGit_PushTags_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockGit_SubmoduleSync_Call) Return(_a0 error) *MockGit_SubmoduleSync_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_PushTags_Call) RunAndReturn(run func(string, ...git.Option) ([]types.Remote, error)) *MockGit_PushTags_Call {
+func (_c *MockGit_SubmoduleSync_Call) RunAndReturn(run func(...git.Option) error) *MockGit_SubmoduleSync_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Rebase provides a mock function with given fields: options
-func (_m *MockGit) Rebase(options ...git.Option) error {
+// SubmoduleUpdate provides a mock function with given fields: options
+func (_m *MockGit) SubmoduleUpdate(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1600,7 +5924,7 @@ func (_m *MockGit) Rebase(options ...git.Option) error {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Rebase")
+		panic("no return value specified for SubmoduleUpdate")
 	}
 
 	var r0 error
@@ -1613,19 +5937,19 @@ func (_m *MockGit) Rebase(options ...git.Option) error {
 	return r0
 }
 
-// MockGit_Rebase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebase'
-type MockGit_Rebase_Call struct {
+// MockGit_SubmoduleUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleUpdate'
+type MockGit_SubmoduleUpdate_Call struct {
 	*mock.Call
 }
 
-// Rebase is a helper method to define mock.On call
+// SubmoduleUpdate is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Rebase(options ...interface{}) *MockGit_Rebase_Call {
-	return &MockGit_Rebase_Call{Call: _e.mock.On("Rebase",
+func (_e *MockGit_Expecter) SubmoduleUpdate(options ...interface{}) *MockGit_SubmoduleUpdate_Call {
+	return &MockGit_SubmoduleUpdate_Call{Call: _e.mock.On("SubmoduleUpdate",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_Rebase_Call) Run(run func(options ...git.Option)) *MockGit_Rebase_Call {
+func (_c *MockGit_SubmoduleUpdate_Call) Run(run func(options ...git.Option)) *MockGit_SubmoduleUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1638,33 +5962,34 @@ func (_c *MockGit_Rebase_Call) Run(run func(options ...git.Option)) *MockGit_Reb
 	return _c
 }
 
-func (_c *MockGit_Rebase_Call) Return(_a0 error) *MockGit_Rebase_Call {
+func (_c *MockGit_SubmoduleUpdate_Call) Return(_a0 error) *MockGit_SubmoduleUpdate_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Rebase_Call) RunAndReturn(run func(...git.Option) error) *MockGit_Rebase_Call {
+func (_c *MockGit_SubmoduleUpdate_Call) RunAndReturn(run func(...git.Option) error) *MockGit_SubmoduleUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Reflog provides a mock function with given fields: options
-func (_m *MockGit) Reflog(options ...git.Option) error {
+// Tag provides a mock function with given fields: name, options
+func (_m *MockGit) Tag(name string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, name)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Reflog")
+		panic("no return value specified for Tag")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(name, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1672,58 +5997,60 @@ func (_m *MockGit) Reflog(options ...git.Option) error {
 	return r0
 }
 
-// MockGit_Reflog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reflog'
-type MockGit_Reflog_Call struct {
+// MockGit_Tag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tag'
+type MockGit_Tag_Call struct {
 	*mock.Call
 }
 
-// Reflog is a helper method to define mock.On call
+// Tag is a helper method to define mock.On call
+//   - name string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Reflog(options ...interface{}) *MockGit_Reflog_Call {
-	return &MockGit_Reflog_Call{Call: _e.mock.On("Reflog",
-		append([]interface{}{}, options...)...)}
+func (_e *MockGit_Expecter) Tag(name interface{}, options ...interface{}) *MockGit_Tag_Call {
+	return &MockGit_Tag_Call{Call: _e.mock.On("Tag",
+		append([]interface{}{name}, options...)...)}
 }
 
-func (_c *MockGit_Reflog_Call) Run(run func(options ...git.Option)) *MockGit_Reflog_Call {
+func (_c *MockGit_Tag_Call) Run(run func(name string, options ...git.Option)) *MockGit_Tag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_Reflog_Call) Return(_a0 error) *MockGit_Reflog_Call {
+func (_c *MockGit_Tag_Call) Return(_a0 error) *MockGit_Tag_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Reflog_Call) RunAndReturn(run func(...git.Option) error) *MockGit_Reflog_Call {
+func (_c *MockGit_Tag_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_Tag_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Remove provides a mock function with given fields: options
-func (_m *MockGit) Remove(options ...git.Option) error {
+// UnsetConfig provides a mock function with given fields: key, options
+func (_m *MockGit) UnsetConfig(key string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, key)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Remove")
+		panic("no return value specified for UnsetConfig")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(key, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1731,59 +6058,60 @@ func (_m *MockGit) Remove(options ...git.Option) error {
 	return r0
 }
 
-// MockGit_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
-type MockGit_Remove_Call struct {
+// MockGit_UnsetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetConfig'
+type MockGit_UnsetConfig_Call struct {
 	*mock.Call
 }
 
-// Remove is a helper method to define mock.On call
+// UnsetConfig is a helper method to define mock.On call
+//   - key string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Remove(options ...interface{}) *MockGit_Remove_Call {
-	return &MockGit_Remove_Call{Call: _e.mock.On("Remove",
-		append([]interface{}{}, options...)...)}
+func (_e *MockGit_Expecter) UnsetConfig(key interface{}, options ...interface{}) *MockGit_UnsetConfig_Call {
+	return &MockGit_UnsetConfig_Call{Call: _e.mock.On("UnsetConfig",
+		append([]interface{}{key}, options...)...)}
 }
 
-func (_c *MockGit_Remove_Call) Run(run func(options ...git.Option)) *MockGit_Remove_Call {
+func (_c *MockGit_UnsetConfig_Call) Run(run func(key string, options ...git.Option)) *MockGit_UnsetConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_Remove_Call) Return(_a0 error) *MockGit_Remove_Call {
+func (_c *MockGit_UnsetConfig_Call) Return(_a0 error) *MockGit_UnsetConfig_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Remove_Call) RunAndReturn(run func(...git.Option) error) *MockGit_Remove_Call {
+func (_c *MockGit_UnsetConfig_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_UnsetConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveRemote provides a mock function with given fields: name, options
-func (_m *MockGit) RemoveRemote(name string, options ...git.Option) error {
+// UnsetUpstream provides a mock function with given fields: branch, options
+func (_m *MockGit) UnsetUpstream(branch string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, name)
+	_ca = append(_ca, branch)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveRemote")
+		panic("no return value specified for UnsetUpstream")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(name, options...)
+		r0 = rf(branch, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1791,20 +6119,20 @@ func (_m *MockGit) RemoveRemote(name string, options ...git.Option) error {
 	return r0
 }
 
-// MockGit_RemoveRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRemote'
-type MockGit_RemoveRemote_Call struct {
+// MockGit_UnsetUpstream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetUpstream'
+type MockGit_UnsetUpstream_Call struct {
 	*mock.Call
 }
 
-// RemoveRemote is a helper method to define mock.On call
-//   - name string
+// UnsetUpstream is a helper method to define mock.On call
+//   - branch string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) RemoveRemote(name interface{}, options ...interface{}) *MockGit_RemoveRemote_Call {
-	return &MockGit_RemoveRemote_Call{Call: _e.mock.On("RemoveRemote",
-		append([]interface{}{name}, options...)...)}
+func (_e *MockGit_Expecter) UnsetUpstream(branch interface{}, options ...interface{}) *MockGit_UnsetUpstream_Call {
+	return &MockGit_UnsetUpstream_Call{Call: _e.mock.On("UnsetUpstream",
+		append([]interface{}{branch}, options...)...)}
 }
 
-func (_c *MockGit_RemoveRemote_Call) Run(run func(name string, options ...git.Option)) *MockGit_RemoveRemote_Call {
+func (_c *MockGit_UnsetUpstream_Call) Run(run func(branch string, options ...git.Option)) *MockGit_UnsetUpstream_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -1817,55 +6145,67 @@ func (_c *MockGit_RemoveRemote_Call) Run(run func(name string, options ...git.Op
 	return _c
 }
 
-func (_c *MockGit_RemoveRemote_Call) Return(_a0 error) *MockGit_RemoveRemote_Call {
+func (_c *MockGit_UnsetUpstream_Call) Return(_a0 error) *MockGit_UnsetUpstream_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_RemoveRemote_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_RemoveRemote_Call {
+func (_c *MockGit_UnsetUpstream_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_UnsetUpstream_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Reset provides a mock function with given fields: files, options
-func (_m *MockGit) Reset(files []string, options ...git.Option) error {
+// VerifyTag provides a mock function with given fields: name, options
+func (_m *MockGit) VerifyTag(name string, options ...git.Option) (*types.CommitSignature, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, files)
+	_ca = append(_ca, name)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Reset")
+		panic("no return value specified for VerifyTag")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func([]string, ...git.Option) error); ok {
-		r0 = rf(files, options...)
+	var r0 *types.CommitSignature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.CommitSignature, error)); ok {
+		return rf(name, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.CommitSignature); ok {
+		r0 = rf(name, options...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CommitSignature)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(name, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockGit_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
-type MockGit_Reset_Call struct {
+// MockGit_VerifyTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyTag'
+type MockGit_VerifyTag_Call struct {
 	*mock.Call
 }
 
-// Reset is a helper method to define mock.On call
-//   - files []string
+// VerifyTag is a helper method to define mock.On call
+//   - name string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Reset(files interface{}, options ...interface{}) *MockGit_Reset_Call {
-	return &MockGit_Reset_Call{Call: _e.mock.On("Reset",
-		append([]interface{}{files}, options...)...)}
+func (_e *MockGit_Expecter) VerifyTag(name interface{}, options ...interface{}) *MockGit_VerifyTag_Call {
+	return &MockGit_VerifyTag_Call{Call: _e.mock.On("VerifyTag",
+		append([]interface{}{name}, options...)...)}
 }
 
-func (_c *MockGit_Reset_Call) Run(run func(files []string, options ...git.Option)) *MockGit_Reset_Call {
+func (_c *MockGit_VerifyTag_Call) Run(run func(name string, options ...git.Option)) *MockGit_VerifyTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -1873,144 +6213,135 @@ func (_c *MockGit_Reset_Call) Run(run func(files []string, options ...git.Option
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].([]string), variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_Reset_Call) Return(_a0 error) *MockGit_Reset_Call {
-	_c.Call.Return(_a0)
+func (_c *MockGit_VerifyTag_Call) Return(_a0 *types.CommitSignature, _a1 error) *MockGit_VerifyTag_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_Reset_Call) RunAndReturn(run func([]string, ...git.Option) error) *MockGit_Reset_Call {
+func (_c *MockGit_VerifyTag_Call) RunAndReturn(run func(string, ...git.Option) (*types.CommitSignature, error)) *MockGit_VerifyTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResolveConflicts provides a mock function with given fields: resolutions
-func (_m *MockGit) ResolveConflicts(resolutions []types.ConflictResolution) error {
-	ret := _m.Called(resolutions)
+// WithContext provides a mock function with given fields: ctx
+func (_m *MockGit) WithContext(ctx context.Context) git.Git {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResolveConflicts")
+		panic("no return value specified for WithContext")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func([]types.ConflictResolution) error); ok {
-		r0 = rf(resolutions)
+	var r0 git.Git
+	if rf, ok := ret.Get(0).(func(context.Context) git.Git); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(git.Git)
+		}
 	}
 
 	return r0
 }
 
-// MockGit_ResolveConflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveConflicts'
-type MockGit_ResolveConflicts_Call struct {
+// MockGit_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type MockGit_WithContext_Call struct {
 	*mock.Call
 }
 
-// ResolveConflicts is a helper method to define mock.On call
-//   - resolutions []types.ConflictResolution
-func (_e *MockGit_Expecter) ResolveConflicts(resolutions interface{}) *MockGit_ResolveConflicts_Call {
-	return &MockGit_ResolveConflicts_Call{Call: _e.mock.On("ResolveConflicts", resolutions)}
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockGit_Expecter) WithContext(ctx interface{}) *MockGit_WithContext_Call {
+	return &MockGit_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
 }
 
-func (_c *MockGit_ResolveConflicts_Call) Run(run func(resolutions []types.ConflictResolution)) *MockGit_ResolveConflicts_Call {
+func (_c *MockGit_WithContext_Call) Run(run func(ctx context.Context)) *MockGit_WithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]types.ConflictResolution))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockGit_ResolveConflicts_Call) Return(_a0 error) *MockGit_ResolveConflicts_Call {
+func (_c *MockGit_WithContext_Call) Return(_a0 git.Git) *MockGit_WithContext_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_ResolveConflicts_Call) RunAndReturn(run func([]types.ConflictResolution) error) *MockGit_ResolveConflicts_Call {
+func (_c *MockGit_WithContext_Call) RunAndReturn(run func(context.Context) git.Git) *MockGit_WithContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Revert provides a mock function with given fields: options
-func (_m *MockGit) Revert(options ...git.Option) error {
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// WithWorktree provides a mock function with given fields: path
+func (_m *MockGit) WithWorktree(path string) git.Git {
+	ret := _m.Called(path)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Revert")
+		panic("no return value specified for WithWorktree")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
-		r0 = rf(options...)
+	var r0 git.Git
+	if rf, ok := ret.Get(0).(func(string) git.Git); ok {
+		r0 = rf(path)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(git.Git)
+		}
 	}
 
 	return r0
 }
 
-// MockGit_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
-type MockGit_Revert_Call struct {
+// MockGit_WithWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithWorktree'
+type MockGit_WithWorktree_Call struct {
 	*mock.Call
 }
 
-// Revert is a helper method to define mock.On call
-//   - options ...git.Option
-func (_e *MockGit_Expecter) Revert(options ...interface{}) *MockGit_Revert_Call {
-	return &MockGit_Revert_Call{Call: _e.mock.On("Revert",
-		append([]interface{}{}, options...)...)}
+// WithWorktree is a helper method to define mock.On call
+//   - path string
+func (_e *MockGit_Expecter) WithWorktree(path interface{}) *MockGit_WithWorktree_Call {
+	return &MockGit_WithWorktree_Call{Call: _e.mock.On("WithWorktree", path)}
 }
 
-func (_c *MockGit_Revert_Call) Run(run func(options ...git.Option)) *MockGit_Revert_Call {
+func (_c *MockGit_WithWorktree_Call) Run(run func(path string)) *MockGit_WithWorktree_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
-			if a != nil {
-				variadicArgs[i] = a.(git.Option)
-			}
-		}
-		run(variadicArgs...)
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockGit_Revert_Call) Return(_a0 error) *MockGit_Revert_Call {
+func (_c *MockGit_WithWorktree_Call) Return(_a0 git.Git) *MockGit_WithWorktree_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Revert_Call) RunAndReturn(run func(...git.Option) error) *MockGit_Revert_Call {
+func (_c *MockGit_WithWorktree_Call) RunAndReturn(run func(string) git.Git) *MockGit_WithWorktree_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetConfig provides a mock function with given fields: key, value, options
-func (_m *MockGit) SetConfig(key string, value string, options ...git.Option) error {
+// WorktreeAdd provides a mock function with given fields: path, commitish, options
+func (_m *MockGit) WorktreeAdd(path string, commitish string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, key, value)
+	_ca = append(_ca, path, commitish)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetConfig")
+		panic("no return value specified for WorktreeAdd")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(key, value, options...)
+		r0 = rf(path, commitish, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2018,21 +6349,21 @@ func (_m *MockGit) SetConfig(key string, value string, options ...git.Option) er
 	return r0
 }
 
-// MockGit_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
-type MockGit_SetConfig_Call struct {
+// MockGit_WorktreeAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeAdd'
+type MockGit_WorktreeAdd_Call struct {
 	*mock.Call
 }
 
-// SetConfig is a helper method to define mock.On call
-//   - key string
-//   - value string
+// WorktreeAdd is a helper method to define mock.On call
+//   - path string
+//   - commitish string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) SetConfig(key interface{}, value interface{}, options ...interface{}) *MockGit_SetConfig_Call {
-	return &MockGit_SetConfig_Call{Call: _e.mock.On("SetConfig",
-		append([]interface{}{key, value}, options...)...)}
+func (_e *MockGit_Expecter) WorktreeAdd(path interface{}, commitish interface{}, options ...interface{}) *MockGit_WorktreeAdd_Call {
+	return &MockGit_WorktreeAdd_Call{Call: _e.mock.On("WorktreeAdd",
+		append([]interface{}{path, commitish}, options...)...)}
 }
 
-func (_c *MockGit_SetConfig_Call) Run(run func(key string, value string, options ...git.Option)) *MockGit_SetConfig_Call {
+func (_c *MockGit_WorktreeAdd_Call) Run(run func(path string, commitish string, options ...git.Option)) *MockGit_WorktreeAdd_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-2)
 		for i, a := range args[2:] {
@@ -2045,96 +6376,105 @@ func (_c *MockGit_SetConfig_Call) Run(run func(key string, value string, options
 	return _c
 }
 
-func (_c *MockGit_SetConfig_Call) Return(_a0 error) *MockGit_SetConfig_Call {
+func (_c *MockGit_WorktreeAdd_Call) Return(_a0 error) *MockGit_WorktreeAdd_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_SetConfig_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_SetConfig_Call {
+func (_c *MockGit_WorktreeAdd_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_WorktreeAdd_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetRemoteURL provides a mock function with given fields: name, url, options
-func (_m *MockGit) SetRemoteURL(name string, url string, options ...git.Option) error {
+// WorktreeList provides a mock function with given fields: options
+func (_m *MockGit) WorktreeList(options ...git.Option) ([]types.Worktree, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, name, url)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetRemoteURL")
+		panic("no return value specified for WorktreeList")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(name, url, options...)
+	var r0 []types.Worktree
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Worktree, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Worktree); ok {
+		r0 = rf(options...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Worktree)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockGit_SetRemoteURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRemoteURL'
-type MockGit_SetRemoteURL_Call struct {
+// MockGit_WorktreeList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeList'
+type MockGit_WorktreeList_Call struct {
 	*mock.Call
 }
 
-// SetRemoteURL is a helper method to define mock.On call
-//   - name string
-//   - url string
+// WorktreeList is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) SetRemoteURL(name interface{}, url interface{}, options ...interface{}) *MockGit_SetRemoteURL_Call {
-	return &MockGit_SetRemoteURL_Call{Call: _e.mock.On("SetRemoteURL",
-		append([]interface{}{name, url}, options...)...)}
+func (_e *MockGit_Expecter) WorktreeList(options ...interface{}) *MockGit_WorktreeList_Call {
+	return &MockGit_WorktreeList_Call{Call: _e.mock.On("WorktreeList",
+		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_SetRemoteURL_Call) Run(run func(name string, url string, options ...git.Option)) *MockGit_SetRemoteURL_Call {
+func (_c *MockGit_WorktreeList_Call) Run(run func(options ...git.Option)) *MockGit_WorktreeList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-2)
-		for i, a := range args[2:] {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), args[1].(string), variadicArgs...)
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_SetRemoteURL_Call) Return(_a0 error) *MockGit_SetRemoteURL_Call {
-	_c.Call.Return(_a0)
+func (_c *MockGit_WorktreeList_Call) Return(_a0 []types.Worktree, _a1 error) *MockGit_WorktreeList_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockGit_SetRemoteURL_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_SetRemoteURL_Call {
+func (_c *MockGit_WorktreeList_Call) RunAndReturn(run func(...git.Option) ([]types.Worktree, error)) *MockGit_WorktreeList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetUpstream provides a mock function with given fields: branch, remote, options
-func (_m *MockGit) SetUpstream(branch string, remote string, options ...git.Option) error {
+// WorktreeLock provides a mock function with given fields: path, options
+func (_m *MockGit) WorktreeLock(path string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, branch, remote)
+	_ca = append(_ca, path)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetUpstream")
+		panic("no return value specified for WorktreeLock")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(branch, remote, options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(path, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2142,151 +6482,106 @@ func (_m *MockGit) SetUpstream(branch string, remote string, options ...git.Opti
 	return r0
 }
 
-// MockGit_SetUpstream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpstream'
-type MockGit_SetUpstream_Call struct {
+// MockGit_WorktreeLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeLock'
+type MockGit_WorktreeLock_Call struct {
 	*mock.Call
 }
 
-// SetUpstream is a helper method to define mock.On call
-//   - branch string
-//   - remote string
+// WorktreeLock is a helper method to define mock.On call
+//   - path string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) SetUpstream(branch interface{}, remote interface{}, options ...interface{}) *MockGit_SetUpstream_Call {
-	return &MockGit_SetUpstream_Call{Call: _e.mock.On("SetUpstream",
-		append([]interface{}{branch, remote}, options...)...)}
+func (_e *MockGit_Expecter) WorktreeLock(path interface{}, options ...interface{}) *MockGit_WorktreeLock_Call {
+	return &MockGit_WorktreeLock_Call{Call: _e.mock.On("WorktreeLock",
+		append([]interface{}{path}, options...)...)}
 }
 
-func (_c *MockGit_SetUpstream_Call) Run(run func(branch string, remote string, options ...git.Option)) *MockGit_SetUpstream_Call {
+func (_c *MockGit_WorktreeLock_Call) Run(run func(path string, options ...git.Option)) *MockGit_WorktreeLock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-2)
-		for i, a := range args[2:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), args[1].(string), variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_SetUpstream_Call) Return(_a0 error) *MockGit_SetUpstream_Call {
+func (_c *MockGit_WorktreeLock_Call) Return(_a0 error) *MockGit_WorktreeLock_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_SetUpstream_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_SetUpstream_Call {
+func (_c *MockGit_WorktreeLock_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_WorktreeLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetWorkingDirectory provides a mock function with given fields: wd
-func (_m *MockGit) SetWorkingDirectory(wd string) {
-	_m.Called(wd)
-}
-
-// MockGit_SetWorkingDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkingDirectory'
-type MockGit_SetWorkingDirectory_Call struct {
-	*mock.Call
-}
-
-// SetWorkingDirectory is a helper method to define mock.On call
-//   - wd string
-func (_e *MockGit_Expecter) SetWorkingDirectory(wd interface{}) *MockGit_SetWorkingDirectory_Call {
-	return &MockGit_SetWorkingDirectory_Call{Call: _e.mock.On("SetWorkingDirectory", wd)}
-}
-
-func (_c *MockGit_SetWorkingDirectory_Call) Run(run func(wd string)) *MockGit_SetWorkingDirectory_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
-	})
-	return _c
-}
-
-func (_c *MockGit_SetWorkingDirectory_Call) Return() *MockGit_SetWorkingDirectory_Call {
-	_c.Call.Return()
-	return _c
-}
-
-func (_c *MockGit_SetWorkingDirectory_Call) RunAndReturn(run func(string)) *MockGit_SetWorkingDirectory_Call {
-	_c.Run(run)
-	return _c
-}
-
-// Show provides a mock function with given fields: object, options
-func (_m *MockGit) Show(object string, options ...git.Option) (*types.Log, error) {
+// WorktreeMove provides a mock function with given fields: path, newPath, options
+func (_m *MockGit) WorktreeMove(path string, newPath string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, object)
+	_ca = append(_ca, path, newPath)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Show")
-	}
-
-	var r0 *types.Log
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.Log, error)); ok {
-		return rf(object, options...)
-	}
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.Log); ok {
-		r0 = rf(object, options...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.Log)
-		}
+		panic("no return value specified for WorktreeMove")
 	}
 
-	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
-		r1 = rf(object, options...)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(path, newPath, options...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockGit_Show_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Show'
-type MockGit_Show_Call struct {
+// MockGit_WorktreeMove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeMove'
+type MockGit_WorktreeMove_Call struct {
 	*mock.Call
 }
 
-// Show is a helper method to define mock.On call
-//   - object string
+// WorktreeMove is a helper method to define mock.On call
+//   - path string
+//   - newPath string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Show(object interface{}, options ...interface{}) *MockGit_Show_Call {
-	return &MockGit_Show_Call{Call: _e.mock.On("Show",
-		append([]interface{}{object}, options...)...)}
+func (_e *MockGit_Expecter) WorktreeMove(path interface{}, newPath interface{}, options ...interface{}) *MockGit_WorktreeMove_Call {
+	return &MockGit_WorktreeMove_Call{Call: _e.mock.On("WorktreeMove",
+		append([]interface{}{path, newPath}, options...)...)}
 }
 
-func (_c *MockGit_Show_Call) Run(run func(object string, options ...git.Option)) *MockGit_Show_Call {
+func (_c *MockGit_WorktreeMove_Call) Run(run func(path string, newPath string, options ...git.Option)) *MockGit_WorktreeMove_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockGit_Show_Call) Return(_a0 *types.Log, _a1 error) *MockGit_Show_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockGit_WorktreeMove_Call) Return(_a0 error) *MockGit_WorktreeMove_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Show_Call) RunAndReturn(run func(string, ...git.Option) (*types.Log, error)) *MockGit_Show_Call {
+func (_c *MockGit_WorktreeMove_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockGit_WorktreeMove_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Status provides a mock function with given fields: options
-func (_m *MockGit) Status(options ...git.Option) ([]types.File, error) {
+// WorktreePrune provides a mock function with given fields: options
+func (_m *MockGit) WorktreePrune(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -2296,44 +6591,32 @@ func (_m *MockGit) Status(options ...git.Option) ([]types.File, error) {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Status")
+		panic("no return value specified for WorktreePrune")
 	}
 
-	var r0 []types.File
-	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.File, error)); ok {
-		return rf(options...)
-	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.File); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
 		r0 = rf(options...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.File)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockGit_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
-type MockGit_Status_Call struct {
+// MockGit_WorktreePrune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreePrune'
+type MockGit_WorktreePrune_Call struct {
 	*mock.Call
 }
 
-// Status is a helper method to define mock.On call
+// WorktreePrune is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Status(options ...interface{}) *MockGit_Status_Call {
-	return &MockGit_Status_Call{Call: _e.mock.On("Status",
+func (_e *MockGit_Expecter) WorktreePrune(options ...interface{}) *MockGit_WorktreePrune_Call {
+	return &MockGit_WorktreePrune_Call{Call: _e.mock.On("WorktreePrune",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockGit_Status_Call) Run(run func(options ...git.Option)) *MockGit_Status_Call {
+func (_c *MockGit_WorktreePrune_Call) Run(run func(options ...git.Option)) *MockGit_WorktreePrune_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -2346,34 +6629,34 @@ func (_c *MockGit_Status_Call) Run(run func(options ...git.Option)) *MockGit_Sta
 	return _c
 }
 
-func (_c *MockGit_Status_Call) Return(_a0 []types.File, _a1 error) *MockGit_Status_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockGit_WorktreePrune_Call) Return(_a0 error) *MockGit_WorktreePrune_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Status_Call) RunAndReturn(run func(...git.Option) ([]types.File, error)) *MockGit_Status_Call {
+func (_c *MockGit_WorktreePrune_Call) RunAndReturn(run func(...git.Option) error) *MockGit_WorktreePrune_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Tag provides a mock function with given fields: name, options
-func (_m *MockGit) Tag(name string, options ...git.Option) error {
+// WorktreeRemove provides a mock function with given fields: path, options
+func (_m *MockGit) WorktreeRemove(path string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, name)
+	_ca = append(_ca, path)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Tag")
+		panic("no return value specified for WorktreeRemove")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(name, options...)
+		r0 = rf(path, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2381,20 +6664,20 @@ func (_m *MockGit) Tag(name string, options ...git.Option) error {
 	return r0
 }
 
-// MockGit_Tag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tag'
-type MockGit_Tag_Call struct {
+// MockGit_WorktreeRemove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeRemove'
+type MockGit_WorktreeRemove_Call struct {
 	*mock.Call
 }
 
-// Tag is a helper method to define mock.On call
-//   - name string
+// WorktreeRemove is a helper method to define mock.On call
+//   - path string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) Tag(name interface{}, options ...interface{}) *MockGit_Tag_Call {
-	return &MockGit_Tag_Call{Call: _e.mock.On("Tag",
-		append([]interface{}{name}, options...)...)}
+func (_e *MockGit_Expecter) WorktreeRemove(path interface{}, options ...interface{}) *MockGit_WorktreeRemove_Call {
+	return &MockGit_WorktreeRemove_Call{Call: _e.mock.On("WorktreeRemove",
+		append([]interface{}{path}, options...)...)}
 }
 
-func (_c *MockGit_Tag_Call) Run(run func(name string, options ...git.Option)) *MockGit_Tag_Call {
+func (_c *MockGit_WorktreeRemove_Call) Run(run func(path string, options ...git.Option)) *MockGit_WorktreeRemove_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -2407,34 +6690,34 @@ func (_c *MockGit_Tag_Call) Run(run func(name string, options ...git.Option)) *M
 	return _c
 }
 
-func (_c *MockGit_Tag_Call) Return(_a0 error) *MockGit_Tag_Call {
+func (_c *MockGit_WorktreeRemove_Call) Return(_a0 error) *MockGit_WorktreeRemove_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_Tag_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_Tag_Call {
+func (_c *MockGit_WorktreeRemove_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_WorktreeRemove_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UnsetConfig provides a mock function with given fields: key, options
-func (_m *MockGit) UnsetConfig(key string, options ...git.Option) error {
+// WorktreeUnlock provides a mock function with given fields: path, options
+func (_m *MockGit) WorktreeUnlock(path string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, key)
+	_ca = append(_ca, path)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UnsetConfig")
+		panic("no return value specified for WorktreeUnlock")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(key, options...)
+		r0 = rf(path, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2442,20 +6725,20 @@ func (_m *MockGit) UnsetConfig(key string, options ...git.Option) error {
 	return r0
 }
 
-// MockGit_UnsetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetConfig'
-type MockGit_UnsetConfig_Call struct {
+// MockGit_WorktreeUnlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeUnlock'
+type MockGit_WorktreeUnlock_Call struct {
 	*mock.Call
 }
 
-// UnsetConfig is a helper method to define mock.On call
-//   - key string
+// WorktreeUnlock is a helper method to define mock.On call
+//   - path string
 //   - options ...git.Option
-func (_e *MockGit_Expecter) UnsetConfig(key interface{}, options ...interface{}) *MockGit_UnsetConfig_Call {
-	return &MockGit_UnsetConfig_Call{Call: _e.mock.On("UnsetConfig",
-		append([]interface{}{key}, options...)...)}
+func (_e *MockGit_Expecter) WorktreeUnlock(path interface{}, options ...interface{}) *MockGit_WorktreeUnlock_Call {
+	return &MockGit_WorktreeUnlock_Call{Call: _e.mock.On("WorktreeUnlock",
+		append([]interface{}{path}, options...)...)}
 }
 
-func (_c *MockGit_UnsetConfig_Call) Run(run func(key string, options ...git.Option)) *MockGit_UnsetConfig_Call {
+func (_c *MockGit_WorktreeUnlock_Call) Run(run func(path string, options ...git.Option)) *MockGit_WorktreeUnlock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -2468,12 +6751,12 @@ func (_c *MockGit_UnsetConfig_Call) Run(run func(key string, options ...git.Opti
 	return _c
 }
 
-func (_c *MockGit_UnsetConfig_Call) Return(_a0 error) *MockGit_UnsetConfig_Call {
+func (_c *MockGit_WorktreeUnlock_Call) Return(_a0 error) *MockGit_WorktreeUnlock_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockGit_UnsetConfig_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_UnsetConfig_Call {
+func (_c *MockGit_WorktreeUnlock_Call) RunAndReturn(run func(string, ...git.Option) error) *MockGit_WorktreeUnlock_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -6,7 +6,9 @@ import (
 	git "github.com/instruqt/git-exec/pkg/git"
 	mock "github.com/stretchr/testify/mock"
 
+	context "context"
 	types "github.com/instruqt/git-exec/pkg/git/types"
+	io "io"
 )
 
 // MockSession is an autogenerated mock type for the Session type
@@ -145,35 +147,97 @@ func (_c *MockSession_AddRemote_Call) RunAndReturn(run func(string, string, ...g
 	return _c
 }
 
-// Checkout provides a mock function with given fields: options
-func (_m *MockSession) Checkout(options ...git.Option) (*types.CheckoutResult, error) {
+// Archive provides a mock function with given fields: treeish, w, options
+func (_m *MockSession) Archive(treeish string, w io.Writer, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, treeish, w)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Checkout")
+		panic("no return value specified for Archive")
 	}
 
-	var r0 *types.CheckoutResult
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Writer, ...git.Option) error); ok {
+		r0 = rf(treeish, w, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_Archive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Archive'
+type MockSession_Archive_Call struct {
+	*mock.Call
+}
+
+// Archive is a helper method to define mock.On call
+//   - treeish string
+//   - w io.Writer
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Archive(treeish interface{}, w interface{}, options ...interface{}) *MockSession_Archive_Call {
+	return &MockSession_Archive_Call{Call: _e.mock.On("Archive",
+		append([]interface{}{treeish, w}, options...)...)}
+}
+
+func (_c *MockSession_Archive_Call) Run(run func(treeish string, w io.Writer, options ...git.Option)) *MockSession_Archive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(io.Writer), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Archive_Call) Return(_a0 error) *MockSession_Archive_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_Archive_Call) RunAndReturn(run func(string, io.Writer, ...git.Option) error) *MockSession_Archive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectBad provides a mock function with given fields: revs
+func (_m *MockSession) BisectBad(revs ...string) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(revs))
+	for _i := range revs {
+		_va[_i] = revs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectBad")
+	}
+
+	var r0 *types.BisectResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) (*types.CheckoutResult, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func(...string) (*types.BisectResult, error)); ok {
+		return rf(revs...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) *types.CheckoutResult); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(...string) *types.BisectResult); ok {
+		r0 = rf(revs...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.CheckoutResult)
+			r0 = ret.Get(0).(*types.BisectResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(revs...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -181,24 +245,24 @@ func (_m *MockSession) Checkout(options ...git.Option) (*types.CheckoutResult, e
 	return r0, r1
 }
 
-// MockSession_Checkout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Checkout'
-type MockSession_Checkout_Call struct {
+// MockSession_BisectBad_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectBad'
+type MockSession_BisectBad_Call struct {
 	*mock.Call
 }
 
-// Checkout is a helper method to define mock.On call
-//   - options ...git.Option
-func (_e *MockSession_Expecter) Checkout(options ...interface{}) *MockSession_Checkout_Call {
-	return &MockSession_Checkout_Call{Call: _e.mock.On("Checkout",
-		append([]interface{}{}, options...)...)}
+// BisectBad is a helper method to define mock.On call
+//   - revs ...string
+func (_e *MockSession_Expecter) BisectBad(revs ...interface{}) *MockSession_BisectBad_Call {
+	return &MockSession_BisectBad_Call{Call: _e.mock.On("BisectBad",
+		append([]interface{}{}, revs...)...)}
 }
 
-func (_c *MockSession_Checkout_Call) Run(run func(options ...git.Option)) *MockSession_Checkout_Call {
+func (_c *MockSession_BisectBad_Call) Run(run func(revs ...string)) *MockSession_BisectBad_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
+		variadicArgs := make([]string, len(args)-0)
 		for i, a := range args[0:] {
 			if a != nil {
-				variadicArgs[i] = a.(git.Option)
+				variadicArgs[i] = a.(string)
 			}
 		}
 		run(variadicArgs...)
@@ -206,117 +270,297 @@ func (_c *MockSession_Checkout_Call) Run(run func(options ...git.Option)) *MockS
 	return _c
 }
 
-func (_c *MockSession_Checkout_Call) Return(_a0 *types.CheckoutResult, _a1 error) *MockSession_Checkout_Call {
+func (_c *MockSession_BisectBad_Call) Return(_a0 *types.BisectResult, _a1 error) *MockSession_BisectBad_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_Checkout_Call) RunAndReturn(run func(...git.Option) (*types.CheckoutResult, error)) *MockSession_Checkout_Call {
+func (_c *MockSession_BisectBad_Call) RunAndReturn(run func(...string) (*types.BisectResult, error)) *MockSession_BisectBad_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Clone provides a mock function with given fields: url, destination, options
-func (_m *MockSession) Clone(url string, destination string, options ...git.Option) error {
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
+// BisectGood provides a mock function with given fields: revs
+func (_m *MockSession) BisectGood(revs ...string) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(revs))
+	for _i := range revs {
+		_va[_i] = revs[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, url, destination)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Clone")
+		panic("no return value specified for BisectGood")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(url, destination, options...)
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (*types.BisectResult, error)); ok {
+		return rf(revs...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) *types.BisectResult); ok {
+		r0 = rf(revs...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(revs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockSession_Clone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clone'
-type MockSession_Clone_Call struct {
+// MockSession_BisectGood_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectGood'
+type MockSession_BisectGood_Call struct {
 	*mock.Call
 }
 
-// Clone is a helper method to define mock.On call
-//   - url string
-//   - destination string
-//   - options ...git.Option
-func (_e *MockSession_Expecter) Clone(url interface{}, destination interface{}, options ...interface{}) *MockSession_Clone_Call {
-	return &MockSession_Clone_Call{Call: _e.mock.On("Clone",
-		append([]interface{}{url, destination}, options...)...)}
+// BisectGood is a helper method to define mock.On call
+//   - revs ...string
+func (_e *MockSession_Expecter) BisectGood(revs ...interface{}) *MockSession_BisectGood_Call {
+	return &MockSession_BisectGood_Call{Call: _e.mock.On("BisectGood",
+		append([]interface{}{}, revs...)...)}
 }
 
-func (_c *MockSession_Clone_Call) Run(run func(url string, destination string, options ...git.Option)) *MockSession_Clone_Call {
+func (_c *MockSession_BisectGood_Call) Run(run func(revs ...string)) *MockSession_BisectGood_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-2)
-		for i, a := range args[2:] {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
 			if a != nil {
-				variadicArgs[i] = a.(git.Option)
+				variadicArgs[i] = a.(string)
 			}
 		}
-		run(args[0].(string), args[1].(string), variadicArgs...)
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Clone_Call) Return(_a0 error) *MockSession_Clone_Call {
+func (_c *MockSession_BisectGood_Call) Return(_a0 *types.BisectResult, _a1 error) *MockSession_BisectGood_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BisectGood_Call) RunAndReturn(run func(...string) (*types.BisectResult, error)) *MockSession_BisectGood_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectLog provides a mock function with no fields
+func (_m *MockSession) BisectLog() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectLog")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BisectLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectLog'
+type MockSession_BisectLog_Call struct {
+	*mock.Call
+}
+
+// BisectLog is a helper method to define mock.On call
+func (_e *MockSession_Expecter) BisectLog() *MockSession_BisectLog_Call {
+	return &MockSession_BisectLog_Call{Call: _e.mock.On("BisectLog")}
+}
+
+func (_c *MockSession_BisectLog_Call) Run(run func()) *MockSession_BisectLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_BisectLog_Call) Return(_a0 string, _a1 error) *MockSession_BisectLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BisectLog_Call) RunAndReturn(run func() (string, error)) *MockSession_BisectLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectReplay provides a mock function with given fields: logFile
+func (_m *MockSession) BisectReplay(logFile string) (*types.BisectResult, error) {
+	ret := _m.Called(logFile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectReplay")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*types.BisectResult, error)); ok {
+		return rf(logFile)
+	}
+	if rf, ok := ret.Get(0).(func(string) *types.BisectResult); ok {
+		r0 = rf(logFile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(logFile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BisectReplay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectReplay'
+type MockSession_BisectReplay_Call struct {
+	*mock.Call
+}
+
+// BisectReplay is a helper method to define mock.On call
+//   - logFile string
+func (_e *MockSession_Expecter) BisectReplay(logFile interface{}) *MockSession_BisectReplay_Call {
+	return &MockSession_BisectReplay_Call{Call: _e.mock.On("BisectReplay", logFile)}
+}
+
+func (_c *MockSession_BisectReplay_Call) Run(run func(logFile string)) *MockSession_BisectReplay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockSession_BisectReplay_Call) Return(_a0 *types.BisectResult, _a1 error) *MockSession_BisectReplay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BisectReplay_Call) RunAndReturn(run func(string) (*types.BisectResult, error)) *MockSession_BisectReplay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectReset provides a mock function with given fields: commit
+func (_m *MockSession) BisectReset(commit string) error {
+	ret := _m.Called(commit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectReset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(commit)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_BisectReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectReset'
+type MockSession_BisectReset_Call struct {
+	*mock.Call
+}
+
+// BisectReset is a helper method to define mock.On call
+//   - commit string
+func (_e *MockSession_Expecter) BisectReset(commit interface{}) *MockSession_BisectReset_Call {
+	return &MockSession_BisectReset_Call{Call: _e.mock.On("BisectReset", commit)}
+}
+
+func (_c *MockSession_BisectReset_Call) Run(run func(commit string)) *MockSession_BisectReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockSession_BisectReset_Call) Return(_a0 error) *MockSession_BisectReset_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Clone_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_Clone_Call {
+func (_c *MockSession_BisectReset_Call) RunAndReturn(run func(string) error) *MockSession_BisectReset_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Commit provides a mock function with given fields: message, options
-func (_m *MockSession) Commit(message string, options ...git.Option) error {
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
+// BisectRun provides a mock function with given fields: command, opts
+func (_m *MockSession) BisectRun(command string, opts ...git.Option) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, message)
+	_ca = append(_ca, command)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Commit")
+		panic("no return value specified for BisectRun")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(message, options...)
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.BisectResult, error)); ok {
+		return rf(command, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.BisectResult); ok {
+		r0 = rf(command, opts...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(command, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockSession_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
-type MockSession_Commit_Call struct {
+// MockSession_BisectRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectRun'
+type MockSession_BisectRun_Call struct {
 	*mock.Call
 }
 
-// Commit is a helper method to define mock.On call
-//   - message string
-//   - options ...git.Option
-func (_e *MockSession_Expecter) Commit(message interface{}, options ...interface{}) *MockSession_Commit_Call {
-	return &MockSession_Commit_Call{Call: _e.mock.On("Commit",
-		append([]interface{}{message}, options...)...)}
+// BisectRun is a helper method to define mock.On call
+//   - command string
+//   - opts ...git.Option
+func (_e *MockSession_Expecter) BisectRun(command interface{}, opts ...interface{}) *MockSession_BisectRun_Call {
+	return &MockSession_BisectRun_Call{Call: _e.mock.On("BisectRun",
+		append([]interface{}{command}, opts...)...)}
 }
 
-func (_c *MockSession_Commit_Call) Run(run func(message string, options ...git.Option)) *MockSession_Commit_Call {
+func (_c *MockSession_BisectRun_Call) Run(run func(command string, opts ...git.Option)) *MockSession_BisectRun_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -329,95 +573,4138 @@ func (_c *MockSession_Commit_Call) Run(run func(message string, options ...git.O
 	return _c
 }
 
-func (_c *MockSession_Commit_Call) Return(_a0 error) *MockSession_Commit_Call {
+func (_c *MockSession_BisectRun_Call) Return(_a0 *types.BisectResult, _a1 error) *MockSession_BisectRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BisectRun_Call) RunAndReturn(run func(string, ...git.Option) (*types.BisectResult, error)) *MockSession_BisectRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectRunFunc provides a mock function with given fields: test
+func (_m *MockSession) BisectRunFunc(test func(string) (types.BisectVerdict, error)) (*types.BisectResult, error) {
+	ret := _m.Called(test)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectRunFunc")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(func(string) (types.BisectVerdict, error)) (*types.BisectResult, error)); ok {
+		return rf(test)
+	}
+	if rf, ok := ret.Get(0).(func(func(string) (types.BisectVerdict, error)) *types.BisectResult); ok {
+		r0 = rf(test)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(func(string) (types.BisectVerdict, error)) error); ok {
+		r1 = rf(test)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BisectRunFunc_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectRunFunc'
+type MockSession_BisectRunFunc_Call struct {
+	*mock.Call
+}
+
+// BisectRunFunc is a helper method to define mock.On call
+//   - test func(string) (types.BisectVerdict, error)
+func (_e *MockSession_Expecter) BisectRunFunc(test interface{}) *MockSession_BisectRunFunc_Call {
+	return &MockSession_BisectRunFunc_Call{Call: _e.mock.On("BisectRunFunc", test)}
+}
+
+func (_c *MockSession_BisectRunFunc_Call) Run(run func(test func(string) (types.BisectVerdict, error))) *MockSession_BisectRunFunc_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(string) (types.BisectVerdict, error)))
+	})
+	return _c
+}
+
+func (_c *MockSession_BisectRunFunc_Call) Return(_a0 *types.BisectResult, _a1 error) *MockSession_BisectRunFunc_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BisectRunFunc_Call) RunAndReturn(run func(func(string) (types.BisectVerdict, error)) (*types.BisectResult, error)) *MockSession_BisectRunFunc_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectSkip provides a mock function with given fields: revs
+func (_m *MockSession) BisectSkip(revs ...string) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(revs))
+	for _i := range revs {
+		_va[_i] = revs[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectSkip")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...string) (*types.BisectResult, error)); ok {
+		return rf(revs...)
+	}
+	if rf, ok := ret.Get(0).(func(...string) *types.BisectResult); ok {
+		r0 = rf(revs...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...string) error); ok {
+		r1 = rf(revs...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BisectSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectSkip'
+type MockSession_BisectSkip_Call struct {
+	*mock.Call
+}
+
+// BisectSkip is a helper method to define mock.On call
+//   - revs ...string
+func (_e *MockSession_Expecter) BisectSkip(revs ...interface{}) *MockSession_BisectSkip_Call {
+	return &MockSession_BisectSkip_Call{Call: _e.mock.On("BisectSkip",
+		append([]interface{}{}, revs...)...)}
+}
+
+func (_c *MockSession_BisectSkip_Call) Run(run func(revs ...string)) *MockSession_BisectSkip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_BisectSkip_Call) Return(_a0 *types.BisectResult, _a1 error) *MockSession_BisectSkip_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BisectSkip_Call) RunAndReturn(run func(...string) (*types.BisectResult, error)) *MockSession_BisectSkip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectStart provides a mock function with given fields: bad, good, options
+func (_m *MockSession) BisectStart(bad string, good []string, options ...git.Option) (*types.BisectResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, bad, good)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectStart")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string, ...git.Option) (*types.BisectResult, error)); ok {
+		return rf(bad, good, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string, ...git.Option) *types.BisectResult); ok {
+		r0 = rf(bad, good, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string, ...git.Option) error); ok {
+		r1 = rf(bad, good, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BisectStart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectStart'
+type MockSession_BisectStart_Call struct {
+	*mock.Call
+}
+
+// BisectStart is a helper method to define mock.On call
+//   - bad string
+//   - good []string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) BisectStart(bad interface{}, good interface{}, options ...interface{}) *MockSession_BisectStart_Call {
+	return &MockSession_BisectStart_Call{Call: _e.mock.On("BisectStart",
+		append([]interface{}{bad, good}, options...)...)}
+}
+
+func (_c *MockSession_BisectStart_Call) Run(run func(bad string, good []string, options ...git.Option)) *MockSession_BisectStart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_BisectStart_Call) Return(_a0 *types.BisectResult, _a1 error) *MockSession_BisectStart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BisectStart_Call) RunAndReturn(run func(string, []string, ...git.Option) (*types.BisectResult, error)) *MockSession_BisectStart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BisectStatus provides a mock function with no fields
+func (_m *MockSession) BisectStatus() (*types.BisectResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for BisectStatus")
+	}
+
+	var r0 *types.BisectResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.BisectResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.BisectResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BisectResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BisectStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BisectStatus'
+type MockSession_BisectStatus_Call struct {
+	*mock.Call
+}
+
+// BisectStatus is a helper method to define mock.On call
+func (_e *MockSession_Expecter) BisectStatus() *MockSession_BisectStatus_Call {
+	return &MockSession_BisectStatus_Call{Call: _e.mock.On("BisectStatus")}
+}
+
+func (_c *MockSession_BisectStatus_Call) Run(run func()) *MockSession_BisectStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_BisectStatus_Call) Return(_a0 *types.BisectResult, _a1 error) *MockSession_BisectStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BisectStatus_Call) RunAndReturn(run func() (*types.BisectResult, error)) *MockSession_BisectStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Blame provides a mock function with given fields: path, options
+func (_m *MockSession) Blame(path string, options ...git.Option) ([]types.BlameLine, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, path)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Blame")
+	}
+
+	var r0 []types.BlameLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.BlameLine, error)); ok {
+		return rf(path, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.BlameLine); ok {
+		r0 = rf(path, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.BlameLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(path, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Blame_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Blame'
+type MockSession_Blame_Call struct {
+	*mock.Call
+}
+
+// Blame is a helper method to define mock.On call
+//   - path string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Blame(path interface{}, options ...interface{}) *MockSession_Blame_Call {
+	return &MockSession_Blame_Call{Call: _e.mock.On("Blame",
+		append([]interface{}{path}, options...)...)}
+}
+
+func (_c *MockSession_Blame_Call) Run(run func(path string, options ...git.Option)) *MockSession_Blame_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Blame_Call) Return(_a0 []types.BlameLine, _a1 error) *MockSession_Blame_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Blame_Call) RunAndReturn(run func(string, ...git.Option) ([]types.BlameLine, error)) *MockSession_Blame_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleCreate provides a mock function with given fields: file, revs, options
+func (_m *MockSession) BundleCreate(file string, revs []string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file, revs)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleCreate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []string, ...git.Option) error); ok {
+		r0 = rf(file, revs, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_BundleCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleCreate'
+type MockSession_BundleCreate_Call struct {
+	*mock.Call
+}
+
+// BundleCreate is a helper method to define mock.On call
+//   - file string
+//   - revs []string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) BundleCreate(file interface{}, revs interface{}, options ...interface{}) *MockSession_BundleCreate_Call {
+	return &MockSession_BundleCreate_Call{Call: _e.mock.On("BundleCreate",
+		append([]interface{}{file, revs}, options...)...)}
+}
+
+func (_c *MockSession_BundleCreate_Call) Run(run func(file string, revs []string, options ...git.Option)) *MockSession_BundleCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_BundleCreate_Call) Return(_a0 error) *MockSession_BundleCreate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_BundleCreate_Call) RunAndReturn(run func(string, []string, ...git.Option) error) *MockSession_BundleCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleCreateTo provides a mock function with given fields: w, revs, options
+func (_m *MockSession) BundleCreateTo(w io.Writer, revs []string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, w, revs)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleCreateTo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer, []string, ...git.Option) error); ok {
+		r0 = rf(w, revs, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_BundleCreateTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleCreateTo'
+type MockSession_BundleCreateTo_Call struct {
+	*mock.Call
+}
+
+// BundleCreateTo is a helper method to define mock.On call
+//   - w io.Writer
+//   - revs []string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) BundleCreateTo(w interface{}, revs interface{}, options ...interface{}) *MockSession_BundleCreateTo_Call {
+	return &MockSession_BundleCreateTo_Call{Call: _e.mock.On("BundleCreateTo",
+		append([]interface{}{w, revs}, options...)...)}
+}
+
+func (_c *MockSession_BundleCreateTo_Call) Run(run func(w io.Writer, revs []string, options ...git.Option)) *MockSession_BundleCreateTo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(io.Writer), args[1].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_BundleCreateTo_Call) Return(_a0 error) *MockSession_BundleCreateTo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_BundleCreateTo_Call) RunAndReturn(run func(io.Writer, []string, ...git.Option) error) *MockSession_BundleCreateTo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleListHeads provides a mock function with given fields: file, options
+func (_m *MockSession) BundleListHeads(file string, options ...git.Option) ([]types.BundleRef, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleListHeads")
+	}
+
+	var r0 []types.BundleRef
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.BundleRef, error)); ok {
+		return rf(file, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.BundleRef); ok {
+		r0 = rf(file, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.BundleRef)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(file, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BundleListHeads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleListHeads'
+type MockSession_BundleListHeads_Call struct {
+	*mock.Call
+}
+
+// BundleListHeads is a helper method to define mock.On call
+//   - file string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) BundleListHeads(file interface{}, options ...interface{}) *MockSession_BundleListHeads_Call {
+	return &MockSession_BundleListHeads_Call{Call: _e.mock.On("BundleListHeads",
+		append([]interface{}{file}, options...)...)}
+}
+
+func (_c *MockSession_BundleListHeads_Call) Run(run func(file string, options ...git.Option)) *MockSession_BundleListHeads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_BundleListHeads_Call) Return(_a0 []types.BundleRef, _a1 error) *MockSession_BundleListHeads_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BundleListHeads_Call) RunAndReturn(run func(string, ...git.Option) ([]types.BundleRef, error)) *MockSession_BundleListHeads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleUnbundle provides a mock function with given fields: file, options
+func (_m *MockSession) BundleUnbundle(file string, options ...git.Option) ([]types.BundleRef, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleUnbundle")
+	}
+
+	var r0 []types.BundleRef
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.BundleRef, error)); ok {
+		return rf(file, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.BundleRef); ok {
+		r0 = rf(file, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.BundleRef)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(file, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BundleUnbundle_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleUnbundle'
+type MockSession_BundleUnbundle_Call struct {
+	*mock.Call
+}
+
+// BundleUnbundle is a helper method to define mock.On call
+//   - file string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) BundleUnbundle(file interface{}, options ...interface{}) *MockSession_BundleUnbundle_Call {
+	return &MockSession_BundleUnbundle_Call{Call: _e.mock.On("BundleUnbundle",
+		append([]interface{}{file}, options...)...)}
+}
+
+func (_c *MockSession_BundleUnbundle_Call) Run(run func(file string, options ...git.Option)) *MockSession_BundleUnbundle_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_BundleUnbundle_Call) Return(_a0 []types.BundleRef, _a1 error) *MockSession_BundleUnbundle_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BundleUnbundle_Call) RunAndReturn(run func(string, ...git.Option) ([]types.BundleRef, error)) *MockSession_BundleUnbundle_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BundleVerify provides a mock function with given fields: file, options
+func (_m *MockSession) BundleVerify(file string, options ...git.Option) (*types.BundleInfo, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, file)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BundleVerify")
+	}
+
+	var r0 *types.BundleInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.BundleInfo, error)); ok {
+		return rf(file, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.BundleInfo); ok {
+		r0 = rf(file, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.BundleInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(file, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_BundleVerify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BundleVerify'
+type MockSession_BundleVerify_Call struct {
+	*mock.Call
+}
+
+// BundleVerify is a helper method to define mock.On call
+//   - file string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) BundleVerify(file interface{}, options ...interface{}) *MockSession_BundleVerify_Call {
+	return &MockSession_BundleVerify_Call{Call: _e.mock.On("BundleVerify",
+		append([]interface{}{file}, options...)...)}
+}
+
+func (_c *MockSession_BundleVerify_Call) Run(run func(file string, options ...git.Option)) *MockSession_BundleVerify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_BundleVerify_Call) Return(_a0 *types.BundleInfo, _a1 error) *MockSession_BundleVerify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_BundleVerify_Call) RunAndReturn(run func(string, ...git.Option) (*types.BundleInfo, error)) *MockSession_BundleVerify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Checkout provides a mock function with given fields: options
+func (_m *MockSession) Checkout(options ...git.Option) (*types.CheckoutResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Checkout")
+	}
+
+	var r0 *types.CheckoutResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.CheckoutResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.CheckoutResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CheckoutResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Checkout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Checkout'
+type MockSession_Checkout_Call struct {
+	*mock.Call
+}
+
+// Checkout is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Checkout(options ...interface{}) *MockSession_Checkout_Call {
+	return &MockSession_Checkout_Call{Call: _e.mock.On("Checkout",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Checkout_Call) Run(run func(options ...git.Option)) *MockSession_Checkout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Checkout_Call) Return(_a0 *types.CheckoutResult, _a1 error) *MockSession_Checkout_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Checkout_Call) RunAndReturn(run func(...git.Option) (*types.CheckoutResult, error)) *MockSession_Checkout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPick provides a mock function with given fields: commits, options
+func (_m *MockSession) CherryPick(commits []string, options ...git.Option) (*types.SequencerResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, commits)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPick")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) (*types.SequencerResult, error)); ok {
+		return rf(commits, options...)
+	}
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) *types.SequencerResult); ok {
+		r0 = rf(commits, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, ...git.Option) error); ok {
+		r1 = rf(commits, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_CherryPick_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPick'
+type MockSession_CherryPick_Call struct {
+	*mock.Call
+}
+
+// CherryPick is a helper method to define mock.On call
+//   - commits []string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) CherryPick(commits interface{}, options ...interface{}) *MockSession_CherryPick_Call {
+	return &MockSession_CherryPick_Call{Call: _e.mock.On("CherryPick",
+		append([]interface{}{commits}, options...)...)}
+}
+
+func (_c *MockSession_CherryPick_Call) Run(run func(commits []string, options ...git.Option)) *MockSession_CherryPick_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_CherryPick_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockSession_CherryPick_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_CherryPick_Call) RunAndReturn(run func([]string, ...git.Option) (*types.SequencerResult, error)) *MockSession_CherryPick_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPickAbort provides a mock function with no fields
+func (_m *MockSession) CherryPickAbort() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPickAbort")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_CherryPickAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPickAbort'
+type MockSession_CherryPickAbort_Call struct {
+	*mock.Call
+}
+
+// CherryPickAbort is a helper method to define mock.On call
+func (_e *MockSession_Expecter) CherryPickAbort() *MockSession_CherryPickAbort_Call {
+	return &MockSession_CherryPickAbort_Call{Call: _e.mock.On("CherryPickAbort")}
+}
+
+func (_c *MockSession_CherryPickAbort_Call) Run(run func()) *MockSession_CherryPickAbort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_CherryPickAbort_Call) Return(_a0 error) *MockSession_CherryPickAbort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_CherryPickAbort_Call) RunAndReturn(run func() error) *MockSession_CherryPickAbort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPickContinue provides a mock function with no fields
+func (_m *MockSession) CherryPickContinue() (*types.SequencerResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPickContinue")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.SequencerResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.SequencerResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_CherryPickContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPickContinue'
+type MockSession_CherryPickContinue_Call struct {
+	*mock.Call
+}
+
+// CherryPickContinue is a helper method to define mock.On call
+func (_e *MockSession_Expecter) CherryPickContinue() *MockSession_CherryPickContinue_Call {
+	return &MockSession_CherryPickContinue_Call{Call: _e.mock.On("CherryPickContinue")}
+}
+
+func (_c *MockSession_CherryPickContinue_Call) Run(run func()) *MockSession_CherryPickContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_CherryPickContinue_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockSession_CherryPickContinue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_CherryPickContinue_Call) RunAndReturn(run func() (*types.SequencerResult, error)) *MockSession_CherryPickContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPickQuit provides a mock function with no fields
+func (_m *MockSession) CherryPickQuit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPickQuit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_CherryPickQuit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPickQuit'
+type MockSession_CherryPickQuit_Call struct {
+	*mock.Call
+}
+
+// CherryPickQuit is a helper method to define mock.On call
+func (_e *MockSession_Expecter) CherryPickQuit() *MockSession_CherryPickQuit_Call {
+	return &MockSession_CherryPickQuit_Call{Call: _e.mock.On("CherryPickQuit")}
+}
+
+func (_c *MockSession_CherryPickQuit_Call) Run(run func()) *MockSession_CherryPickQuit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_CherryPickQuit_Call) Return(_a0 error) *MockSession_CherryPickQuit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_CherryPickQuit_Call) RunAndReturn(run func() error) *MockSession_CherryPickQuit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CherryPickSkip provides a mock function with no fields
+func (_m *MockSession) CherryPickSkip() (*types.SequencerResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CherryPickSkip")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.SequencerResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.SequencerResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_CherryPickSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CherryPickSkip'
+type MockSession_CherryPickSkip_Call struct {
+	*mock.Call
+}
+
+// CherryPickSkip is a helper method to define mock.On call
+func (_e *MockSession_Expecter) CherryPickSkip() *MockSession_CherryPickSkip_Call {
+	return &MockSession_CherryPickSkip_Call{Call: _e.mock.On("CherryPickSkip")}
+}
+
+func (_c *MockSession_CherryPickSkip_Call) Run(run func()) *MockSession_CherryPickSkip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_CherryPickSkip_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockSession_CherryPickSkip_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_CherryPickSkip_Call) RunAndReturn(run func() (*types.SequencerResult, error)) *MockSession_CherryPickSkip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Clone provides a mock function with given fields: url, destination, options
+func (_m *MockSession) Clone(url string, destination string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, url, destination)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Clone")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(url, destination, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_Clone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clone'
+type MockSession_Clone_Call struct {
+	*mock.Call
+}
+
+// Clone is a helper method to define mock.On call
+//   - url string
+//   - destination string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Clone(url interface{}, destination interface{}, options ...interface{}) *MockSession_Clone_Call {
+	return &MockSession_Clone_Call{Call: _e.mock.On("Clone",
+		append([]interface{}{url, destination}, options...)...)}
+}
+
+func (_c *MockSession_Clone_Call) Run(run func(url string, destination string, options ...git.Option)) *MockSession_Clone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Clone_Call) Return(_a0 error) *MockSession_Clone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_Clone_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_Clone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Commit provides a mock function with given fields: message, options
+func (_m *MockSession) Commit(message string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, message)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(message, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type MockSession_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - message string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Commit(message interface{}, options ...interface{}) *MockSession_Commit_Call {
+	return &MockSession_Commit_Call{Call: _e.mock.On("Commit",
+		append([]interface{}{message}, options...)...)}
+}
+
+func (_c *MockSession_Commit_Call) Run(run func(message string, options ...git.Option)) *MockSession_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Commit_Call) Return(_a0 error) *MockSession_Commit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_Commit_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Conflicts provides a mock function with given fields: options
+func (_m *MockSession) Conflicts(options ...git.Option) ([]types.ConflictFile, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Conflicts")
+	}
+
+	var r0 []types.ConflictFile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.ConflictFile, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.ConflictFile); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ConflictFile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Conflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Conflicts'
+type MockSession_Conflicts_Call struct {
+	*mock.Call
+}
+
+// Conflicts is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Conflicts(options ...interface{}) *MockSession_Conflicts_Call {
+	return &MockSession_Conflicts_Call{Call: _e.mock.On("Conflicts",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Conflicts_Call) Run(run func(options ...git.Option)) *MockSession_Conflicts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Conflicts_Call) Return(_a0 []types.ConflictFile, _a1 error) *MockSession_Conflicts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Conflicts_Call) RunAndReturn(run func(...git.Option) ([]types.ConflictFile, error)) *MockSession_Conflicts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyBranch provides a mock function with given fields: source, destination, options
+func (_m *MockSession) CopyBranch(source string, destination string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, source, destination)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(source, destination, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_CopyBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyBranch'
+type MockSession_CopyBranch_Call struct {
+	*mock.Call
+}
+
+// CopyBranch is a helper method to define mock.On call
+//   - source string
+//   - destination string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) CopyBranch(source interface{}, destination interface{}, options ...interface{}) *MockSession_CopyBranch_Call {
+	return &MockSession_CopyBranch_Call{Call: _e.mock.On("CopyBranch",
+		append([]interface{}{source, destination}, options...)...)}
+}
+
+func (_c *MockSession_CopyBranch_Call) Run(run func(source string, destination string, options ...git.Option)) *MockSession_CopyBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_CopyBranch_Call) Return(_a0 error) *MockSession_CopyBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_CopyBranch_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_CopyBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBranch provides a mock function with given fields: branch, options
+func (_m *MockSession) CreateBranch(branch string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, branch)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(branch, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_CreateBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBranch'
+type MockSession_CreateBranch_Call struct {
+	*mock.Call
+}
+
+// CreateBranch is a helper method to define mock.On call
+//   - branch string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) CreateBranch(branch interface{}, options ...interface{}) *MockSession_CreateBranch_Call {
+	return &MockSession_CreateBranch_Call{Call: _e.mock.On("CreateBranch",
+		append([]interface{}{branch}, options...)...)}
+}
+
+func (_c *MockSession_CreateBranch_Call) Run(run func(branch string, options ...git.Option)) *MockSession_CreateBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_CreateBranch_Call) Return(_a0 error) *MockSession_CreateBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_CreateBranch_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_CreateBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBranch provides a mock function with given fields: branch, options
+func (_m *MockSession) DeleteBranch(branch string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, branch)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(branch, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
+type MockSession_DeleteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteBranch is a helper method to define mock.On call
+//   - branch string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) DeleteBranch(branch interface{}, options ...interface{}) *MockSession_DeleteBranch_Call {
+	return &MockSession_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch",
+		append([]interface{}{branch}, options...)...)}
+}
+
+func (_c *MockSession_DeleteBranch_Call) Run(run func(branch string, options ...git.Option)) *MockSession_DeleteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_DeleteBranch_Call) Return(_a0 error) *MockSession_DeleteBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_DeleteBranch_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_DeleteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRemoteBranch provides a mock function with given fields: remote, branch, options
+func (_m *MockSession) DeleteRemoteBranch(remote string, branch string, options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, remote, branch)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRemoteBranch")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) ([]types.Remote, error)); ok {
+		return rf(remote, branch, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) []types.Remote); ok {
+		r0 = rf(remote, branch, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...git.Option) error); ok {
+		r1 = rf(remote, branch, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_DeleteRemoteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRemoteBranch'
+type MockSession_DeleteRemoteBranch_Call struct {
+	*mock.Call
+}
+
+// DeleteRemoteBranch is a helper method to define mock.On call
+//   - remote string
+//   - branch string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) DeleteRemoteBranch(remote interface{}, branch interface{}, options ...interface{}) *MockSession_DeleteRemoteBranch_Call {
+	return &MockSession_DeleteRemoteBranch_Call{Call: _e.mock.On("DeleteRemoteBranch",
+		append([]interface{}{remote, branch}, options...)...)}
+}
+
+func (_c *MockSession_DeleteRemoteBranch_Call) Run(run func(remote string, branch string, options ...git.Option)) *MockSession_DeleteRemoteBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_DeleteRemoteBranch_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_DeleteRemoteBranch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_DeleteRemoteBranch_Call) RunAndReturn(run func(string, string, ...git.Option) ([]types.Remote, error)) *MockSession_DeleteRemoteBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRemoteTag provides a mock function with given fields: remote, tagName, options
+func (_m *MockSession) DeleteRemoteTag(remote string, tagName string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, remote, tagName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRemoteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(remote, tagName, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_DeleteRemoteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRemoteTag'
+type MockSession_DeleteRemoteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteRemoteTag is a helper method to define mock.On call
+//   - remote string
+//   - tagName string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) DeleteRemoteTag(remote interface{}, tagName interface{}, options ...interface{}) *MockSession_DeleteRemoteTag_Call {
+	return &MockSession_DeleteRemoteTag_Call{Call: _e.mock.On("DeleteRemoteTag",
+		append([]interface{}{remote, tagName}, options...)...)}
+}
+
+func (_c *MockSession_DeleteRemoteTag_Call) Run(run func(remote string, tagName string, options ...git.Option)) *MockSession_DeleteRemoteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_DeleteRemoteTag_Call) Return(_a0 error) *MockSession_DeleteRemoteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_DeleteRemoteTag_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_DeleteRemoteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTag provides a mock function with given fields: name, options
+func (_m *MockSession) DeleteTag(name string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(name, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
+type MockSession_DeleteTag_Call struct {
+	*mock.Call
+}
+
+// DeleteTag is a helper method to define mock.On call
+//   - name string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) DeleteTag(name interface{}, options ...interface{}) *MockSession_DeleteTag_Call {
+	return &MockSession_DeleteTag_Call{Call: _e.mock.On("DeleteTag",
+		append([]interface{}{name}, options...)...)}
+}
+
+func (_c *MockSession_DeleteTag_Call) Run(run func(name string, options ...git.Option)) *MockSession_DeleteTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_DeleteTag_Call) Return(_a0 error) *MockSession_DeleteTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_DeleteTag_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_DeleteTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Destroy provides a mock function with no fields
+func (_m *MockSession) Destroy() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Destroy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_Destroy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Destroy'
+type MockSession_Destroy_Call struct {
+	*mock.Call
+}
+
+// Destroy is a helper method to define mock.On call
+func (_e *MockSession_Expecter) Destroy() *MockSession_Destroy_Call {
+	return &MockSession_Destroy_Call{Call: _e.mock.On("Destroy")}
+}
+
+func (_c *MockSession_Destroy_Call) Run(run func()) *MockSession_Destroy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_Destroy_Call) Return(_a0 error) *MockSession_Destroy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_Destroy_Call) RunAndReturn(run func() error) *MockSession_Destroy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DetailedStatus provides a mock function with given fields: options
+func (_m *MockSession) DetailedStatus(options ...git.Option) (*types.StatusResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DetailedStatus")
+	}
+
+	var r0 *types.StatusResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.StatusResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.StatusResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.StatusResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_DetailedStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DetailedStatus'
+type MockSession_DetailedStatus_Call struct {
+	*mock.Call
+}
+
+// DetailedStatus is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) DetailedStatus(options ...interface{}) *MockSession_DetailedStatus_Call {
+	return &MockSession_DetailedStatus_Call{Call: _e.mock.On("DetailedStatus",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_DetailedStatus_Call) Run(run func(options ...git.Option)) *MockSession_DetailedStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_DetailedStatus_Call) Return(_a0 *types.StatusResult, _a1 error) *MockSession_DetailedStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_DetailedStatus_Call) RunAndReturn(run func(...git.Option) (*types.StatusResult, error)) *MockSession_DetailedStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Diff provides a mock function with given fields: options
+func (_m *MockSession) Diff(options ...git.Option) ([]types.Diff, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Diff")
+	}
+
+	var r0 []types.Diff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Diff, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Diff); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Diff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type MockSession_Diff_Call struct {
+	*mock.Call
+}
+
+// Diff is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Diff(options ...interface{}) *MockSession_Diff_Call {
+	return &MockSession_Diff_Call{Call: _e.mock.On("Diff",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Diff_Call) Run(run func(options ...git.Option)) *MockSession_Diff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Diff_Call) Return(_a0 []types.Diff, _a1 error) *MockSession_Diff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Diff_Call) RunAndReturn(run func(...git.Option) ([]types.Diff, error)) *MockSession_Diff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fetch provides a mock function with given fields: options
+func (_m *MockSession) Fetch(options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fetch")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Fetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fetch'
+type MockSession_Fetch_Call struct {
+	*mock.Call
+}
+
+// Fetch is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Fetch(options ...interface{}) *MockSession_Fetch_Call {
+	return &MockSession_Fetch_Call{Call: _e.mock.On("Fetch",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Fetch_Call) Run(run func(options ...git.Option)) *MockSession_Fetch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Fetch_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_Fetch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Fetch_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockSession_Fetch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: key, options
+func (_m *MockSession) GetConfig(key string, options ...git.Option) (string, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, key)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (string, error)); ok {
+		return rf(key, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) string); ok {
+		r0 = rf(key, options...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(key, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type MockSession_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - key string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) GetConfig(key interface{}, options ...interface{}) *MockSession_GetConfig_Call {
+	return &MockSession_GetConfig_Call{Call: _e.mock.On("GetConfig",
+		append([]interface{}{key}, options...)...)}
+}
+
+func (_c *MockSession_GetConfig_Call) Run(run func(key string, options ...git.Option)) *MockSession_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_GetConfig_Call) Return(_a0 string, _a1 error) *MockSession_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_GetConfig_Call) RunAndReturn(run func(string, ...git.Option) (string, error)) *MockSession_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessionConfig provides a mock function with no fields
+func (_m *MockSession) GetSessionConfig() *git.SessionConfig {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionConfig")
+	}
+
+	var r0 *git.SessionConfig
+	if rf, ok := ret.Get(0).(func() *git.SessionConfig); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*git.SessionConfig)
+		}
+	}
+
+	return r0
+}
+
+// MockSession_GetSessionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionConfig'
+type MockSession_GetSessionConfig_Call struct {
+	*mock.Call
+}
+
+// GetSessionConfig is a helper method to define mock.On call
+func (_e *MockSession_Expecter) GetSessionConfig() *MockSession_GetSessionConfig_Call {
+	return &MockSession_GetSessionConfig_Call{Call: _e.mock.On("GetSessionConfig")}
+}
+
+func (_c *MockSession_GetSessionConfig_Call) Run(run func()) *MockSession_GetSessionConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_GetSessionConfig_Call) Return(_a0 *git.SessionConfig) *MockSession_GetSessionConfig_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_GetSessionConfig_Call) RunAndReturn(run func() *git.SessionConfig) *MockSession_GetSessionConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Init provides a mock function with given fields: path, options
+func (_m *MockSession) Init(path string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, path)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Init")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(path, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
+type MockSession_Init_Call struct {
+	*mock.Call
+}
+
+// Init is a helper method to define mock.On call
+//   - path string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Init(path interface{}, options ...interface{}) *MockSession_Init_Call {
+	return &MockSession_Init_Call{Call: _e.mock.On("Init",
+		append([]interface{}{path}, options...)...)}
+}
+
+func (_c *MockSession_Init_Call) Run(run func(path string, options ...git.Option)) *MockSession_Init_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Init_Call) Return(_a0 error) *MockSession_Init_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_Init_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_Init_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitRepository provides a mock function with no fields
+func (_m *MockSession) InitRepository() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InitRepository")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_InitRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitRepository'
+type MockSession_InitRepository_Call struct {
+	*mock.Call
+}
+
+// InitRepository is a helper method to define mock.On call
+func (_e *MockSession_Expecter) InitRepository() *MockSession_InitRepository_Call {
+	return &MockSession_InitRepository_Call{Call: _e.mock.On("InitRepository")}
+}
+
+func (_c *MockSession_InitRepository_Call) Run(run func()) *MockSession_InitRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_InitRepository_Call) Return(_a0 error) *MockSession_InitRepository_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_InitRepository_Call) RunAndReturn(run func() error) *MockSession_InitRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBareRepository provides a mock function with no fields
+func (_m *MockSession) IsBareRepository() (bool, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBareRepository")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (bool, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_IsBareRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBareRepository'
+type MockSession_IsBareRepository_Call struct {
+	*mock.Call
+}
+
+// IsBareRepository is a helper method to define mock.On call
+func (_e *MockSession_Expecter) IsBareRepository() *MockSession_IsBareRepository_Call {
+	return &MockSession_IsBareRepository_Call{Call: _e.mock.On("IsBareRepository")}
+}
+
+func (_c *MockSession_IsBareRepository_Call) Run(run func()) *MockSession_IsBareRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_IsBareRepository_Call) Return(_a0 bool, _a1 error) *MockSession_IsBareRepository_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_IsBareRepository_Call) RunAndReturn(run func() (bool, error)) *MockSession_IsBareRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsValid provides a mock function with no fields
+func (_m *MockSession) IsValid() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsValid")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockSession_IsValid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsValid'
+type MockSession_IsValid_Call struct {
+	*mock.Call
+}
+
+// IsValid is a helper method to define mock.On call
+func (_e *MockSession_Expecter) IsValid() *MockSession_IsValid_Call {
+	return &MockSession_IsValid_Call{Call: _e.mock.On("IsValid")}
+}
+
+func (_c *MockSession_IsValid_Call) Run(run func()) *MockSession_IsValid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_IsValid_Call) Return(_a0 bool) *MockSession_IsValid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_IsValid_Call) RunAndReturn(run func() bool) *MockSession_IsValid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBranches provides a mock function with given fields: options
+func (_m *MockSession) ListBranches(options ...git.Option) ([]types.Branch, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBranches")
+	}
+
+	var r0 []types.Branch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Branch, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Branch); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Branch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_ListBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBranches'
+type MockSession_ListBranches_Call struct {
+	*mock.Call
+}
+
+// ListBranches is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) ListBranches(options ...interface{}) *MockSession_ListBranches_Call {
+	return &MockSession_ListBranches_Call{Call: _e.mock.On("ListBranches",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_ListBranches_Call) Run(run func(options ...git.Option)) *MockSession_ListBranches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_ListBranches_Call) Return(_a0 []types.Branch, _a1 error) *MockSession_ListBranches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_ListBranches_Call) RunAndReturn(run func(...git.Option) ([]types.Branch, error)) *MockSession_ListBranches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListConfig provides a mock function with given fields: options
+func (_m *MockSession) ListConfig(options ...git.Option) ([]types.ConfigEntry, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConfig")
+	}
+
+	var r0 []types.ConfigEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.ConfigEntry, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.ConfigEntry); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ConfigEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_ListConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfig'
+type MockSession_ListConfig_Call struct {
+	*mock.Call
+}
+
+// ListConfig is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) ListConfig(options ...interface{}) *MockSession_ListConfig_Call {
+	return &MockSession_ListConfig_Call{Call: _e.mock.On("ListConfig",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_ListConfig_Call) Run(run func(options ...git.Option)) *MockSession_ListConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_ListConfig_Call) Return(_a0 []types.ConfigEntry, _a1 error) *MockSession_ListConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_ListConfig_Call) RunAndReturn(run func(...git.Option) ([]types.ConfigEntry, error)) *MockSession_ListConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRemotes provides a mock function with given fields: options
+func (_m *MockSession) ListRemotes(options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRemotes")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_ListRemotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRemotes'
+type MockSession_ListRemotes_Call struct {
+	*mock.Call
+}
+
+// ListRemotes is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) ListRemotes(options ...interface{}) *MockSession_ListRemotes_Call {
+	return &MockSession_ListRemotes_Call{Call: _e.mock.On("ListRemotes",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_ListRemotes_Call) Run(run func(options ...git.Option)) *MockSession_ListRemotes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_ListRemotes_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_ListRemotes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_ListRemotes_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockSession_ListRemotes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function with given fields: options
+func (_m *MockSession) ListTags(options ...git.Option) ([]types.Tag, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []types.Tag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Tag, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Tag); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Tag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type MockSession_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) ListTags(options ...interface{}) *MockSession_ListTags_Call {
+	return &MockSession_ListTags_Call{Call: _e.mock.On("ListTags",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_ListTags_Call) Run(run func(options ...git.Option)) *MockSession_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_ListTags_Call) Return(_a0 []types.Tag, _a1 error) *MockSession_ListTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_ListTags_Call) RunAndReturn(run func(...git.Option) ([]types.Tag, error)) *MockSession_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Log provides a mock function with given fields: options
+func (_m *MockSession) Log(options ...git.Option) ([]types.Log, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Log")
+	}
+
+	var r0 []types.Log
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Log, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Log); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Log)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
+type MockSession_Log_Call struct {
+	*mock.Call
+}
+
+// Log is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Log(options ...interface{}) *MockSession_Log_Call {
+	return &MockSession_Log_Call{Call: _e.mock.On("Log",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Log_Call) Run(run func(options ...git.Option)) *MockSession_Log_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Log_Call) Return(_a0 []types.Log, _a1 error) *MockSession_Log_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Log_Call) RunAndReturn(run func(...git.Option) ([]types.Log, error)) *MockSession_Log_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LogStream provides a mock function with given fields: options
+func (_m *MockSession) LogStream(options ...git.Option) (*git.LogIterator, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LogStream")
+	}
+
+	var r0 *git.LogIterator
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*git.LogIterator, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *git.LogIterator); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*git.LogIterator)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_LogStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LogStream'
+type MockSession_LogStream_Call struct {
+	*mock.Call
+}
+
+// LogStream is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) LogStream(options ...interface{}) *MockSession_LogStream_Call {
+	return &MockSession_LogStream_Call{Call: _e.mock.On("LogStream",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_LogStream_Call) Run(run func(options ...git.Option)) *MockSession_LogStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_LogStream_Call) Return(_a0 *git.LogIterator, _a1 error) *MockSession_LogStream_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_LogStream_Call) RunAndReturn(run func(...git.Option) (*git.LogIterator, error)) *MockSession_LogStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Merge provides a mock function with given fields: options
+func (_m *MockSession) Merge(options ...git.Option) (*types.MergeResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Merge")
+	}
+
+	var r0 *types.MergeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.MergeResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.MergeResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MergeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
+type MockSession_Merge_Call struct {
+	*mock.Call
+}
+
+// Merge is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Merge(options ...interface{}) *MockSession_Merge_Call {
+	return &MockSession_Merge_Call{Call: _e.mock.On("Merge",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Merge_Call) Run(run func(options ...git.Option)) *MockSession_Merge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Merge_Call) Return(_a0 *types.MergeResult, _a1 error) *MockSession_Merge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Merge_Call) RunAndReturn(run func(...git.Option) (*types.MergeResult, error)) *MockSession_Merge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeAbort provides a mock function with no fields
+func (_m *MockSession) MergeAbort() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeAbort")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_MergeAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeAbort'
+type MockSession_MergeAbort_Call struct {
+	*mock.Call
+}
+
+// MergeAbort is a helper method to define mock.On call
+func (_e *MockSession_Expecter) MergeAbort() *MockSession_MergeAbort_Call {
+	return &MockSession_MergeAbort_Call{Call: _e.mock.On("MergeAbort")}
+}
+
+func (_c *MockSession_MergeAbort_Call) Run(run func()) *MockSession_MergeAbort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_MergeAbort_Call) Return(_a0 error) *MockSession_MergeAbort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_MergeAbort_Call) RunAndReturn(run func() error) *MockSession_MergeAbort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MergeContinue provides a mock function with no fields
+func (_m *MockSession) MergeContinue() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeContinue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_MergeContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeContinue'
+type MockSession_MergeContinue_Call struct {
+	*mock.Call
+}
+
+// MergeContinue is a helper method to define mock.On call
+func (_e *MockSession_Expecter) MergeContinue() *MockSession_MergeContinue_Call {
+	return &MockSession_MergeContinue_Call{Call: _e.mock.On("MergeContinue")}
+}
+
+func (_c *MockSession_MergeContinue_Call) Run(run func()) *MockSession_MergeContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_MergeContinue_Call) Return(_a0 error) *MockSession_MergeContinue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_MergeContinue_Call) RunAndReturn(run func() error) *MockSession_MergeContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pull provides a mock function with given fields: options
+func (_m *MockSession) Pull(options ...git.Option) (*types.MergeResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pull")
+	}
+
+	var r0 *types.MergeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.MergeResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.MergeResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MergeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Pull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pull'
+type MockSession_Pull_Call struct {
+	*mock.Call
+}
+
+// Pull is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Pull(options ...interface{}) *MockSession_Pull_Call {
+	return &MockSession_Pull_Call{Call: _e.mock.On("Pull",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Pull_Call) Run(run func(options ...git.Option)) *MockSession_Pull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Pull_Call) Return(_a0 *types.MergeResult, _a1 error) *MockSession_Pull_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Pull_Call) RunAndReturn(run func(...git.Option) (*types.MergeResult, error)) *MockSession_Pull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Push provides a mock function with given fields: options
+func (_m *MockSession) Push(options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Push")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
+type MockSession_Push_Call struct {
+	*mock.Call
+}
+
+// Push is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Push(options ...interface{}) *MockSession_Push_Call {
+	return &MockSession_Push_Call{Call: _e.mock.On("Push",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Push_Call) Run(run func(options ...git.Option)) *MockSession_Push_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Push_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_Push_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Push_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockSession_Push_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PushTags provides a mock function with given fields: remote, options
+func (_m *MockSession) PushTags(remote string, options ...git.Option) ([]types.Remote, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, remote)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PushTags")
+	}
+
+	var r0 []types.Remote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.Remote, error)); ok {
+		return rf(remote, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.Remote); ok {
+		r0 = rf(remote, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Remote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(remote, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_PushTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushTags'
+type MockSession_PushTags_Call struct {
+	*mock.Call
+}
+
+// PushTags is a helper method to define mock.On call
+//   - remote string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) PushTags(remote interface{}, options ...interface{}) *MockSession_PushTags_Call {
+	return &MockSession_PushTags_Call{Call: _e.mock.On("PushTags",
+		append([]interface{}{remote}, options...)...)}
+}
+
+func (_c *MockSession_PushTags_Call) Run(run func(remote string, options ...git.Option)) *MockSession_PushTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_PushTags_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_PushTags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_PushTags_Call) RunAndReturn(run func(string, ...git.Option) ([]types.Remote, error)) *MockSession_PushTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rebase provides a mock function with given fields: options
+func (_m *MockSession) Rebase(options ...git.Option) (*types.RebaseResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rebase")
+	}
+
+	var r0 *types.RebaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.RebaseResult, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.RebaseResult); ok {
+		r0 = rf(options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RebaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Rebase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebase'
+type MockSession_Rebase_Call struct {
+	*mock.Call
+}
+
+// Rebase is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Rebase(options ...interface{}) *MockSession_Rebase_Call {
+	return &MockSession_Rebase_Call{Call: _e.mock.On("Rebase",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Rebase_Call) Run(run func(options ...git.Option)) *MockSession_Rebase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Rebase_Call) Return(_a0 *types.RebaseResult, _a1 error) *MockSession_Rebase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Rebase_Call) RunAndReturn(run func(...git.Option) (*types.RebaseResult, error)) *MockSession_Rebase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseAbort provides a mock function with no fields
+func (_m *MockSession) RebaseAbort() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseAbort")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_RebaseAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseAbort'
+type MockSession_RebaseAbort_Call struct {
+	*mock.Call
+}
+
+// RebaseAbort is a helper method to define mock.On call
+func (_e *MockSession_Expecter) RebaseAbort() *MockSession_RebaseAbort_Call {
+	return &MockSession_RebaseAbort_Call{Call: _e.mock.On("RebaseAbort")}
+}
+
+func (_c *MockSession_RebaseAbort_Call) Run(run func()) *MockSession_RebaseAbort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_RebaseAbort_Call) Return(_a0 error) *MockSession_RebaseAbort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_RebaseAbort_Call) RunAndReturn(run func() error) *MockSession_RebaseAbort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseContinue provides a mock function with no fields
+func (_m *MockSession) RebaseContinue() (*types.RebaseResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseContinue")
+	}
+
+	var r0 *types.RebaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.RebaseResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.RebaseResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RebaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_RebaseContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseContinue'
+type MockSession_RebaseContinue_Call struct {
+	*mock.Call
+}
+
+// RebaseContinue is a helper method to define mock.On call
+func (_e *MockSession_Expecter) RebaseContinue() *MockSession_RebaseContinue_Call {
+	return &MockSession_RebaseContinue_Call{Call: _e.mock.On("RebaseContinue")}
+}
+
+func (_c *MockSession_RebaseContinue_Call) Run(run func()) *MockSession_RebaseContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_RebaseContinue_Call) Return(_a0 *types.RebaseResult, _a1 error) *MockSession_RebaseContinue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_RebaseContinue_Call) RunAndReturn(run func() (*types.RebaseResult, error)) *MockSession_RebaseContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseEditTodo provides a mock function with given fields: todo
+func (_m *MockSession) RebaseEditTodo(todo []types.RebaseTodo) error {
+	ret := _m.Called(todo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseEditTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]types.RebaseTodo) error); ok {
+		r0 = rf(todo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_RebaseEditTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseEditTodo'
+type MockSession_RebaseEditTodo_Call struct {
+	*mock.Call
+}
+
+// RebaseEditTodo is a helper method to define mock.On call
+//   - todo []types.RebaseTodo
+func (_e *MockSession_Expecter) RebaseEditTodo(todo interface{}) *MockSession_RebaseEditTodo_Call {
+	return &MockSession_RebaseEditTodo_Call{Call: _e.mock.On("RebaseEditTodo", todo)}
+}
+
+func (_c *MockSession_RebaseEditTodo_Call) Run(run func(todo []types.RebaseTodo)) *MockSession_RebaseEditTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]types.RebaseTodo))
+	})
+	return _c
+}
+
+func (_c *MockSession_RebaseEditTodo_Call) Return(_a0 error) *MockSession_RebaseEditTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_RebaseEditTodo_Call) RunAndReturn(run func([]types.RebaseTodo) error) *MockSession_RebaseEditTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseInteractive provides a mock function with given fields: upstream, todo, options
+func (_m *MockSession) RebaseInteractive(upstream string, todo []types.RebaseTodo, options ...git.Option) (*types.RebaseResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, upstream, todo)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseInteractive")
+	}
+
+	var r0 *types.RebaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []types.RebaseTodo, ...git.Option) (*types.RebaseResult, error)); ok {
+		return rf(upstream, todo, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, []types.RebaseTodo, ...git.Option) *types.RebaseResult); ok {
+		r0 = rf(upstream, todo, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RebaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []types.RebaseTodo, ...git.Option) error); ok {
+		r1 = rf(upstream, todo, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_RebaseInteractive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseInteractive'
+type MockSession_RebaseInteractive_Call struct {
+	*mock.Call
+}
+
+// RebaseInteractive is a helper method to define mock.On call
+//   - upstream string
+//   - todo []types.RebaseTodo
+//   - options ...git.Option
+func (_e *MockSession_Expecter) RebaseInteractive(upstream interface{}, todo interface{}, options ...interface{}) *MockSession_RebaseInteractive_Call {
+	return &MockSession_RebaseInteractive_Call{Call: _e.mock.On("RebaseInteractive",
+		append([]interface{}{upstream, todo}, options...)...)}
+}
+
+func (_c *MockSession_RebaseInteractive_Call) Run(run func(upstream string, todo []types.RebaseTodo, options ...git.Option)) *MockSession_RebaseInteractive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].([]types.RebaseTodo), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_RebaseInteractive_Call) Return(_a0 *types.RebaseResult, _a1 error) *MockSession_RebaseInteractive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_RebaseInteractive_Call) RunAndReturn(run func(string, []types.RebaseTodo, ...git.Option) (*types.RebaseResult, error)) *MockSession_RebaseInteractive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseSkip provides a mock function with no fields
+func (_m *MockSession) RebaseSkip() (*types.RebaseResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseSkip")
+	}
+
+	var r0 *types.RebaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.RebaseResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.RebaseResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RebaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_RebaseSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseSkip'
+type MockSession_RebaseSkip_Call struct {
+	*mock.Call
+}
+
+// RebaseSkip is a helper method to define mock.On call
+func (_e *MockSession_Expecter) RebaseSkip() *MockSession_RebaseSkip_Call {
+	return &MockSession_RebaseSkip_Call{Call: _e.mock.On("RebaseSkip")}
+}
+
+func (_c *MockSession_RebaseSkip_Call) Run(run func()) *MockSession_RebaseSkip_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_RebaseSkip_Call) Return(_a0 *types.RebaseResult, _a1 error) *MockSession_RebaseSkip_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_RebaseSkip_Call) RunAndReturn(run func() (*types.RebaseResult, error)) *MockSession_RebaseSkip_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reflog provides a mock function with given fields: ref, options
+func (_m *MockSession) Reflog(ref string, options ...git.Option) ([]types.ReflogEntry, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ref)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reflog")
+	}
+
+	var r0 []types.ReflogEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.ReflogEntry, error)); ok {
+		return rf(ref, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.ReflogEntry); ok {
+		r0 = rf(ref, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ReflogEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(ref, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Reflog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reflog'
+type MockSession_Reflog_Call struct {
+	*mock.Call
+}
+
+// Reflog is a helper method to define mock.On call
+//   - ref string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Reflog(ref interface{}, options ...interface{}) *MockSession_Reflog_Call {
+	return &MockSession_Reflog_Call{Call: _e.mock.On("Reflog",
+		append([]interface{}{ref}, options...)...)}
+}
+
+func (_c *MockSession_Reflog_Call) Run(run func(ref string, options ...git.Option)) *MockSession_Reflog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Reflog_Call) Return(_a0 []types.ReflogEntry, _a1 error) *MockSession_Reflog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Reflog_Call) RunAndReturn(run func(string, ...git.Option) ([]types.ReflogEntry, error)) *MockSession_Reflog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReflogDelete provides a mock function with given fields: selector, options
+func (_m *MockSession) ReflogDelete(selector string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, selector)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReflogDelete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(selector, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_ReflogDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReflogDelete'
+type MockSession_ReflogDelete_Call struct {
+	*mock.Call
+}
+
+// ReflogDelete is a helper method to define mock.On call
+//   - selector string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) ReflogDelete(selector interface{}, options ...interface{}) *MockSession_ReflogDelete_Call {
+	return &MockSession_ReflogDelete_Call{Call: _e.mock.On("ReflogDelete",
+		append([]interface{}{selector}, options...)...)}
+}
+
+func (_c *MockSession_ReflogDelete_Call) Run(run func(selector string, options ...git.Option)) *MockSession_ReflogDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_ReflogDelete_Call) Return(_a0 error) *MockSession_ReflogDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_ReflogDelete_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_ReflogDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReflogExpire provides a mock function with given fields: options
+func (_m *MockSession) ReflogExpire(options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReflogExpire")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
+		r0 = rf(options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_ReflogExpire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReflogExpire'
+type MockSession_ReflogExpire_Call struct {
+	*mock.Call
+}
+
+// ReflogExpire is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) ReflogExpire(options ...interface{}) *MockSession_ReflogExpire_Call {
+	return &MockSession_ReflogExpire_Call{Call: _e.mock.On("ReflogExpire",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_ReflogExpire_Call) Run(run func(options ...git.Option)) *MockSession_ReflogExpire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_ReflogExpire_Call) Return(_a0 error) *MockSession_ReflogExpire_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_ReflogExpire_Call) RunAndReturn(run func(...git.Option) error) *MockSession_ReflogExpire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: options
+func (_m *MockSession) Remove(options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
+		r0 = rf(options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type MockSession_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Remove(options ...interface{}) *MockSession_Remove_Call {
+	return &MockSession_Remove_Call{Call: _e.mock.On("Remove",
+		append([]interface{}{}, options...)...)}
+}
+
+func (_c *MockSession_Remove_Call) Run(run func(options ...git.Option)) *MockSession_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Remove_Call) Return(_a0 error) *MockSession_Remove_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_Remove_Call) RunAndReturn(run func(...git.Option) error) *MockSession_Remove_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveRemote provides a mock function with given fields: name, options
+func (_m *MockSession) RemoveRemote(name string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, name)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveRemote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(name, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_RemoveRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRemote'
+type MockSession_RemoveRemote_Call struct {
+	*mock.Call
+}
+
+// RemoveRemote is a helper method to define mock.On call
+//   - name string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) RemoveRemote(name interface{}, options ...interface{}) *MockSession_RemoveRemote_Call {
+	return &MockSession_RemoveRemote_Call{Call: _e.mock.On("RemoveRemote",
+		append([]interface{}{name}, options...)...)}
+}
+
+func (_c *MockSession_RemoveRemote_Call) Run(run func(name string, options ...git.Option)) *MockSession_RemoveRemote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_RemoveRemote_Call) Return(_a0 error) *MockSession_RemoveRemote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_RemoveRemote_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_RemoveRemote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RenameBranch provides a mock function with given fields: oldName, newName, options
+func (_m *MockSession) RenameBranch(oldName string, newName string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, oldName, newName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RenameBranch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(oldName, newName, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_RenameBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameBranch'
+type MockSession_RenameBranch_Call struct {
+	*mock.Call
+}
+
+// RenameBranch is a helper method to define mock.On call
+//   - oldName string
+//   - newName string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) RenameBranch(oldName interface{}, newName interface{}, options ...interface{}) *MockSession_RenameBranch_Call {
+	return &MockSession_RenameBranch_Call{Call: _e.mock.On("RenameBranch",
+		append([]interface{}{oldName, newName}, options...)...)}
+}
+
+func (_c *MockSession_RenameBranch_Call) Run(run func(oldName string, newName string, options ...git.Option)) *MockSession_RenameBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_RenameBranch_Call) Return(_a0 error) *MockSession_RenameBranch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_RenameBranch_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_RenameBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: files, options
+func (_m *MockSession) Reset(files []string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, files)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) error); ok {
+		r0 = rf(files, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type MockSession_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - files []string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Reset(files interface{}, options ...interface{}) *MockSession_Reset_Call {
+	return &MockSession_Reset_Call{Call: _e.mock.On("Reset",
+		append([]interface{}{files}, options...)...)}
+}
+
+func (_c *MockSession_Reset_Call) Run(run func(files []string, options ...git.Option)) *MockSession_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Reset_Call) Return(_a0 error) *MockSession_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_Reset_Call) RunAndReturn(run func([]string, ...git.Option) error) *MockSession_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveConflicts provides a mock function with given fields: resolutions
+func (_m *MockSession) ResolveConflicts(resolutions []types.ConflictResolution) ([]types.ConflictResolutionResult, error) {
+	ret := _m.Called(resolutions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveConflicts")
+	}
+
+	var r0 []types.ConflictResolutionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]types.ConflictResolution) ([]types.ConflictResolutionResult, error)); ok {
+		return rf(resolutions)
+	}
+	if rf, ok := ret.Get(0).(func([]types.ConflictResolution) []types.ConflictResolutionResult); ok {
+		r0 = rf(resolutions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.ConflictResolutionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]types.ConflictResolution) error); ok {
+		r1 = rf(resolutions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_ResolveConflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveConflicts'
+type MockSession_ResolveConflicts_Call struct {
+	*mock.Call
+}
+
+// ResolveConflicts is a helper method to define mock.On call
+//   - resolutions []types.ConflictResolution
+func (_e *MockSession_Expecter) ResolveConflicts(resolutions interface{}) *MockSession_ResolveConflicts_Call {
+	return &MockSession_ResolveConflicts_Call{Call: _e.mock.On("ResolveConflicts", resolutions)}
+}
+
+func (_c *MockSession_ResolveConflicts_Call) Run(run func(resolutions []types.ConflictResolution)) *MockSession_ResolveConflicts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]types.ConflictResolution))
+	})
+	return _c
+}
+
+func (_c *MockSession_ResolveConflicts_Call) Return(_a0 []types.ConflictResolutionResult, _a1 error) *MockSession_ResolveConflicts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_ResolveConflicts_Call) RunAndReturn(run func([]types.ConflictResolution) ([]types.ConflictResolutionResult, error)) *MockSession_ResolveConflicts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revert provides a mock function with given fields: commits, options
+func (_m *MockSession) Revert(commits []string, options ...git.Option) (*types.SequencerResult, error) {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, commits)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revert")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) (*types.SequencerResult, error)); ok {
+		return rf(commits, options...)
+	}
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) *types.SequencerResult); ok {
+		r0 = rf(commits, options...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, ...git.Option) error); ok {
+		r1 = rf(commits, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type MockSession_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - commits []string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) Revert(commits interface{}, options ...interface{}) *MockSession_Revert_Call {
+	return &MockSession_Revert_Call{Call: _e.mock.On("Revert",
+		append([]interface{}{commits}, options...)...)}
+}
+
+func (_c *MockSession_Revert_Call) Run(run func(commits []string, options ...git.Option)) *MockSession_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].([]string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockSession_Revert_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockSession_Revert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_Revert_Call) RunAndReturn(run func([]string, ...git.Option) (*types.SequencerResult, error)) *MockSession_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertAbort provides a mock function with no fields
+func (_m *MockSession) RevertAbort() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertAbort")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_RevertAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertAbort'
+type MockSession_RevertAbort_Call struct {
+	*mock.Call
+}
+
+// RevertAbort is a helper method to define mock.On call
+func (_e *MockSession_Expecter) RevertAbort() *MockSession_RevertAbort_Call {
+	return &MockSession_RevertAbort_Call{Call: _e.mock.On("RevertAbort")}
+}
+
+func (_c *MockSession_RevertAbort_Call) Run(run func()) *MockSession_RevertAbort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_RevertAbort_Call) Return(_a0 error) *MockSession_RevertAbort_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_RevertAbort_Call) RunAndReturn(run func() error) *MockSession_RevertAbort_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertContinue provides a mock function with no fields
+func (_m *MockSession) RevertContinue() (*types.SequencerResult, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertContinue")
+	}
+
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.SequencerResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.SequencerResult); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSession_RevertContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertContinue'
+type MockSession_RevertContinue_Call struct {
+	*mock.Call
+}
+
+// RevertContinue is a helper method to define mock.On call
+func (_e *MockSession_Expecter) RevertContinue() *MockSession_RevertContinue_Call {
+	return &MockSession_RevertContinue_Call{Call: _e.mock.On("RevertContinue")}
+}
+
+func (_c *MockSession_RevertContinue_Call) Run(run func()) *MockSession_RevertContinue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_RevertContinue_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockSession_RevertContinue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSession_RevertContinue_Call) RunAndReturn(run func() (*types.SequencerResult, error)) *MockSession_RevertContinue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevertQuit provides a mock function with no fields
+func (_m *MockSession) RevertQuit() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevertQuit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSession_RevertQuit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertQuit'
+type MockSession_RevertQuit_Call struct {
+	*mock.Call
+}
+
+// RevertQuit is a helper method to define mock.On call
+func (_e *MockSession_Expecter) RevertQuit() *MockSession_RevertQuit_Call {
+	return &MockSession_RevertQuit_Call{Call: _e.mock.On("RevertQuit")}
+}
+
+func (_c *MockSession_RevertQuit_Call) Run(run func()) *MockSession_RevertQuit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSession_RevertQuit_Call) Return(_a0 error) *MockSession_RevertQuit_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Commit_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_Commit_Call {
+func (_c *MockSession_RevertQuit_Call) RunAndReturn(run func() error) *MockSession_RevertQuit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateBranch provides a mock function with given fields: branch, options
-func (_m *MockSession) CreateBranch(branch string, options ...git.Option) error {
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, branch)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// RevertSkip provides a mock function with no fields
+func (_m *MockSession) RevertSkip() (*types.SequencerResult, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateBranch")
+		panic("no return value specified for RevertSkip")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(branch, options...)
+	var r0 *types.SequencerResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.SequencerResult, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.SequencerResult); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.SequencerResult)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockSession_CreateBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBranch'
-type MockSession_CreateBranch_Call struct {
+// MockSession_RevertSkip_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertSkip'
+type MockSession_RevertSkip_Call struct {
 	*mock.Call
 }
 
-// CreateBranch is a helper method to define mock.On call
-//   - branch string
-//   - options ...git.Option
-func (_e *MockSession_Expecter) CreateBranch(branch interface{}, options ...interface{}) *MockSession_CreateBranch_Call {
-	return &MockSession_CreateBranch_Call{Call: _e.mock.On("CreateBranch",
-		append([]interface{}{branch}, options...)...)}
+// RevertSkip is a helper method to define mock.On call
+func (_e *MockSession_Expecter) RevertSkip() *MockSession_RevertSkip_Call {
+	return &MockSession_RevertSkip_Call{Call: _e.mock.On("RevertSkip")}
 }
 
-func (_c *MockSession_CreateBranch_Call) Run(run func(branch string, options ...git.Option)) *MockSession_CreateBranch_Call {
+func (_c *MockSession_RevertSkip_Call) Run(run func()) *MockSession_RevertSkip_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
-			if a != nil {
-				variadicArgs[i] = a.(git.Option)
-			}
-		}
-		run(args[0].(string), variadicArgs...)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockSession_CreateBranch_Call) Return(_a0 error) *MockSession_CreateBranch_Call {
-	_c.Call.Return(_a0)
+func (_c *MockSession_RevertSkip_Call) Return(_a0 *types.SequencerResult, _a1 error) *MockSession_RevertSkip_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_CreateBranch_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_CreateBranch_Call {
+func (_c *MockSession_RevertSkip_Call) RunAndReturn(run func() (*types.SequencerResult, error)) *MockSession_RevertSkip_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteBranch provides a mock function with given fields: branch, options
-func (_m *MockSession) DeleteBranch(branch string, options ...git.Option) error {
+// SetConfig provides a mock function with given fields: key, value, options
+func (_m *MockSession) SetConfig(key string, value string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, branch)
+	_ca = append(_ca, key, value)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteBranch")
+		panic("no return value specified for SetConfig")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(branch, options...)
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(key, value, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -425,60 +4712,61 @@ func (_m *MockSession) DeleteBranch(branch string, options ...git.Option) error
 	return r0
 }
 
-// MockSession_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
-type MockSession_DeleteBranch_Call struct {
+// MockSession_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
+type MockSession_SetConfig_Call struct {
 	*mock.Call
 }
 
-// DeleteBranch is a helper method to define mock.On call
-//   - branch string
+// SetConfig is a helper method to define mock.On call
+//   - key string
+//   - value string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) DeleteBranch(branch interface{}, options ...interface{}) *MockSession_DeleteBranch_Call {
-	return &MockSession_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch",
-		append([]interface{}{branch}, options...)...)}
+func (_e *MockSession_Expecter) SetConfig(key interface{}, value interface{}, options ...interface{}) *MockSession_SetConfig_Call {
+	return &MockSession_SetConfig_Call{Call: _e.mock.On("SetConfig",
+		append([]interface{}{key, value}, options...)...)}
 }
 
-func (_c *MockSession_DeleteBranch_Call) Run(run func(branch string, options ...git.Option)) *MockSession_DeleteBranch_Call {
+func (_c *MockSession_SetConfig_Call) Run(run func(key string, value string, options ...git.Option)) *MockSession_SetConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_DeleteBranch_Call) Return(_a0 error) *MockSession_DeleteBranch_Call {
+func (_c *MockSession_SetConfig_Call) Return(_a0 error) *MockSession_SetConfig_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_DeleteBranch_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_DeleteBranch_Call {
+func (_c *MockSession_SetConfig_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_SetConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteRemoteTag provides a mock function with given fields: remote, tagName, options
-func (_m *MockSession) DeleteRemoteTag(remote string, tagName string, options ...git.Option) error {
+// SetRemoteURL provides a mock function with given fields: name, url, options
+func (_m *MockSession) SetRemoteURL(name string, url string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, remote, tagName)
+	_ca = append(_ca, name, url)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteRemoteTag")
+		panic("no return value specified for SetRemoteURL")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(remote, tagName, options...)
+		r0 = rf(name, url, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -486,21 +4774,21 @@ func (_m *MockSession) DeleteRemoteTag(remote string, tagName string, options ..
 	return r0
 }
 
-// MockSession_DeleteRemoteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRemoteTag'
-type MockSession_DeleteRemoteTag_Call struct {
+// MockSession_SetRemoteURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRemoteURL'
+type MockSession_SetRemoteURL_Call struct {
 	*mock.Call
 }
 
-// DeleteRemoteTag is a helper method to define mock.On call
-//   - remote string
-//   - tagName string
+// SetRemoteURL is a helper method to define mock.On call
+//   - name string
+//   - url string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) DeleteRemoteTag(remote interface{}, tagName interface{}, options ...interface{}) *MockSession_DeleteRemoteTag_Call {
-	return &MockSession_DeleteRemoteTag_Call{Call: _e.mock.On("DeleteRemoteTag",
-		append([]interface{}{remote, tagName}, options...)...)}
+func (_e *MockSession_Expecter) SetRemoteURL(name interface{}, url interface{}, options ...interface{}) *MockSession_SetRemoteURL_Call {
+	return &MockSession_SetRemoteURL_Call{Call: _e.mock.On("SetRemoteURL",
+		append([]interface{}{name, url}, options...)...)}
 }
 
-func (_c *MockSession_DeleteRemoteTag_Call) Run(run func(remote string, tagName string, options ...git.Option)) *MockSession_DeleteRemoteTag_Call {
+func (_c *MockSession_SetRemoteURL_Call) Run(run func(name string, url string, options ...git.Option)) *MockSession_SetRemoteURL_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-2)
 		for i, a := range args[2:] {
@@ -513,34 +4801,34 @@ func (_c *MockSession_DeleteRemoteTag_Call) Run(run func(remote string, tagName
 	return _c
 }
 
-func (_c *MockSession_DeleteRemoteTag_Call) Return(_a0 error) *MockSession_DeleteRemoteTag_Call {
+func (_c *MockSession_SetRemoteURL_Call) Return(_a0 error) *MockSession_SetRemoteURL_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_DeleteRemoteTag_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_DeleteRemoteTag_Call {
+func (_c *MockSession_SetRemoteURL_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_SetRemoteURL_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTag provides a mock function with given fields: name, options
-func (_m *MockSession) DeleteTag(name string, options ...git.Option) error {
+// SetUpstream provides a mock function with given fields: branch, remote, options
+func (_m *MockSession) SetUpstream(branch string, remote string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, name)
+	_ca = append(_ca, branch, remote)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTag")
+		panic("no return value specified for SetUpstream")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(name, options...)
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(branch, remote, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -548,53 +4836,61 @@ func (_m *MockSession) DeleteTag(name string, options ...git.Option) error {
 	return r0
 }
 
-// MockSession_DeleteTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTag'
-type MockSession_DeleteTag_Call struct {
+// MockSession_SetUpstream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpstream'
+type MockSession_SetUpstream_Call struct {
 	*mock.Call
 }
 
-// DeleteTag is a helper method to define mock.On call
-//   - name string
+// SetUpstream is a helper method to define mock.On call
+//   - branch string
+//   - remote string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) DeleteTag(name interface{}, options ...interface{}) *MockSession_DeleteTag_Call {
-	return &MockSession_DeleteTag_Call{Call: _e.mock.On("DeleteTag",
-		append([]interface{}{name}, options...)...)}
+func (_e *MockSession_Expecter) SetUpstream(branch interface{}, remote interface{}, options ...interface{}) *MockSession_SetUpstream_Call {
+	return &MockSession_SetUpstream_Call{Call: _e.mock.On("SetUpstream",
+		append([]interface{}{branch, remote}, options...)...)}
 }
 
-func (_c *MockSession_DeleteTag_Call) Run(run func(name string, options ...git.Option)) *MockSession_DeleteTag_Call {
+func (_c *MockSession_SetUpstream_Call) Run(run func(branch string, remote string, options ...git.Option)) *MockSession_SetUpstream_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_DeleteTag_Call) Return(_a0 error) *MockSession_DeleteTag_Call {
+func (_c *MockSession_SetUpstream_Call) Return(_a0 error) *MockSession_SetUpstream_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_DeleteTag_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_DeleteTag_Call {
+func (_c *MockSession_SetUpstream_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_SetUpstream_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Destroy provides a mock function with no fields
-func (_m *MockSession) Destroy() error {
-	ret := _m.Called()
+// SetUpstreamTo provides a mock function with given fields: branch, upstream, options
+func (_m *MockSession) SetUpstreamTo(branch string, upstream string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, branch, upstream)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Destroy")
+		panic("no return value specified for SetUpstreamTo")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(branch, upstream, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -602,133 +4898,106 @@ func (_m *MockSession) Destroy() error {
 	return r0
 }
 
-// MockSession_Destroy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Destroy'
-type MockSession_Destroy_Call struct {
+// MockSession_SetUpstreamTo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpstreamTo'
+type MockSession_SetUpstreamTo_Call struct {
 	*mock.Call
 }
 
-// Destroy is a helper method to define mock.On call
-func (_e *MockSession_Expecter) Destroy() *MockSession_Destroy_Call {
-	return &MockSession_Destroy_Call{Call: _e.mock.On("Destroy")}
+// SetUpstreamTo is a helper method to define mock.On call
+//   - branch string
+//   - upstream string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) SetUpstreamTo(branch interface{}, upstream interface{}, options ...interface{}) *MockSession_SetUpstreamTo_Call {
+	return &MockSession_SetUpstreamTo_Call{Call: _e.mock.On("SetUpstreamTo",
+		append([]interface{}{branch, upstream}, options...)...)}
 }
 
-func (_c *MockSession_Destroy_Call) Run(run func()) *MockSession_Destroy_Call {
+func (_c *MockSession_SetUpstreamTo_Call) Run(run func(branch string, upstream string, options ...git.Option)) *MockSession_SetUpstreamTo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Destroy_Call) Return(_a0 error) *MockSession_Destroy_Call {
+func (_c *MockSession_SetUpstreamTo_Call) Return(_a0 error) *MockSession_SetUpstreamTo_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Destroy_Call) RunAndReturn(run func() error) *MockSession_Destroy_Call {
+func (_c *MockSession_SetUpstreamTo_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_SetUpstreamTo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Diff provides a mock function with given fields: options
-func (_m *MockSession) Diff(options ...git.Option) ([]types.Diff, error) {
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	if len(ret) == 0 {
-		panic("no return value specified for Diff")
-	}
-
-	var r0 []types.Diff
-	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Diff, error)); ok {
-		return rf(options...)
-	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Diff); ok {
-		r0 = rf(options...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Diff)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+// SetWorkingDirectory provides a mock function with given fields: wd
+func (_m *MockSession) SetWorkingDirectory(wd string) {
+	_m.Called(wd)
 }
 
-// MockSession_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
-type MockSession_Diff_Call struct {
+// MockSession_SetWorkingDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkingDirectory'
+type MockSession_SetWorkingDirectory_Call struct {
 	*mock.Call
 }
 
-// Diff is a helper method to define mock.On call
-//   - options ...git.Option
-func (_e *MockSession_Expecter) Diff(options ...interface{}) *MockSession_Diff_Call {
-	return &MockSession_Diff_Call{Call: _e.mock.On("Diff",
-		append([]interface{}{}, options...)...)}
+// SetWorkingDirectory is a helper method to define mock.On call
+//   - wd string
+func (_e *MockSession_Expecter) SetWorkingDirectory(wd interface{}) *MockSession_SetWorkingDirectory_Call {
+	return &MockSession_SetWorkingDirectory_Call{Call: _e.mock.On("SetWorkingDirectory", wd)}
 }
-
-func (_c *MockSession_Diff_Call) Run(run func(options ...git.Option)) *MockSession_Diff_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
-			if a != nil {
-				variadicArgs[i] = a.(git.Option)
-			}
-		}
-		run(variadicArgs...)
+
+func (_c *MockSession_SetWorkingDirectory_Call) Run(run func(wd string)) *MockSession_SetWorkingDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockSession_Diff_Call) Return(_a0 []types.Diff, _a1 error) *MockSession_Diff_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockSession_SetWorkingDirectory_Call) Return() *MockSession_SetWorkingDirectory_Call {
+	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockSession_Diff_Call) RunAndReturn(run func(...git.Option) ([]types.Diff, error)) *MockSession_Diff_Call {
-	_c.Call.Return(run)
+func (_c *MockSession_SetWorkingDirectory_Call) RunAndReturn(run func(string)) *MockSession_SetWorkingDirectory_Call {
+	_c.Run(run)
 	return _c
 }
 
-// Fetch provides a mock function with given fields: options
-func (_m *MockSession) Fetch(options ...git.Option) ([]types.Remote, error) {
+// Show provides a mock function with given fields: object, options
+func (_m *MockSession) Show(object string, options ...git.Option) (*types.Log, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, object)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Fetch")
+		panic("no return value specified for Show")
 	}
 
-	var r0 []types.Remote
+	var r0 *types.Log
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.Log, error)); ok {
+		return rf(object, options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.Log); ok {
+		r0 = rf(object, options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Remote)
+			r0 = ret.Get(0).(*types.Log)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(object, options...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -736,69 +5005,72 @@ func (_m *MockSession) Fetch(options ...git.Option) ([]types.Remote, error) {
 	return r0, r1
 }
 
-// MockSession_Fetch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fetch'
-type MockSession_Fetch_Call struct {
+// MockSession_Show_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Show'
+type MockSession_Show_Call struct {
 	*mock.Call
 }
 
-// Fetch is a helper method to define mock.On call
+// Show is a helper method to define mock.On call
+//   - object string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Fetch(options ...interface{}) *MockSession_Fetch_Call {
-	return &MockSession_Fetch_Call{Call: _e.mock.On("Fetch",
-		append([]interface{}{}, options...)...)}
+func (_e *MockSession_Expecter) Show(object interface{}, options ...interface{}) *MockSession_Show_Call {
+	return &MockSession_Show_Call{Call: _e.mock.On("Show",
+		append([]interface{}{object}, options...)...)}
 }
 
-func (_c *MockSession_Fetch_Call) Run(run func(options ...git.Option)) *MockSession_Fetch_Call {
+func (_c *MockSession_Show_Call) Run(run func(object string, options ...git.Option)) *MockSession_Show_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Fetch_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_Fetch_Call {
+func (_c *MockSession_Show_Call) Return(_a0 *types.Log, _a1 error) *MockSession_Show_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_Fetch_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockSession_Fetch_Call {
+func (_c *MockSession_Show_Call) RunAndReturn(run func(string, ...git.Option) (*types.Log, error)) *MockSession_Show_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConfig provides a mock function with given fields: key, options
-func (_m *MockSession) GetConfig(key string, options ...git.Option) (string, error) {
+// StashApply provides a mock function with given fields: stash, options
+func (_m *MockSession) StashApply(stash string, options ...git.Option) (*types.MergeResult, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, key)
+	_ca = append(_ca, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetConfig")
+		panic("no return value specified for StashApply")
 	}
 
-	var r0 string
+	var r0 *types.MergeResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) (string, error)); ok {
-		return rf(key, options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.MergeResult, error)); ok {
+		return rf(stash, options...)
 	}
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) string); ok {
-		r0 = rf(key, options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.MergeResult); ok {
+		r0 = rf(stash, options...)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MergeResult)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
-		r1 = rf(key, options...)
+		r1 = rf(stash, options...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -806,20 +5078,20 @@ func (_m *MockSession) GetConfig(key string, options ...git.Option) (string, err
 	return r0, r1
 }
 
-// MockSession_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
-type MockSession_GetConfig_Call struct {
+// MockSession_StashApply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashApply'
+type MockSession_StashApply_Call struct {
 	*mock.Call
 }
 
-// GetConfig is a helper method to define mock.On call
-//   - key string
+// StashApply is a helper method to define mock.On call
+//   - stash string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) GetConfig(key interface{}, options ...interface{}) *MockSession_GetConfig_Call {
-	return &MockSession_GetConfig_Call{Call: _e.mock.On("GetConfig",
-		append([]interface{}{key}, options...)...)}
+func (_e *MockSession_Expecter) StashApply(stash interface{}, options ...interface{}) *MockSession_StashApply_Call {
+	return &MockSession_StashApply_Call{Call: _e.mock.On("StashApply",
+		append([]interface{}{stash}, options...)...)}
 }
 
-func (_c *MockSession_GetConfig_Call) Run(run func(key string, options ...git.Option)) *MockSession_GetConfig_Call {
+func (_c *MockSession_StashApply_Call) Run(run func(stash string, options ...git.Option)) *MockSession_StashApply_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -832,81 +5104,34 @@ func (_c *MockSession_GetConfig_Call) Run(run func(key string, options ...git.Op
 	return _c
 }
 
-func (_c *MockSession_GetConfig_Call) Return(_a0 string, _a1 error) *MockSession_GetConfig_Call {
+func (_c *MockSession_StashApply_Call) Return(_a0 *types.MergeResult, _a1 error) *MockSession_StashApply_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_GetConfig_Call) RunAndReturn(run func(string, ...git.Option) (string, error)) *MockSession_GetConfig_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
-// GetSessionConfig provides a mock function with no fields
-func (_m *MockSession) GetSessionConfig() *git.SessionConfig {
-	ret := _m.Called()
-
-	if len(ret) == 0 {
-		panic("no return value specified for GetSessionConfig")
-	}
-
-	var r0 *git.SessionConfig
-	if rf, ok := ret.Get(0).(func() *git.SessionConfig); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*git.SessionConfig)
-		}
-	}
-
-	return r0
-}
-
-// MockSession_GetSessionConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionConfig'
-type MockSession_GetSessionConfig_Call struct {
-	*mock.Call
-}
-
-// GetSessionConfig is a helper method to define mock.On call
-func (_e *MockSession_Expecter) GetSessionConfig() *MockSession_GetSessionConfig_Call {
-	return &MockSession_GetSessionConfig_Call{Call: _e.mock.On("GetSessionConfig")}
-}
-
-func (_c *MockSession_GetSessionConfig_Call) Run(run func()) *MockSession_GetSessionConfig_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run()
-	})
-	return _c
-}
-
-func (_c *MockSession_GetSessionConfig_Call) Return(_a0 *git.SessionConfig) *MockSession_GetSessionConfig_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *MockSession_GetSessionConfig_Call) RunAndReturn(run func() *git.SessionConfig) *MockSession_GetSessionConfig_Call {
+func (_c *MockSession_StashApply_Call) RunAndReturn(run func(string, ...git.Option) (*types.MergeResult, error)) *MockSession_StashApply_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Init provides a mock function with given fields: path, options
-func (_m *MockSession) Init(path string, options ...git.Option) error {
+// StashBranch provides a mock function with given fields: branch, stash, options
+func (_m *MockSession) StashBranch(branch string, stash string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, path)
+	_ca = append(_ca, branch, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Init")
+		panic("no return value specified for StashBranch")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(path, options...)
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(branch, stash, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -914,53 +5139,60 @@ func (_m *MockSession) Init(path string, options ...git.Option) error {
 	return r0
 }
 
-// MockSession_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
-type MockSession_Init_Call struct {
+// MockSession_StashBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashBranch'
+type MockSession_StashBranch_Call struct {
 	*mock.Call
 }
 
-// Init is a helper method to define mock.On call
-//   - path string
+// StashBranch is a helper method to define mock.On call
+//   - branch string
+//   - stash string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Init(path interface{}, options ...interface{}) *MockSession_Init_Call {
-	return &MockSession_Init_Call{Call: _e.mock.On("Init",
-		append([]interface{}{path}, options...)...)}
+func (_e *MockSession_Expecter) StashBranch(branch interface{}, stash interface{}, options ...interface{}) *MockSession_StashBranch_Call {
+	return &MockSession_StashBranch_Call{Call: _e.mock.On("StashBranch",
+		append([]interface{}{branch, stash}, options...)...)}
 }
 
-func (_c *MockSession_Init_Call) Run(run func(path string, options ...git.Option)) *MockSession_Init_Call {
+func (_c *MockSession_StashBranch_Call) Run(run func(branch string, stash string, options ...git.Option)) *MockSession_StashBranch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Init_Call) Return(_a0 error) *MockSession_Init_Call {
+func (_c *MockSession_StashBranch_Call) Return(_a0 error) *MockSession_StashBranch_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Init_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_Init_Call {
+func (_c *MockSession_StashBranch_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_StashBranch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InitRepository provides a mock function with no fields
-func (_m *MockSession) InitRepository() error {
-	ret := _m.Called()
+// StashClear provides a mock function with given fields: options
+func (_m *MockSession) StashClear(options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for InitRepository")
+		panic("no return value specified for StashClear")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
+		r0 = rf(options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -968,80 +5200,104 @@ func (_m *MockSession) InitRepository() error {
 	return r0
 }
 
-// MockSession_InitRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitRepository'
-type MockSession_InitRepository_Call struct {
+// MockSession_StashClear_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashClear'
+type MockSession_StashClear_Call struct {
 	*mock.Call
 }
 
-// InitRepository is a helper method to define mock.On call
-func (_e *MockSession_Expecter) InitRepository() *MockSession_InitRepository_Call {
-	return &MockSession_InitRepository_Call{Call: _e.mock.On("InitRepository")}
+// StashClear is a helper method to define mock.On call
+//   - options ...git.Option
+func (_e *MockSession_Expecter) StashClear(options ...interface{}) *MockSession_StashClear_Call {
+	return &MockSession_StashClear_Call{Call: _e.mock.On("StashClear",
+		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_InitRepository_Call) Run(run func()) *MockSession_InitRepository_Call {
+func (_c *MockSession_StashClear_Call) Run(run func(options ...git.Option)) *MockSession_StashClear_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_InitRepository_Call) Return(_a0 error) *MockSession_InitRepository_Call {
+func (_c *MockSession_StashClear_Call) Return(_a0 error) *MockSession_StashClear_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_InitRepository_Call) RunAndReturn(run func() error) *MockSession_InitRepository_Call {
+func (_c *MockSession_StashClear_Call) RunAndReturn(run func(...git.Option) error) *MockSession_StashClear_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsValid provides a mock function with no fields
-func (_m *MockSession) IsValid() bool {
-	ret := _m.Called()
+// StashDrop provides a mock function with given fields: stash, options
+func (_m *MockSession) StashDrop(stash string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, stash)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsValid")
+		panic("no return value specified for StashDrop")
 	}
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(stash, options...)
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockSession_IsValid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsValid'
-type MockSession_IsValid_Call struct {
+// MockSession_StashDrop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashDrop'
+type MockSession_StashDrop_Call struct {
 	*mock.Call
 }
 
-// IsValid is a helper method to define mock.On call
-func (_e *MockSession_Expecter) IsValid() *MockSession_IsValid_Call {
-	return &MockSession_IsValid_Call{Call: _e.mock.On("IsValid")}
+// StashDrop is a helper method to define mock.On call
+//   - stash string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) StashDrop(stash interface{}, options ...interface{}) *MockSession_StashDrop_Call {
+	return &MockSession_StashDrop_Call{Call: _e.mock.On("StashDrop",
+		append([]interface{}{stash}, options...)...)}
 }
 
-func (_c *MockSession_IsValid_Call) Run(run func()) *MockSession_IsValid_Call {
+func (_c *MockSession_StashDrop_Call) Run(run func(stash string, options ...git.Option)) *MockSession_StashDrop_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_IsValid_Call) Return(_a0 bool) *MockSession_IsValid_Call {
+func (_c *MockSession_StashDrop_Call) Return(_a0 error) *MockSession_StashDrop_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_IsValid_Call) RunAndReturn(run func() bool) *MockSession_IsValid_Call {
+func (_c *MockSession_StashDrop_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_StashDrop_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListBranches provides a mock function with given fields: options
-func (_m *MockSession) ListBranches(options ...git.Option) ([]types.Branch, error) {
+// StashList provides a mock function with given fields: options
+func (_m *MockSession) StashList(options ...git.Option) ([]types.StashEntry, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1051,19 +5307,19 @@ func (_m *MockSession) ListBranches(options ...git.Option) ([]types.Branch, erro
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListBranches")
+		panic("no return value specified for StashList")
 	}
 
-	var r0 []types.Branch
+	var r0 []types.StashEntry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Branch, error)); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.StashEntry, error)); ok {
 		return rf(options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Branch); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.StashEntry); ok {
 		r0 = rf(options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Branch)
+			r0 = ret.Get(0).([]types.StashEntry)
 		}
 	}
 
@@ -1076,19 +5332,19 @@ func (_m *MockSession) ListBranches(options ...git.Option) ([]types.Branch, erro
 	return r0, r1
 }
 
-// MockSession_ListBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBranches'
-type MockSession_ListBranches_Call struct {
+// MockSession_StashList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashList'
+type MockSession_StashList_Call struct {
 	*mock.Call
 }
 
-// ListBranches is a helper method to define mock.On call
+// StashList is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) ListBranches(options ...interface{}) *MockSession_ListBranches_Call {
-	return &MockSession_ListBranches_Call{Call: _e.mock.On("ListBranches",
+func (_e *MockSession_Expecter) StashList(options ...interface{}) *MockSession_StashList_Call {
+	return &MockSession_StashList_Call{Call: _e.mock.On("StashList",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_ListBranches_Call) Run(run func(options ...git.Option)) *MockSession_ListBranches_Call {
+func (_c *MockSession_StashList_Call) Run(run func(options ...git.Option)) *MockSession_StashList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1101,89 +5357,91 @@ func (_c *MockSession_ListBranches_Call) Run(run func(options ...git.Option)) *M
 	return _c
 }
 
-func (_c *MockSession_ListBranches_Call) Return(_a0 []types.Branch, _a1 error) *MockSession_ListBranches_Call {
+func (_c *MockSession_StashList_Call) Return(_a0 []types.StashEntry, _a1 error) *MockSession_StashList_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_ListBranches_Call) RunAndReturn(run func(...git.Option) ([]types.Branch, error)) *MockSession_ListBranches_Call {
+func (_c *MockSession_StashList_Call) RunAndReturn(run func(...git.Option) ([]types.StashEntry, error)) *MockSession_StashList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListConfig provides a mock function with given fields: options
-func (_m *MockSession) ListConfig(options ...git.Option) ([]types.ConfigEntry, error) {
+// StashPop provides a mock function with given fields: stash, options
+func (_m *MockSession) StashPop(stash string, options ...git.Option) (*types.MergeResult, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListConfig")
+		panic("no return value specified for StashPop")
 	}
 
-	var r0 []types.ConfigEntry
+	var r0 *types.MergeResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.ConfigEntry, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.MergeResult, error)); ok {
+		return rf(stash, options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.ConfigEntry); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.MergeResult); ok {
+		r0 = rf(stash, options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.ConfigEntry)
+			r0 = ret.Get(0).(*types.MergeResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(stash, options...)
 	} else {
 		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
-
-// MockSession_ListConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfig'
-type MockSession_ListConfig_Call struct {
+
+// MockSession_StashPop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashPop'
+type MockSession_StashPop_Call struct {
 	*mock.Call
 }
 
-// ListConfig is a helper method to define mock.On call
+// StashPop is a helper method to define mock.On call
+//   - stash string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) ListConfig(options ...interface{}) *MockSession_ListConfig_Call {
-	return &MockSession_ListConfig_Call{Call: _e.mock.On("ListConfig",
-		append([]interface{}{}, options...)...)}
+func (_e *MockSession_Expecter) StashPop(stash interface{}, options ...interface{}) *MockSession_StashPop_Call {
+	return &MockSession_StashPop_Call{Call: _e.mock.On("StashPop",
+		append([]interface{}{stash}, options...)...)}
 }
 
-func (_c *MockSession_ListConfig_Call) Run(run func(options ...git.Option)) *MockSession_ListConfig_Call {
+func (_c *MockSession_StashPop_Call) Run(run func(stash string, options ...git.Option)) *MockSession_StashPop_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_ListConfig_Call) Return(_a0 []types.ConfigEntry, _a1 error) *MockSession_ListConfig_Call {
+func (_c *MockSession_StashPop_Call) Return(_a0 *types.MergeResult, _a1 error) *MockSession_StashPop_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_ListConfig_Call) RunAndReturn(run func(...git.Option) ([]types.ConfigEntry, error)) *MockSession_ListConfig_Call {
+func (_c *MockSession_StashPop_Call) RunAndReturn(run func(string, ...git.Option) (*types.MergeResult, error)) *MockSession_StashPop_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListRemotes provides a mock function with given fields: options
-func (_m *MockSession) ListRemotes(options ...git.Option) ([]types.Remote, error) {
+// StashPush provides a mock function with given fields: options
+func (_m *MockSession) StashPush(options ...git.Option) (*types.StashEntry, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1193,19 +5451,19 @@ func (_m *MockSession) ListRemotes(options ...git.Option) ([]types.Remote, error
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListRemotes")
+		panic("no return value specified for StashPush")
 	}
 
-	var r0 []types.Remote
+	var r0 *types.StashEntry
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) (*types.StashEntry, error)); ok {
 		return rf(options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) *types.StashEntry); ok {
 		r0 = rf(options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Remote)
+			r0 = ret.Get(0).(*types.StashEntry)
 		}
 	}
 
@@ -1218,19 +5476,19 @@ func (_m *MockSession) ListRemotes(options ...git.Option) ([]types.Remote, error
 	return r0, r1
 }
 
-// MockSession_ListRemotes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRemotes'
-type MockSession_ListRemotes_Call struct {
+// MockSession_StashPush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashPush'
+type MockSession_StashPush_Call struct {
 	*mock.Call
 }
 
-// ListRemotes is a helper method to define mock.On call
+// StashPush is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) ListRemotes(options ...interface{}) *MockSession_ListRemotes_Call {
-	return &MockSession_ListRemotes_Call{Call: _e.mock.On("ListRemotes",
+func (_e *MockSession_Expecter) StashPush(options ...interface{}) *MockSession_StashPush_Call {
+	return &MockSession_StashPush_Call{Call: _e.mock.On("StashPush",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_ListRemotes_Call) Run(run func(options ...git.Option)) *MockSession_ListRemotes_Call {
+func (_c *MockSession_StashPush_Call) Run(run func(options ...git.Option)) *MockSession_StashPush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1243,45 +5501,46 @@ func (_c *MockSession_ListRemotes_Call) Run(run func(options ...git.Option)) *Mo
 	return _c
 }
 
-func (_c *MockSession_ListRemotes_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_ListRemotes_Call {
+func (_c *MockSession_StashPush_Call) Return(_a0 *types.StashEntry, _a1 error) *MockSession_StashPush_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_ListRemotes_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockSession_ListRemotes_Call {
+func (_c *MockSession_StashPush_Call) RunAndReturn(run func(...git.Option) (*types.StashEntry, error)) *MockSession_StashPush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTags provides a mock function with given fields: options
-func (_m *MockSession) ListTags(options ...git.Option) ([]string, error) {
+// StashShow provides a mock function with given fields: stash, options
+func (_m *MockSession) StashShow(stash string, options ...git.Option) ([]types.Diff, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, stash)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTags")
+		panic("no return value specified for StashShow")
 	}
 
-	var r0 []string
+	var r0 []types.Diff
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]string, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.Diff, error)); ok {
+		return rf(stash, options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []string); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.Diff); ok {
+		r0 = rf(stash, options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
+			r0 = ret.Get(0).([]types.Diff)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(stash, options...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1289,70 +5548,65 @@ func (_m *MockSession) ListTags(options ...git.Option) ([]string, error) {
 	return r0, r1
 }
 
-// MockSession_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
-type MockSession_ListTags_Call struct {
+// MockSession_StashShow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashShow'
+type MockSession_StashShow_Call struct {
 	*mock.Call
 }
 
-// ListTags is a helper method to define mock.On call
+// StashShow is a helper method to define mock.On call
+//   - stash string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) ListTags(options ...interface{}) *MockSession_ListTags_Call {
-	return &MockSession_ListTags_Call{Call: _e.mock.On("ListTags",
-		append([]interface{}{}, options...)...)}
+func (_e *MockSession_Expecter) StashShow(stash interface{}, options ...interface{}) *MockSession_StashShow_Call {
+	return &MockSession_StashShow_Call{Call: _e.mock.On("StashShow",
+		append([]interface{}{stash}, options...)...)}
 }
 
-func (_c *MockSession_ListTags_Call) Run(run func(options ...git.Option)) *MockSession_ListTags_Call {
+func (_c *MockSession_StashShow_Call) Run(run func(stash string, options ...git.Option)) *MockSession_StashShow_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_ListTags_Call) Return(_a0 []string, _a1 error) *MockSession_ListTags_Call {
+func (_c *MockSession_StashShow_Call) Return(_a0 []types.Diff, _a1 error) *MockSession_StashShow_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_ListTags_Call) RunAndReturn(run func(...git.Option) ([]string, error)) *MockSession_ListTags_Call {
+func (_c *MockSession_StashShow_Call) RunAndReturn(run func(string, ...git.Option) ([]types.Diff, error)) *MockSession_StashShow_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Log provides a mock function with given fields: options
-func (_m *MockSession) Log(options ...git.Option) ([]types.Log, error) {
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// State provides a mock function with no fields
+func (_m *MockSession) State() (*types.RepositoryState, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Log")
+		panic("no return value specified for State")
 	}
 
-	var r0 []types.Log
+	var r0 *types.RepositoryState
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Log, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func() (*types.RepositoryState, error)); ok {
+		return rf()
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Log); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func() *types.RepositoryState); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Log)
+			r0 = ret.Get(0).(*types.RepositoryState)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1360,43 +5614,35 @@ func (_m *MockSession) Log(options ...git.Option) ([]types.Log, error) {
 	return r0, r1
 }
 
-// MockSession_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
-type MockSession_Log_Call struct {
+// MockSession_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type MockSession_State_Call struct {
 	*mock.Call
 }
 
-// Log is a helper method to define mock.On call
-//   - options ...git.Option
-func (_e *MockSession_Expecter) Log(options ...interface{}) *MockSession_Log_Call {
-	return &MockSession_Log_Call{Call: _e.mock.On("Log",
-		append([]interface{}{}, options...)...)}
+// State is a helper method to define mock.On call
+func (_e *MockSession_Expecter) State() *MockSession_State_Call {
+	return &MockSession_State_Call{Call: _e.mock.On("State")}
 }
 
-func (_c *MockSession_Log_Call) Run(run func(options ...git.Option)) *MockSession_Log_Call {
+func (_c *MockSession_State_Call) Run(run func()) *MockSession_State_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
-			if a != nil {
-				variadicArgs[i] = a.(git.Option)
-			}
-		}
-		run(variadicArgs...)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockSession_Log_Call) Return(_a0 []types.Log, _a1 error) *MockSession_Log_Call {
+func (_c *MockSession_State_Call) Return(_a0 *types.RepositoryState, _a1 error) *MockSession_State_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_Log_Call) RunAndReturn(run func(...git.Option) ([]types.Log, error)) *MockSession_Log_Call {
+func (_c *MockSession_State_Call) RunAndReturn(run func() (*types.RepositoryState, error)) *MockSession_State_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Merge provides a mock function with given fields: options
-func (_m *MockSession) Merge(options ...git.Option) (*types.MergeResult, error) {
+// Status provides a mock function with given fields: options
+func (_m *MockSession) Status(options ...git.Option) ([]types.File, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1406,19 +5652,19 @@ func (_m *MockSession) Merge(options ...git.Option) (*types.MergeResult, error)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Merge")
+		panic("no return value specified for Status")
 	}
 
-	var r0 *types.MergeResult
+	var r0 []types.File
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) (*types.MergeResult, error)); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.File, error)); ok {
 		return rf(options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) *types.MergeResult); ok {
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.File); ok {
 		r0 = rf(options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.MergeResult)
+			r0 = ret.Get(0).([]types.File)
 		}
 	}
 
@@ -1431,19 +5677,19 @@ func (_m *MockSession) Merge(options ...git.Option) (*types.MergeResult, error)
 	return r0, r1
 }
 
-// MockSession_Merge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Merge'
-type MockSession_Merge_Call struct {
+// MockSession_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type MockSession_Status_Call struct {
 	*mock.Call
 }
 
-// Merge is a helper method to define mock.On call
+// Status is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Merge(options ...interface{}) *MockSession_Merge_Call {
-	return &MockSession_Merge_Call{Call: _e.mock.On("Merge",
+func (_e *MockSession_Expecter) Status(options ...interface{}) *MockSession_Status_Call {
+	return &MockSession_Status_Call{Call: _e.mock.On("Status",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_Merge_Call) Run(run func(options ...git.Option)) *MockSession_Merge_Call {
+func (_c *MockSession_Status_Call) Run(run func(options ...git.Option)) *MockSession_Status_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1456,27 +5702,34 @@ func (_c *MockSession_Merge_Call) Run(run func(options ...git.Option)) *MockSess
 	return _c
 }
 
-func (_c *MockSession_Merge_Call) Return(_a0 *types.MergeResult, _a1 error) *MockSession_Merge_Call {
+func (_c *MockSession_Status_Call) Return(_a0 []types.File, _a1 error) *MockSession_Status_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_Merge_Call) RunAndReturn(run func(...git.Option) (*types.MergeResult, error)) *MockSession_Merge_Call {
+func (_c *MockSession_Status_Call) RunAndReturn(run func(...git.Option) ([]types.File, error)) *MockSession_Status_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MergeAbort provides a mock function with no fields
-func (_m *MockSession) MergeAbort() error {
-	ret := _m.Called()
+// SubmoduleAdd provides a mock function with given fields: url, path, options
+func (_m *MockSession) SubmoduleAdd(url string, path string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, url, path)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MergeAbort")
+		panic("no return value specified for SubmoduleAdd")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(url, path, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1484,44 +5737,61 @@ func (_m *MockSession) MergeAbort() error {
 	return r0
 }
 
-// MockSession_MergeAbort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeAbort'
-type MockSession_MergeAbort_Call struct {
+// MockSession_SubmoduleAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleAdd'
+type MockSession_SubmoduleAdd_Call struct {
 	*mock.Call
 }
 
-// MergeAbort is a helper method to define mock.On call
-func (_e *MockSession_Expecter) MergeAbort() *MockSession_MergeAbort_Call {
-	return &MockSession_MergeAbort_Call{Call: _e.mock.On("MergeAbort")}
+// SubmoduleAdd is a helper method to define mock.On call
+//   - url string
+//   - path string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) SubmoduleAdd(url interface{}, path interface{}, options ...interface{}) *MockSession_SubmoduleAdd_Call {
+	return &MockSession_SubmoduleAdd_Call{Call: _e.mock.On("SubmoduleAdd",
+		append([]interface{}{url, path}, options...)...)}
 }
 
-func (_c *MockSession_MergeAbort_Call) Run(run func()) *MockSession_MergeAbort_Call {
+func (_c *MockSession_SubmoduleAdd_Call) Run(run func(url string, path string, options ...git.Option)) *MockSession_SubmoduleAdd_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_MergeAbort_Call) Return(_a0 error) *MockSession_MergeAbort_Call {
+func (_c *MockSession_SubmoduleAdd_Call) Return(_a0 error) *MockSession_SubmoduleAdd_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_MergeAbort_Call) RunAndReturn(run func() error) *MockSession_MergeAbort_Call {
+func (_c *MockSession_SubmoduleAdd_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_SubmoduleAdd_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MergeContinue provides a mock function with no fields
-func (_m *MockSession) MergeContinue() error {
-	ret := _m.Called()
+// SubmoduleDeinit provides a mock function with given fields: paths, options
+func (_m *MockSession) SubmoduleDeinit(paths []string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, paths)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MergeContinue")
+		panic("no return value specified for SubmoduleDeinit")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func([]string, ...git.Option) error); ok {
+		r0 = rf(paths, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1529,62 +5799,70 @@ func (_m *MockSession) MergeContinue() error {
 	return r0
 }
 
-// MockSession_MergeContinue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MergeContinue'
-type MockSession_MergeContinue_Call struct {
+// MockSession_SubmoduleDeinit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleDeinit'
+type MockSession_SubmoduleDeinit_Call struct {
 	*mock.Call
 }
 
-// MergeContinue is a helper method to define mock.On call
-func (_e *MockSession_Expecter) MergeContinue() *MockSession_MergeContinue_Call {
-	return &MockSession_MergeContinue_Call{Call: _e.mock.On("MergeContinue")}
+// SubmoduleDeinit is a helper method to define mock.On call
+//   - paths []string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) SubmoduleDeinit(paths interface{}, options ...interface{}) *MockSession_SubmoduleDeinit_Call {
+	return &MockSession_SubmoduleDeinit_Call{Call: _e.mock.On("SubmoduleDeinit",
+		append([]interface{}{paths}, options...)...)}
 }
 
-func (_c *MockSession_MergeContinue_Call) Run(run func()) *MockSession_MergeContinue_Call {
+func (_c *MockSession_SubmoduleDeinit_Call) Run(run func(paths []string, options ...git.Option)) *MockSession_SubmoduleDeinit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].([]string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_MergeContinue_Call) Return(_a0 error) *MockSession_MergeContinue_Call {
+func (_c *MockSession_SubmoduleDeinit_Call) Return(_a0 error) *MockSession_SubmoduleDeinit_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_MergeContinue_Call) RunAndReturn(run func() error) *MockSession_MergeContinue_Call {
+func (_c *MockSession_SubmoduleDeinit_Call) RunAndReturn(run func([]string, ...git.Option) error) *MockSession_SubmoduleDeinit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Pull provides a mock function with given fields: options
-func (_m *MockSession) Pull(options ...git.Option) (*types.MergeResult, error) {
+// SubmoduleForeach provides a mock function with given fields: command, options
+func (_m *MockSession) SubmoduleForeach(command string, options ...git.Option) (string, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, command)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Pull")
+		panic("no return value specified for SubmoduleForeach")
 	}
 
-	var r0 *types.MergeResult
+	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) (*types.MergeResult, error)); ok {
-		return rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (string, error)); ok {
+		return rf(command, options...)
 	}
-	if rf, ok := ret.Get(0).(func(...git.Option) *types.MergeResult); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) string); ok {
+		r0 = rf(command, options...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.MergeResult)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(command, options...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1592,43 +5870,44 @@ func (_m *MockSession) Pull(options ...git.Option) (*types.MergeResult, error) {
 	return r0, r1
 }
 
-// MockSession_Pull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pull'
-type MockSession_Pull_Call struct {
+// MockSession_SubmoduleForeach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleForeach'
+type MockSession_SubmoduleForeach_Call struct {
 	*mock.Call
 }
 
-// Pull is a helper method to define mock.On call
+// SubmoduleForeach is a helper method to define mock.On call
+//   - command string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Pull(options ...interface{}) *MockSession_Pull_Call {
-	return &MockSession_Pull_Call{Call: _e.mock.On("Pull",
-		append([]interface{}{}, options...)...)}
+func (_e *MockSession_Expecter) SubmoduleForeach(command interface{}, options ...interface{}) *MockSession_SubmoduleForeach_Call {
+	return &MockSession_SubmoduleForeach_Call{Call: _e.mock.On("SubmoduleForeach",
+		append([]interface{}{command}, options...)...)}
 }
 
-func (_c *MockSession_Pull_Call) Run(run func(options ...git.Option)) *MockSession_Pull_Call {
+func (_c *MockSession_SubmoduleForeach_Call) Run(run func(command string, options ...git.Option)) *MockSession_SubmoduleForeach_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Pull_Call) Return(_a0 *types.MergeResult, _a1 error) *MockSession_Pull_Call {
+func (_c *MockSession_SubmoduleForeach_Call) Return(_a0 string, _a1 error) *MockSession_SubmoduleForeach_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_Pull_Call) RunAndReturn(run func(...git.Option) (*types.MergeResult, error)) *MockSession_Pull_Call {
+func (_c *MockSession_SubmoduleForeach_Call) RunAndReturn(run func(string, ...git.Option) (string, error)) *MockSession_SubmoduleForeach_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Push provides a mock function with given fields: options
-func (_m *MockSession) Push(options ...git.Option) ([]types.Remote, error) {
+// SubmoduleInit provides a mock function with given fields: options
+func (_m *MockSession) SubmoduleInit(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1638,44 +5917,32 @@ func (_m *MockSession) Push(options ...git.Option) ([]types.Remote, error) {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Push")
+		panic("no return value specified for SubmoduleInit")
 	}
 
-	var r0 []types.Remote
-	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Remote, error)); ok {
-		return rf(options...)
-	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.Remote); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
 		r0 = rf(options...)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Remote)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockSession_Push_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Push'
-type MockSession_Push_Call struct {
+// MockSession_SubmoduleInit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleInit'
+type MockSession_SubmoduleInit_Call struct {
 	*mock.Call
 }
 
-// Push is a helper method to define mock.On call
+// SubmoduleInit is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Push(options ...interface{}) *MockSession_Push_Call {
-	return &MockSession_Push_Call{Call: _e.mock.On("Push",
+func (_e *MockSession_Expecter) SubmoduleInit(options ...interface{}) *MockSession_SubmoduleInit_Call {
+	return &MockSession_SubmoduleInit_Call{Call: _e.mock.On("SubmoduleInit",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_Push_Call) Run(run func(options ...git.Option)) *MockSession_Push_Call {
+func (_c *MockSession_SubmoduleInit_Call) Run(run func(options ...git.Option)) *MockSession_SubmoduleInit_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1688,46 +5955,45 @@ func (_c *MockSession_Push_Call) Run(run func(options ...git.Option)) *MockSessi
 	return _c
 }
 
-func (_c *MockSession_Push_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_Push_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockSession_SubmoduleInit_Call) Return(_a0 error) *MockSession_SubmoduleInit_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Push_Call) RunAndReturn(run func(...git.Option) ([]types.Remote, error)) *MockSession_Push_Call {
+func (_c *MockSession_SubmoduleInit_Call) RunAndReturn(run func(...git.Option) error) *MockSession_SubmoduleInit_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PushTags provides a mock function with given fields: remote, options
-func (_m *MockSession) PushTags(remote string, options ...git.Option) ([]types.Remote, error) {
+// SubmoduleStatus provides a mock function with given fields: options
+func (_m *MockSession) SubmoduleStatus(options ...git.Option) ([]types.Submodule, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, remote)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PushTags")
+		panic("no return value specified for SubmoduleStatus")
 	}
 
-	var r0 []types.Remote
+	var r0 []types.Submodule
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) ([]types.Remote, error)); ok {
-		return rf(remote, options...)
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Submodule, error)); ok {
+		return rf(options...)
 	}
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) []types.Remote); ok {
-		r0 = rf(remote, options...)
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Submodule); ok {
+		r0 = rf(options...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.Remote)
+			r0 = ret.Get(0).([]types.Submodule)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
-		r1 = rf(remote, options...)
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1735,44 +6001,43 @@ func (_m *MockSession) PushTags(remote string, options ...git.Option) ([]types.R
 	return r0, r1
 }
 
-// MockSession_PushTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PushTags'
-type MockSession_PushTags_Call struct {
+// MockSession_SubmoduleStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleStatus'
+type MockSession_SubmoduleStatus_Call struct {
 	*mock.Call
 }
 
-// PushTags is a helper method to define mock.On call
-//   - remote string
+// SubmoduleStatus is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) PushTags(remote interface{}, options ...interface{}) *MockSession_PushTags_Call {
-	return &MockSession_PushTags_Call{Call: _e.mock.On("PushTags",
-		append([]interface{}{remote}, options...)...)}
+func (_e *MockSession_Expecter) SubmoduleStatus(options ...interface{}) *MockSession_SubmoduleStatus_Call {
+	return &MockSession_SubmoduleStatus_Call{Call: _e.mock.On("SubmoduleStatus",
+		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_PushTags_Call) Run(run func(remote string, options ...git.Option)) *MockSession_PushTags_Call {
+func (_c *MockSession_SubmoduleStatus_Call) Run(run func(options ...git.Option)) *MockSession_SubmoduleStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_PushTags_Call) Return(_a0 []types.Remote, _a1 error) *MockSession_PushTags_Call {
+func (_c *MockSession_SubmoduleStatus_Call) Return(_a0 []types.Submodule, _a1 error) *MockSession_SubmoduleStatus_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_PushTags_Call) RunAndReturn(run func(string, ...git.Option) ([]types.Remote, error)) *MockSession_PushTags_Call {
+func (_c *MockSession_SubmoduleStatus_Call) RunAndReturn(run func(...git.Option) ([]types.Submodule, error)) *MockSession_SubmoduleStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Rebase provides a mock function with given fields: options
-func (_m *MockSession) Rebase(options ...git.Option) error {
+// SubmoduleSync provides a mock function with given fields: options
+func (_m *MockSession) SubmoduleSync(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1782,7 +6047,7 @@ func (_m *MockSession) Rebase(options ...git.Option) error {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Rebase")
+		panic("no return value specified for SubmoduleSync")
 	}
 
 	var r0 error
@@ -1795,19 +6060,19 @@ func (_m *MockSession) Rebase(options ...git.Option) error {
 	return r0
 }
 
-// MockSession_Rebase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebase'
-type MockSession_Rebase_Call struct {
+// MockSession_SubmoduleSync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleSync'
+type MockSession_SubmoduleSync_Call struct {
 	*mock.Call
 }
 
-// Rebase is a helper method to define mock.On call
+// SubmoduleSync is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Rebase(options ...interface{}) *MockSession_Rebase_Call {
-	return &MockSession_Rebase_Call{Call: _e.mock.On("Rebase",
+func (_e *MockSession_Expecter) SubmoduleSync(options ...interface{}) *MockSession_SubmoduleSync_Call {
+	return &MockSession_SubmoduleSync_Call{Call: _e.mock.On("SubmoduleSync",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_Rebase_Call) Run(run func(options ...git.Option)) *MockSession_Rebase_Call {
+func (_c *MockSession_SubmoduleSync_Call) Run(run func(options ...git.Option)) *MockSession_SubmoduleSync_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1820,18 +6085,18 @@ func (_c *MockSession_Rebase_Call) Run(run func(options ...git.Option)) *MockSes
 	return _c
 }
 
-func (_c *MockSession_Rebase_Call) Return(_a0 error) *MockSession_Rebase_Call {
+func (_c *MockSession_SubmoduleSync_Call) Return(_a0 error) *MockSession_SubmoduleSync_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Rebase_Call) RunAndReturn(run func(...git.Option) error) *MockSession_Rebase_Call {
+func (_c *MockSession_SubmoduleSync_Call) RunAndReturn(run func(...git.Option) error) *MockSession_SubmoduleSync_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Reflog provides a mock function with given fields: options
-func (_m *MockSession) Reflog(options ...git.Option) error {
+// SubmoduleUpdate provides a mock function with given fields: options
+func (_m *MockSession) SubmoduleUpdate(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
@@ -1841,7 +6106,7 @@ func (_m *MockSession) Reflog(options ...git.Option) error {
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Reflog")
+		panic("no return value specified for SubmoduleUpdate")
 	}
 
 	var r0 error
@@ -1854,19 +6119,19 @@ func (_m *MockSession) Reflog(options ...git.Option) error {
 	return r0
 }
 
-// MockSession_Reflog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reflog'
-type MockSession_Reflog_Call struct {
+// MockSession_SubmoduleUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmoduleUpdate'
+type MockSession_SubmoduleUpdate_Call struct {
 	*mock.Call
 }
 
-// Reflog is a helper method to define mock.On call
+// SubmoduleUpdate is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Reflog(options ...interface{}) *MockSession_Reflog_Call {
-	return &MockSession_Reflog_Call{Call: _e.mock.On("Reflog",
+func (_e *MockSession_Expecter) SubmoduleUpdate(options ...interface{}) *MockSession_SubmoduleUpdate_Call {
+	return &MockSession_SubmoduleUpdate_Call{Call: _e.mock.On("SubmoduleUpdate",
 		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_Reflog_Call) Run(run func(options ...git.Option)) *MockSession_Reflog_Call {
+func (_c *MockSession_SubmoduleUpdate_Call) Run(run func(options ...git.Option)) *MockSession_SubmoduleUpdate_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-0)
 		for i, a := range args[0:] {
@@ -1879,33 +6144,34 @@ func (_c *MockSession_Reflog_Call) Run(run func(options ...git.Option)) *MockSes
 	return _c
 }
 
-func (_c *MockSession_Reflog_Call) Return(_a0 error) *MockSession_Reflog_Call {
+func (_c *MockSession_SubmoduleUpdate_Call) Return(_a0 error) *MockSession_SubmoduleUpdate_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Reflog_Call) RunAndReturn(run func(...git.Option) error) *MockSession_Reflog_Call {
+func (_c *MockSession_SubmoduleUpdate_Call) RunAndReturn(run func(...git.Option) error) *MockSession_SubmoduleUpdate_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Remove provides a mock function with given fields: options
-func (_m *MockSession) Remove(options ...git.Option) error {
+// Tag provides a mock function with given fields: name, options
+func (_m *MockSession) Tag(name string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, name)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Remove")
+		panic("no return value specified for Tag")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
-		r0 = rf(options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(name, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1913,59 +6179,60 @@ func (_m *MockSession) Remove(options ...git.Option) error {
 	return r0
 }
 
-// MockSession_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
-type MockSession_Remove_Call struct {
+// MockSession_Tag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tag'
+type MockSession_Tag_Call struct {
 	*mock.Call
 }
 
-// Remove is a helper method to define mock.On call
+// Tag is a helper method to define mock.On call
+//   - name string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Remove(options ...interface{}) *MockSession_Remove_Call {
-	return &MockSession_Remove_Call{Call: _e.mock.On("Remove",
-		append([]interface{}{}, options...)...)}
+func (_e *MockSession_Expecter) Tag(name interface{}, options ...interface{}) *MockSession_Tag_Call {
+	return &MockSession_Tag_Call{Call: _e.mock.On("Tag",
+		append([]interface{}{name}, options...)...)}
 }
 
-func (_c *MockSession_Remove_Call) Run(run func(options ...git.Option)) *MockSession_Remove_Call {
+func (_c *MockSession_Tag_Call) Run(run func(name string, options ...git.Option)) *MockSession_Tag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Remove_Call) Return(_a0 error) *MockSession_Remove_Call {
+func (_c *MockSession_Tag_Call) Return(_a0 error) *MockSession_Tag_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Remove_Call) RunAndReturn(run func(...git.Option) error) *MockSession_Remove_Call {
+func (_c *MockSession_Tag_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_Tag_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveRemote provides a mock function with given fields: name, options
-func (_m *MockSession) RemoveRemote(name string, options ...git.Option) error {
+// UnsetConfig provides a mock function with given fields: key, options
+func (_m *MockSession) UnsetConfig(key string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, name)
+	_ca = append(_ca, key)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveRemote")
+		panic("no return value specified for UnsetConfig")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(name, options...)
+		r0 = rf(key, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1973,20 +6240,20 @@ func (_m *MockSession) RemoveRemote(name string, options ...git.Option) error {
 	return r0
 }
 
-// MockSession_RemoveRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveRemote'
-type MockSession_RemoveRemote_Call struct {
+// MockSession_UnsetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetConfig'
+type MockSession_UnsetConfig_Call struct {
 	*mock.Call
 }
 
-// RemoveRemote is a helper method to define mock.On call
-//   - name string
+// UnsetConfig is a helper method to define mock.On call
+//   - key string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) RemoveRemote(name interface{}, options ...interface{}) *MockSession_RemoveRemote_Call {
-	return &MockSession_RemoveRemote_Call{Call: _e.mock.On("RemoveRemote",
-		append([]interface{}{name}, options...)...)}
+func (_e *MockSession_Expecter) UnsetConfig(key interface{}, options ...interface{}) *MockSession_UnsetConfig_Call {
+	return &MockSession_UnsetConfig_Call{Call: _e.mock.On("UnsetConfig",
+		append([]interface{}{key}, options...)...)}
 }
 
-func (_c *MockSession_RemoveRemote_Call) Run(run func(name string, options ...git.Option)) *MockSession_RemoveRemote_Call {
+func (_c *MockSession_UnsetConfig_Call) Run(run func(key string, options ...git.Option)) *MockSession_UnsetConfig_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -1999,34 +6266,34 @@ func (_c *MockSession_RemoveRemote_Call) Run(run func(name string, options ...gi
 	return _c
 }
 
-func (_c *MockSession_RemoveRemote_Call) Return(_a0 error) *MockSession_RemoveRemote_Call {
+func (_c *MockSession_UnsetConfig_Call) Return(_a0 error) *MockSession_UnsetConfig_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_RemoveRemote_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_RemoveRemote_Call {
+func (_c *MockSession_UnsetConfig_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_UnsetConfig_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Reset provides a mock function with given fields: files, options
-func (_m *MockSession) Reset(files []string, options ...git.Option) error {
+// UnsetUpstream provides a mock function with given fields: branch, options
+func (_m *MockSession) UnsetUpstream(branch string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, files)
+	_ca = append(_ca, branch)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Reset")
+		panic("no return value specified for UnsetUpstream")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]string, ...git.Option) error); ok {
-		r0 = rf(files, options...)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(branch, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2034,20 +6301,20 @@ func (_m *MockSession) Reset(files []string, options ...git.Option) error {
 	return r0
 }
 
-// MockSession_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
-type MockSession_Reset_Call struct {
+// MockSession_UnsetUpstream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetUpstream'
+type MockSession_UnsetUpstream_Call struct {
 	*mock.Call
 }
 
-// Reset is a helper method to define mock.On call
-//   - files []string
+// UnsetUpstream is a helper method to define mock.On call
+//   - branch string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Reset(files interface{}, options ...interface{}) *MockSession_Reset_Call {
-	return &MockSession_Reset_Call{Call: _e.mock.On("Reset",
-		append([]interface{}{files}, options...)...)}
+func (_e *MockSession_Expecter) UnsetUpstream(branch interface{}, options ...interface{}) *MockSession_UnsetUpstream_Call {
+	return &MockSession_UnsetUpstream_Call{Call: _e.mock.On("UnsetUpstream",
+		append([]interface{}{branch}, options...)...)}
 }
 
-func (_c *MockSession_Reset_Call) Run(run func(files []string, options ...git.Option)) *MockSession_Reset_Call {
+func (_c *MockSession_UnsetUpstream_Call) Run(run func(branch string, options ...git.Option)) *MockSession_UnsetUpstream_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -2055,32 +6322,32 @@ func (_c *MockSession_Reset_Call) Run(run func(files []string, options ...git.Op
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].([]string), variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Reset_Call) Return(_a0 error) *MockSession_Reset_Call {
+func (_c *MockSession_UnsetUpstream_Call) Return(_a0 error) *MockSession_UnsetUpstream_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Reset_Call) RunAndReturn(run func([]string, ...git.Option) error) *MockSession_Reset_Call {
+func (_c *MockSession_UnsetUpstream_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_UnsetUpstream_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResolveConflicts provides a mock function with given fields: resolutions
-func (_m *MockSession) ResolveConflicts(resolutions []types.ConflictResolution) error {
-	ret := _m.Called(resolutions)
+// UpdateUser provides a mock function with given fields: name, email
+func (_m *MockSession) UpdateUser(name string, email string) error {
+	ret := _m.Called(name, email)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ResolveConflicts")
+		panic("no return value specified for UpdateUser")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func([]types.ConflictResolution) error); ok {
-		r0 = rf(resolutions)
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(name, email)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2088,173 +6355,222 @@ func (_m *MockSession) ResolveConflicts(resolutions []types.ConflictResolution)
 	return r0
 }
 
-// MockSession_ResolveConflicts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveConflicts'
-type MockSession_ResolveConflicts_Call struct {
+// MockSession_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
+type MockSession_UpdateUser_Call struct {
 	*mock.Call
 }
 
-// ResolveConflicts is a helper method to define mock.On call
-//   - resolutions []types.ConflictResolution
-func (_e *MockSession_Expecter) ResolveConflicts(resolutions interface{}) *MockSession_ResolveConflicts_Call {
-	return &MockSession_ResolveConflicts_Call{Call: _e.mock.On("ResolveConflicts", resolutions)}
+// UpdateUser is a helper method to define mock.On call
+//   - name string
+//   - email string
+func (_e *MockSession_Expecter) UpdateUser(name interface{}, email interface{}) *MockSession_UpdateUser_Call {
+	return &MockSession_UpdateUser_Call{Call: _e.mock.On("UpdateUser", name, email)}
 }
 
-func (_c *MockSession_ResolveConflicts_Call) Run(run func(resolutions []types.ConflictResolution)) *MockSession_ResolveConflicts_Call {
+func (_c *MockSession_UpdateUser_Call) Run(run func(name string, email string)) *MockSession_UpdateUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].([]types.ConflictResolution))
+		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockSession_ResolveConflicts_Call) Return(_a0 error) *MockSession_ResolveConflicts_Call {
+func (_c *MockSession_UpdateUser_Call) Return(_a0 error) *MockSession_UpdateUser_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_ResolveConflicts_Call) RunAndReturn(run func([]types.ConflictResolution) error) *MockSession_ResolveConflicts_Call {
+func (_c *MockSession_UpdateUser_Call) RunAndReturn(run func(string, string) error) *MockSession_UpdateUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Revert provides a mock function with given fields: options
-func (_m *MockSession) Revert(options ...git.Option) error {
+// VerifyTag provides a mock function with given fields: name, options
+func (_m *MockSession) VerifyTag(name string, options ...git.Option) (*types.CommitSignature, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, name)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Revert")
+		panic("no return value specified for VerifyTag")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
-		r0 = rf(options...)
+	var r0 *types.CommitSignature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.CommitSignature, error)); ok {
+		return rf(name, options...)
+	}
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.CommitSignature); ok {
+		r0 = rf(name, options...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.CommitSignature)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
+		r1 = rf(name, options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockSession_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
-type MockSession_Revert_Call struct {
+// MockSession_VerifyTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyTag'
+type MockSession_VerifyTag_Call struct {
 	*mock.Call
 }
 
-// Revert is a helper method to define mock.On call
+// VerifyTag is a helper method to define mock.On call
+//   - name string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Revert(options ...interface{}) *MockSession_Revert_Call {
-	return &MockSession_Revert_Call{Call: _e.mock.On("Revert",
-		append([]interface{}{}, options...)...)}
+func (_e *MockSession_Expecter) VerifyTag(name interface{}, options ...interface{}) *MockSession_VerifyTag_Call {
+	return &MockSession_VerifyTag_Call{Call: _e.mock.On("VerifyTag",
+		append([]interface{}{name}, options...)...)}
 }
 
-func (_c *MockSession_Revert_Call) Run(run func(options ...git.Option)) *MockSession_Revert_Call {
+func (_c *MockSession_VerifyTag_Call) Run(run func(name string, options ...git.Option)) *MockSession_VerifyTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Revert_Call) Return(_a0 error) *MockSession_Revert_Call {
-	_c.Call.Return(_a0)
+func (_c *MockSession_VerifyTag_Call) Return(_a0 *types.CommitSignature, _a1 error) *MockSession_VerifyTag_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_Revert_Call) RunAndReturn(run func(...git.Option) error) *MockSession_Revert_Call {
+func (_c *MockSession_VerifyTag_Call) RunAndReturn(run func(string, ...git.Option) (*types.CommitSignature, error)) *MockSession_VerifyTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetConfig provides a mock function with given fields: key, value, options
-func (_m *MockSession) SetConfig(key string, value string, options ...git.Option) error {
-	_va := make([]interface{}, len(options))
-	for _i := range options {
-		_va[_i] = options[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, key, value)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// WithContext provides a mock function with given fields: ctx
+func (_m *MockSession) WithContext(ctx context.Context) git.Git {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetConfig")
+		panic("no return value specified for WithContext")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(key, value, options...)
+	var r0 git.Git
+	if rf, ok := ret.Get(0).(func(context.Context) git.Git); ok {
+		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(git.Git)
+		}
 	}
 
 	return r0
 }
 
-// MockSession_SetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConfig'
-type MockSession_SetConfig_Call struct {
+// MockSession_WithContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithContext'
+type MockSession_WithContext_Call struct {
 	*mock.Call
 }
 
-// SetConfig is a helper method to define mock.On call
-//   - key string
-//   - value string
-//   - options ...git.Option
-func (_e *MockSession_Expecter) SetConfig(key interface{}, value interface{}, options ...interface{}) *MockSession_SetConfig_Call {
-	return &MockSession_SetConfig_Call{Call: _e.mock.On("SetConfig",
-		append([]interface{}{key, value}, options...)...)}
+// WithContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSession_Expecter) WithContext(ctx interface{}) *MockSession_WithContext_Call {
+	return &MockSession_WithContext_Call{Call: _e.mock.On("WithContext", ctx)}
 }
 
-func (_c *MockSession_SetConfig_Call) Run(run func(key string, value string, options ...git.Option)) *MockSession_SetConfig_Call {
+func (_c *MockSession_WithContext_Call) Run(run func(ctx context.Context)) *MockSession_WithContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-2)
-		for i, a := range args[2:] {
-			if a != nil {
-				variadicArgs[i] = a.(git.Option)
-			}
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockSession_WithContext_Call) Return(_a0 git.Git) *MockSession_WithContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSession_WithContext_Call) RunAndReturn(run func(context.Context) git.Git) *MockSession_WithContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithWorktree provides a mock function with given fields: path
+func (_m *MockSession) WithWorktree(path string) git.Git {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithWorktree")
+	}
+
+	var r0 git.Git
+	if rf, ok := ret.Get(0).(func(string) git.Git); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(git.Git)
 		}
-		run(args[0].(string), args[1].(string), variadicArgs...)
+	}
+
+	return r0
+}
+
+// MockSession_WithWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithWorktree'
+type MockSession_WithWorktree_Call struct {
+	*mock.Call
+}
+
+// WithWorktree is a helper method to define mock.On call
+//   - path string
+func (_e *MockSession_Expecter) WithWorktree(path interface{}) *MockSession_WithWorktree_Call {
+	return &MockSession_WithWorktree_Call{Call: _e.mock.On("WithWorktree", path)}
+}
+
+func (_c *MockSession_WithWorktree_Call) Run(run func(path string)) *MockSession_WithWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
 	})
 	return _c
 }
 
-func (_c *MockSession_SetConfig_Call) Return(_a0 error) *MockSession_SetConfig_Call {
+func (_c *MockSession_WithWorktree_Call) Return(_a0 git.Git) *MockSession_WithWorktree_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_SetConfig_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_SetConfig_Call {
+func (_c *MockSession_WithWorktree_Call) RunAndReturn(run func(string) git.Git) *MockSession_WithWorktree_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetRemoteURL provides a mock function with given fields: name, url, options
-func (_m *MockSession) SetRemoteURL(name string, url string, options ...git.Option) error {
+// WorktreeAdd provides a mock function with given fields: path, commitish, options
+func (_m *MockSession) WorktreeAdd(path string, commitish string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, name, url)
+	_ca = append(_ca, path, commitish)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetRemoteURL")
+		panic("no return value specified for WorktreeAdd")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(name, url, options...)
+		r0 = rf(path, commitish, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2262,21 +6578,21 @@ func (_m *MockSession) SetRemoteURL(name string, url string, options ...git.Opti
 	return r0
 }
 
-// MockSession_SetRemoteURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRemoteURL'
-type MockSession_SetRemoteURL_Call struct {
+// MockSession_WorktreeAdd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeAdd'
+type MockSession_WorktreeAdd_Call struct {
 	*mock.Call
 }
 
-// SetRemoteURL is a helper method to define mock.On call
-//   - name string
-//   - url string
+// WorktreeAdd is a helper method to define mock.On call
+//   - path string
+//   - commitish string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) SetRemoteURL(name interface{}, url interface{}, options ...interface{}) *MockSession_SetRemoteURL_Call {
-	return &MockSession_SetRemoteURL_Call{Call: _e.mock.On("SetRemoteURL",
-		append([]interface{}{name, url}, options...)...)}
+func (_e *MockSession_Expecter) WorktreeAdd(path interface{}, commitish interface{}, options ...interface{}) *MockSession_WorktreeAdd_Call {
+	return &MockSession_WorktreeAdd_Call{Call: _e.mock.On("WorktreeAdd",
+		append([]interface{}{path, commitish}, options...)...)}
 }
 
-func (_c *MockSession_SetRemoteURL_Call) Run(run func(name string, url string, options ...git.Option)) *MockSession_SetRemoteURL_Call {
+func (_c *MockSession_WorktreeAdd_Call) Run(run func(path string, commitish string, options ...git.Option)) *MockSession_WorktreeAdd_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-2)
 		for i, a := range args[2:] {
@@ -2289,162 +6605,126 @@ func (_c *MockSession_SetRemoteURL_Call) Run(run func(name string, url string, o
 	return _c
 }
 
-func (_c *MockSession_SetRemoteURL_Call) Return(_a0 error) *MockSession_SetRemoteURL_Call {
+func (_c *MockSession_WorktreeAdd_Call) Return(_a0 error) *MockSession_WorktreeAdd_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_SetRemoteURL_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_SetRemoteURL_Call {
+func (_c *MockSession_WorktreeAdd_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_WorktreeAdd_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetUpstream provides a mock function with given fields: branch, remote, options
-func (_m *MockSession) SetUpstream(branch string, remote string, options ...git.Option) error {
+// WorktreeList provides a mock function with given fields: options
+func (_m *MockSession) WorktreeList(options ...git.Option) ([]types.Worktree, error) {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, branch, remote)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetUpstream")
+		panic("no return value specified for WorktreeList")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
-		r0 = rf(branch, remote, options...)
+	var r0 []types.Worktree
+	var r1 error
+	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.Worktree, error)); ok {
+		return rf(options...)
+	}
+	if rf, ok := ret.Get(0).(func(...git.Option) []types.Worktree); ok {
+		r0 = rf(options...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.Worktree)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
+		r1 = rf(options...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockSession_SetUpstream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpstream'
-type MockSession_SetUpstream_Call struct {
+// MockSession_WorktreeList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeList'
+type MockSession_WorktreeList_Call struct {
 	*mock.Call
 }
 
-// SetUpstream is a helper method to define mock.On call
-//   - branch string
-//   - remote string
+// WorktreeList is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) SetUpstream(branch interface{}, remote interface{}, options ...interface{}) *MockSession_SetUpstream_Call {
-	return &MockSession_SetUpstream_Call{Call: _e.mock.On("SetUpstream",
-		append([]interface{}{branch, remote}, options...)...)}
+func (_e *MockSession_Expecter) WorktreeList(options ...interface{}) *MockSession_WorktreeList_Call {
+	return &MockSession_WorktreeList_Call{Call: _e.mock.On("WorktreeList",
+		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_SetUpstream_Call) Run(run func(branch string, remote string, options ...git.Option)) *MockSession_SetUpstream_Call {
+func (_c *MockSession_WorktreeList_Call) Run(run func(options ...git.Option)) *MockSession_WorktreeList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-2)
-		for i, a := range args[2:] {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), args[1].(string), variadicArgs...)
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_SetUpstream_Call) Return(_a0 error) *MockSession_SetUpstream_Call {
-	_c.Call.Return(_a0)
+func (_c *MockSession_WorktreeList_Call) Return(_a0 []types.Worktree, _a1 error) *MockSession_WorktreeList_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockSession_SetUpstream_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_SetUpstream_Call {
+func (_c *MockSession_WorktreeList_Call) RunAndReturn(run func(...git.Option) ([]types.Worktree, error)) *MockSession_WorktreeList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetWorkingDirectory provides a mock function with given fields: wd
-func (_m *MockSession) SetWorkingDirectory(wd string) {
-	_m.Called(wd)
-}
-
-// MockSession_SetWorkingDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorkingDirectory'
-type MockSession_SetWorkingDirectory_Call struct {
-	*mock.Call
-}
-
-// SetWorkingDirectory is a helper method to define mock.On call
-//   - wd string
-func (_e *MockSession_Expecter) SetWorkingDirectory(wd interface{}) *MockSession_SetWorkingDirectory_Call {
-	return &MockSession_SetWorkingDirectory_Call{Call: _e.mock.On("SetWorkingDirectory", wd)}
-}
-
-func (_c *MockSession_SetWorkingDirectory_Call) Run(run func(wd string)) *MockSession_SetWorkingDirectory_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
-	})
-	return _c
-}
-
-func (_c *MockSession_SetWorkingDirectory_Call) Return() *MockSession_SetWorkingDirectory_Call {
-	_c.Call.Return()
-	return _c
-}
-
-func (_c *MockSession_SetWorkingDirectory_Call) RunAndReturn(run func(string)) *MockSession_SetWorkingDirectory_Call {
-	_c.Run(run)
-	return _c
-}
-
-// Show provides a mock function with given fields: object, options
-func (_m *MockSession) Show(object string, options ...git.Option) (*types.Log, error) {
+// WorktreeLock provides a mock function with given fields: path, options
+func (_m *MockSession) WorktreeLock(path string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, object)
+	_ca = append(_ca, path)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Show")
-	}
-
-	var r0 *types.Log
-	var r1 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) (*types.Log, error)); ok {
-		return rf(object, options...)
-	}
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) *types.Log); ok {
-		r0 = rf(object, options...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*types.Log)
-		}
+		panic("no return value specified for WorktreeLock")
 	}
 
-	if rf, ok := ret.Get(1).(func(string, ...git.Option) error); ok {
-		r1 = rf(object, options...)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(path, options...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockSession_Show_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Show'
-type MockSession_Show_Call struct {
+// MockSession_WorktreeLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeLock'
+type MockSession_WorktreeLock_Call struct {
 	*mock.Call
 }
 
-// Show is a helper method to define mock.On call
-//   - object string
+// WorktreeLock is a helper method to define mock.On call
+//   - path string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Show(object interface{}, options ...interface{}) *MockSession_Show_Call {
-	return &MockSession_Show_Call{Call: _e.mock.On("Show",
-		append([]interface{}{object}, options...)...)}
+func (_e *MockSession_Expecter) WorktreeLock(path interface{}, options ...interface{}) *MockSession_WorktreeLock_Call {
+	return &MockSession_WorktreeLock_Call{Call: _e.mock.On("WorktreeLock",
+		append([]interface{}{path}, options...)...)}
 }
 
-func (_c *MockSession_Show_Call) Run(run func(object string, options ...git.Option)) *MockSession_Show_Call {
+func (_c *MockSession_WorktreeLock_Call) Run(run func(path string, options ...git.Option)) *MockSession_WorktreeLock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -2457,105 +6737,95 @@ func (_c *MockSession_Show_Call) Run(run func(object string, options ...git.Opti
 	return _c
 }
 
-func (_c *MockSession_Show_Call) Return(_a0 *types.Log, _a1 error) *MockSession_Show_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockSession_WorktreeLock_Call) Return(_a0 error) *MockSession_WorktreeLock_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Show_Call) RunAndReturn(run func(string, ...git.Option) (*types.Log, error)) *MockSession_Show_Call {
+func (_c *MockSession_WorktreeLock_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_WorktreeLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Status provides a mock function with given fields: options
-func (_m *MockSession) Status(options ...git.Option) ([]types.File, error) {
+// WorktreeMove provides a mock function with given fields: path, newPath, options
+func (_m *MockSession) WorktreeMove(path string, newPath string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
+	_ca = append(_ca, path, newPath)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Status")
-	}
-
-	var r0 []types.File
-	var r1 error
-	if rf, ok := ret.Get(0).(func(...git.Option) ([]types.File, error)); ok {
-		return rf(options...)
-	}
-	if rf, ok := ret.Get(0).(func(...git.Option) []types.File); ok {
-		r0 = rf(options...)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]types.File)
-		}
+		panic("no return value specified for WorktreeMove")
 	}
 
-	if rf, ok := ret.Get(1).(func(...git.Option) error); ok {
-		r1 = rf(options...)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, ...git.Option) error); ok {
+		r0 = rf(path, newPath, options...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockSession_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
-type MockSession_Status_Call struct {
+// MockSession_WorktreeMove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeMove'
+type MockSession_WorktreeMove_Call struct {
 	*mock.Call
 }
 
-// Status is a helper method to define mock.On call
+// WorktreeMove is a helper method to define mock.On call
+//   - path string
+//   - newPath string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Status(options ...interface{}) *MockSession_Status_Call {
-	return &MockSession_Status_Call{Call: _e.mock.On("Status",
-		append([]interface{}{}, options...)...)}
+func (_e *MockSession_Expecter) WorktreeMove(path interface{}, newPath interface{}, options ...interface{}) *MockSession_WorktreeMove_Call {
+	return &MockSession_WorktreeMove_Call{Call: _e.mock.On("WorktreeMove",
+		append([]interface{}{path, newPath}, options...)...)}
 }
 
-func (_c *MockSession_Status_Call) Run(run func(options ...git.Option)) *MockSession_Status_Call {
+func (_c *MockSession_WorktreeMove_Call) Run(run func(path string, newPath string, options ...git.Option)) *MockSession_WorktreeMove_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-0)
-		for i, a := range args[0:] {
+		variadicArgs := make([]git.Option, len(args)-2)
+		for i, a := range args[2:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(variadicArgs...)
+		run(args[0].(string), args[1].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Status_Call) Return(_a0 []types.File, _a1 error) *MockSession_Status_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockSession_WorktreeMove_Call) Return(_a0 error) *MockSession_WorktreeMove_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Status_Call) RunAndReturn(run func(...git.Option) ([]types.File, error)) *MockSession_Status_Call {
+func (_c *MockSession_WorktreeMove_Call) RunAndReturn(run func(string, string, ...git.Option) error) *MockSession_WorktreeMove_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Tag provides a mock function with given fields: name, options
-func (_m *MockSession) Tag(name string, options ...git.Option) error {
+// WorktreePrune provides a mock function with given fields: options
+func (_m *MockSession) WorktreePrune(options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, name)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Tag")
+		panic("no return value specified for WorktreePrune")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(name, options...)
+	if rf, ok := ret.Get(0).(func(...git.Option) error); ok {
+		r0 = rf(options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2563,60 +6833,59 @@ func (_m *MockSession) Tag(name string, options ...git.Option) error {
 	return r0
 }
 
-// MockSession_Tag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tag'
-type MockSession_Tag_Call struct {
+// MockSession_WorktreePrune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreePrune'
+type MockSession_WorktreePrune_Call struct {
 	*mock.Call
 }
 
-// Tag is a helper method to define mock.On call
-//   - name string
+// WorktreePrune is a helper method to define mock.On call
 //   - options ...git.Option
-func (_e *MockSession_Expecter) Tag(name interface{}, options ...interface{}) *MockSession_Tag_Call {
-	return &MockSession_Tag_Call{Call: _e.mock.On("Tag",
-		append([]interface{}{name}, options...)...)}
+func (_e *MockSession_Expecter) WorktreePrune(options ...interface{}) *MockSession_WorktreePrune_Call {
+	return &MockSession_WorktreePrune_Call{Call: _e.mock.On("WorktreePrune",
+		append([]interface{}{}, options...)...)}
 }
 
-func (_c *MockSession_Tag_Call) Run(run func(name string, options ...git.Option)) *MockSession_Tag_Call {
+func (_c *MockSession_WorktreePrune_Call) Run(run func(options ...git.Option)) *MockSession_WorktreePrune_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]git.Option, len(args)-1)
-		for i, a := range args[1:] {
+		variadicArgs := make([]git.Option, len(args)-0)
+		for i, a := range args[0:] {
 			if a != nil {
 				variadicArgs[i] = a.(git.Option)
 			}
 		}
-		run(args[0].(string), variadicArgs...)
+		run(variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_Tag_Call) Return(_a0 error) *MockSession_Tag_Call {
+func (_c *MockSession_WorktreePrune_Call) Return(_a0 error) *MockSession_WorktreePrune_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_Tag_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_Tag_Call {
+func (_c *MockSession_WorktreePrune_Call) RunAndReturn(run func(...git.Option) error) *MockSession_WorktreePrune_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UnsetConfig provides a mock function with given fields: key, options
-func (_m *MockSession) UnsetConfig(key string, options ...git.Option) error {
+// WorktreeRemove provides a mock function with given fields: path, options
+func (_m *MockSession) WorktreeRemove(path string, options ...git.Option) error {
 	_va := make([]interface{}, len(options))
 	for _i := range options {
 		_va[_i] = options[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, key)
+	_ca = append(_ca, path)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UnsetConfig")
+		panic("no return value specified for WorktreeRemove")
 	}
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
-		r0 = rf(key, options...)
+		r0 = rf(path, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2624,20 +6893,20 @@ func (_m *MockSession) UnsetConfig(key string, options ...git.Option) error {
 	return r0
 }
 
-// MockSession_UnsetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetConfig'
-type MockSession_UnsetConfig_Call struct {
+// MockSession_WorktreeRemove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeRemove'
+type MockSession_WorktreeRemove_Call struct {
 	*mock.Call
 }
 
-// UnsetConfig is a helper method to define mock.On call
-//   - key string
+// WorktreeRemove is a helper method to define mock.On call
+//   - path string
 //   - options ...git.Option
-func (_e *MockSession_Expecter) UnsetConfig(key interface{}, options ...interface{}) *MockSession_UnsetConfig_Call {
-	return &MockSession_UnsetConfig_Call{Call: _e.mock.On("UnsetConfig",
-		append([]interface{}{key}, options...)...)}
+func (_e *MockSession_Expecter) WorktreeRemove(path interface{}, options ...interface{}) *MockSession_WorktreeRemove_Call {
+	return &MockSession_WorktreeRemove_Call{Call: _e.mock.On("WorktreeRemove",
+		append([]interface{}{path}, options...)...)}
 }
 
-func (_c *MockSession_UnsetConfig_Call) Run(run func(key string, options ...git.Option)) *MockSession_UnsetConfig_Call {
+func (_c *MockSession_WorktreeRemove_Call) Run(run func(path string, options ...git.Option)) *MockSession_WorktreeRemove_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]git.Option, len(args)-1)
 		for i, a := range args[1:] {
@@ -2650,27 +6919,34 @@ func (_c *MockSession_UnsetConfig_Call) Run(run func(key string, options ...git.
 	return _c
 }
 
-func (_c *MockSession_UnsetConfig_Call) Return(_a0 error) *MockSession_UnsetConfig_Call {
+func (_c *MockSession_WorktreeRemove_Call) Return(_a0 error) *MockSession_WorktreeRemove_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_UnsetConfig_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_UnsetConfig_Call {
+func (_c *MockSession_WorktreeRemove_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_WorktreeRemove_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateUser provides a mock function with given fields: name, email
-func (_m *MockSession) UpdateUser(name string, email string) error {
-	ret := _m.Called(name, email)
+// WorktreeUnlock provides a mock function with given fields: path, options
+func (_m *MockSession) WorktreeUnlock(path string, options ...git.Option) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, path)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
+		panic("no return value specified for WorktreeUnlock")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = rf(name, email)
+	if rf, ok := ret.Get(0).(func(string, ...git.Option) error); ok {
+		r0 = rf(path, options...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -2678,31 +6954,38 @@ func (_m *MockSession) UpdateUser(name string, email string) error {
 	return r0
 }
 
-// MockSession_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type MockSession_UpdateUser_Call struct {
+// MockSession_WorktreeUnlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorktreeUnlock'
+type MockSession_WorktreeUnlock_Call struct {
 	*mock.Call
 }
 
-// UpdateUser is a helper method to define mock.On call
-//   - name string
-//   - email string
-func (_e *MockSession_Expecter) UpdateUser(name interface{}, email interface{}) *MockSession_UpdateUser_Call {
-	return &MockSession_UpdateUser_Call{Call: _e.mock.On("UpdateUser", name, email)}
+// WorktreeUnlock is a helper method to define mock.On call
+//   - path string
+//   - options ...git.Option
+func (_e *MockSession_Expecter) WorktreeUnlock(path interface{}, options ...interface{}) *MockSession_WorktreeUnlock_Call {
+	return &MockSession_WorktreeUnlock_Call{Call: _e.mock.On("WorktreeUnlock",
+		append([]interface{}{path}, options...)...)}
 }
 
-func (_c *MockSession_UpdateUser_Call) Run(run func(name string, email string)) *MockSession_UpdateUser_Call {
+func (_c *MockSession_WorktreeUnlock_Call) Run(run func(path string, options ...git.Option)) *MockSession_WorktreeUnlock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		variadicArgs := make([]git.Option, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(git.Option)
+			}
+		}
+		run(args[0].(string), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockSession_UpdateUser_Call) Return(_a0 error) *MockSession_UpdateUser_Call {
+func (_c *MockSession_WorktreeUnlock_Call) Return(_a0 error) *MockSession_WorktreeUnlock_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockSession_UpdateUser_Call) RunAndReturn(run func(string, string) error) *MockSession_UpdateUser_Call {
+func (_c *MockSession_WorktreeUnlock_Call) RunAndReturn(run func(string, ...git.Option) error) *MockSession_WorktreeUnlock_Call {
 	_c.Call.Return(run)
 	return _c
 }
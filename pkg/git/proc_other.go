@@ -0,0 +1,11 @@
+//go:build !unix
+
+package git
+
+import (
+	"os/exec"
+)
+
+// setProcessGroup is a no-op on platforms without process groups; cancellation
+// kills only the git process itself
+func setProcessGroup(cmd *exec.Cmd) {}
@@ -1,31 +1,26 @@
 package git
 
 import (
-	"strings"
-	"github.com/instruqt/git-exec/pkg/git/errors"
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
-// Pull pulls changes from the remote repository
+// Pull pulls changes from the remote repository. When the merge stops on
+// conflicts the error is returned together with the conflicted files
 func (g *gitImpl) Pull(opts ...Option) (*types.MergeResult, error) {
 	cmd := g.newCommand("pull")
 	cmd.ApplyOptions(opts...)
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+
+	result := &types.MergeResult{}
+	result.BaseBranch = g.currentBranch()
+	base := g.revParse("HEAD")
+
 	output, err := cmd.Execute()
-	
-	result := &types.MergeResult{Success: err == nil}
 	if err != nil {
-		if gitErr, ok := err.(*errors.GitError); ok {
-			if strings.Contains(gitErr.Stderr, "CONFLICT") {
-				result.Success = false
-				result.ConflictedFiles = strings.Fields(gitErr.Stderr)
-			}
-		}
+		g.collectConflicts(result, err)
 		return result, err
 	}
-	
-	outputStr := string(output)
-	if strings.Contains(outputStr, "Fast-forward") {
-		result.FastForward = true
-	}
+
+	g.completeMerge(result, base, string(output))
 	return result, nil
-}
\ No newline at end of file
+}
@@ -1,16 +1,93 @@
 package git
 
 import (
+	stderrors "errors"
+	"regexp"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/errors"
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
-// Push pushes changes to the remote repository
+// Push pushes changes to the remote repository. When the remote rejects some
+// of the refs an errors.PushRejectedError carrying the per-ref result is returned
 func (g *gitImpl) Push(opts ...Option) ([]types.Remote, error) {
-	cmd := g.newCommand("push")
+	cmd := g.newCommand("push", "--porcelain")
 	cmd.ApplyOptions(opts...)
-	_, err := cmd.ExecuteWithStderr()
+	return g.executePush(cmd)
+}
+
+// executePush runs a porcelain push and parses the per-ref result
+func (g *gitImpl) executePush(cmd Command) ([]types.Remote, error) {
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+	output, err := cmd.Execute()
 	if err != nil {
-		return nil, err
+		var gitErr *errors.GitError
+		if !stderrors.As(err, &gitErr) {
+			return nil, err
+		}
+		output = []byte(gitErr.Stdout)
+	}
+
+	remotes := g.resolveRemoteNames(parsePushPorcelain(string(output)))
+	if err == nil {
+		return remotes, nil
+	}
+
+	rejected := []types.Ref{}
+	for _, remote := range remotes {
+		for _, ref := range remote.Refs {
+			if ref.Status == types.RefStatusRejected {
+				rejected = append(rejected, ref)
+			}
+		}
+	}
+	if len(rejected) == 0 {
+		return remotes, err
+	}
+
+	var gitErr *errors.GitError
+	stderrors.As(err, &gitErr)
+	return remotes, &errors.PushRejectedError{
+		GitError: gitErr,
+		Remotes:  remotes,
+		Rejected: rejected,
+	}
+}
+
+var pushPorcelainPattern = regexp.MustCompile(`^(.)\t([^\t]*):([^\t]*)\t(\[[^\]]+\]|[0-9a-f]+\.\.\.?[0-9a-f]+)(?: \((.+)\))?$`)
+
+// parsePushPorcelain parses `git push --porcelain` output, starting a new
+// remote for every "To <url>" line:
+// <flag> \t <from>:<to> \t <summary> (<reason>)
+func parsePushPorcelain(output string) []types.Remote {
+	remotes := []types.Remote{}
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "To ") {
+			remotes = append(remotes, types.Remote{URL: strings.TrimPrefix(line, "To ")})
+			continue
+		}
+		m := pushPorcelainPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		ref := types.Ref{
+			Status:  refStatusFromFlag(m[1]),
+			Summary: m[4],
+			From:    m[2],
+			To:      m[3],
+		}
+		ref.OldSHA, ref.NewSHA = summarySHAs(m[4])
+		if m[5] != "" {
+			reason := m[5]
+			ref.Reason = &reason
+		}
+
+		if len(remotes) == 0 {
+			remotes = append(remotes, types.Remote{})
+		}
+		remotes[len(remotes)-1].Refs = append(remotes[len(remotes)-1].Refs, ref)
 	}
-	return []types.Remote{}, nil
-}
\ No newline at end of file
+	return remotes
+}
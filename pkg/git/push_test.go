@@ -0,0 +1,86 @@
+package git_test
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test push results for new, updated and deleted refs
+func TestPushResults(t *testing.T) {
+	remoteDir, upstreamDir, _ := setupRemotePair(t)
+	upstream := openTestRepo(t, upstreamDir)
+
+	require.NoError(t, upstream.CreateBranch("feature"))
+	require.NoError(t, upstream.Commit("Second commit", git.CommitWithAllowEmpty()))
+
+	remotes, err := upstream.Push(git.PushWithRemote("origin", "main", "feature"))
+	require.NoError(t, err)
+	require.Len(t, remotes, 1)
+	assert.Equal(t, "origin", remotes[0].Name)
+	assert.Equal(t, remoteDir, remotes[0].URL)
+	require.Len(t, remotes[0].Refs, 2)
+
+	refs := make(map[string]types.Ref)
+	for _, ref := range remotes[0].Refs {
+		refs[ref.To] = ref
+	}
+	main := refs["refs/heads/main"]
+	assert.Equal(t, types.RefStatusFastForward, main.Status)
+	assert.Equal(t, "refs/heads/main", main.From)
+	assert.NotEmpty(t, main.OldSHA)
+	assert.NotEmpty(t, main.NewSHA)
+	assert.Equal(t, types.RefStatusNew, refs["refs/heads/feature"].Status)
+	assert.Equal(t, "[new branch]", refs["refs/heads/feature"].Summary)
+
+	remotes, err = upstream.Push(git.PushWithRemote("origin", ":feature"))
+	require.NoError(t, err)
+	require.Len(t, remotes[0].Refs, 1)
+	assert.Equal(t, types.RefStatusPruned, remotes[0].Refs[0].Status)
+	assert.Equal(t, "[deleted]", remotes[0].Refs[0].Summary)
+}
+
+// Test that partially rejected pushes return a typed error with the per-ref result
+func TestPushRejected(t *testing.T) {
+	_, upstreamDir, downstreamDir := setupRemotePair(t)
+	upstream := openTestRepo(t, upstreamDir)
+	downstream := openTestRepo(t, downstreamDir)
+
+	// Diverge main so that the downstream push is not a fast-forward
+	require.NoError(t, upstream.Commit("Upstream commit", git.CommitWithAllowEmpty()))
+	_, err := upstream.Push(git.PushWithRemote("origin", "main"))
+	require.NoError(t, err)
+
+	require.NoError(t, downstream.Commit("Downstream commit", git.CommitWithAllowEmpty()))
+	require.NoError(t, downstream.Tag("v1"))
+
+	remotes, err := downstream.Push(git.PushWithRemote("origin", "main", "v1"))
+	require.Error(t, err)
+
+	var rejectedErr *errors.PushRejectedError
+	require.True(t, stderrors.As(err, &rejectedErr), "expected PushRejectedError, got %T", err)
+	var gitErr *errors.GitError
+	assert.True(t, stderrors.As(err, &gitErr))
+
+	require.Len(t, rejectedErr.Rejected, 1)
+	rejected := rejectedErr.Rejected[0]
+	assert.Equal(t, "refs/heads/main", rejected.To)
+	assert.Equal(t, "[rejected]", rejected.Summary)
+	require.NotNil(t, rejected.Reason)
+	assert.Equal(t, "fetch first", *rejected.Reason)
+
+	// The tag was still pushed
+	require.Len(t, remotes, 1)
+	assert.Equal(t, rejectedErr.Remotes, remotes)
+	statuses := make(map[string]types.RefStatus)
+	for _, ref := range remotes[0].Refs {
+		statuses[ref.To] = ref.Status
+	}
+	assert.Equal(t, types.RefStatusNew, statuses["refs/tags/v1"])
+	assert.Equal(t, types.RefStatusRejected, statuses["refs/heads/main"])
+}
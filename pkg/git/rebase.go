@@ -1,9 +1,316 @@
 package git
 
-// Rebase rebases branches
-func (g *gitImpl) Rebase(opts ...Option) error {
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+const (
+	// rebaseTodoEnv carries the todo list to the sequence editor shim
+	rebaseTodoEnv = "GIT_EXEC_REBASE_TODO"
+	// rebaseSequenceEditor replaces the todo list git proposes. git runs the
+	// editor through the shell with the file name appended
+	rebaseSequenceEditor = `printf '%s' "$` + rebaseTodoEnv + `" >`
+)
+
+// rewordExecPattern matches the exec step that sets a new commit message
+// from a blob, see formatRebaseTodo
+var rewordExecPattern = regexp.MustCompile(`^git cat-file blob ([0-9a-f]+) \| git commit --amend .*-F -$`)
+
+// rebaseActionAliases maps the short forms git accepts in todo lists
+var rebaseActionAliases = map[string]types.RebaseAction{
+	"p": types.RebaseActionPick,
+	"r": types.RebaseActionReword,
+	"e": types.RebaseActionEdit,
+	"s": types.RebaseActionSquash,
+	"f": types.RebaseActionFixup,
+	"d": types.RebaseActionDrop,
+	"x": types.RebaseActionExec,
+	"b": types.RebaseActionBreak,
+	"l": types.RebaseActionLabel,
+	"t": types.RebaseActionReset,
+	"m": types.RebaseActionMerge,
+	"u": types.RebaseActionUpdateRef,
+}
+
+// Rebase reapplies commits on top of another base. Conflicts are not
+// reported as an error; the result lists them and the rebase stays in progress
+func (g *gitImpl) Rebase(opts ...Option) (*types.RebaseResult, error) {
 	cmd := g.newCommand("rebase")
 	cmd.ApplyOptions(opts...)
+	return g.runRebase(cmd)
+}
+
+// RebaseInteractive runs an interactive rebase of the commits after upstream
+// with the given todo list instead of the one git proposes. The rebase stops
+// at edit and break steps, on conflicts and on failing exec steps
+func (g *gitImpl) RebaseInteractive(upstream string, todo []types.RebaseTodo, opts ...Option) (*types.RebaseResult, error) {
+	script, err := g.formatRebaseTodo(todo)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := g.newCommand("rebase", "--interactive")
+	cmd.ApplyOptions(opts...)
+	if upstream != "" {
+		cmd.AddArgs(upstream)
+	}
+	cmd.ApplyOptions(WithEnv("GIT_SEQUENCE_EDITOR", rebaseSequenceEditor), WithEnv(rebaseTodoEnv, script))
+	return g.runRebase(cmd)
+}
+
+// RebaseContinue continues a stopped rebase after conflicts were resolved or
+// a commit was edited
+func (g *gitImpl) RebaseContinue() (*types.RebaseResult, error) {
+	return g.runRebase(g.newCommand("rebase", "--continue"))
+}
+
+// RebaseSkip skips the step that stopped the rebase
+func (g *gitImpl) RebaseSkip() (*types.RebaseResult, error) {
+	return g.runRebase(g.newCommand("rebase", "--skip"))
+}
+
+// RebaseAbort cancels the rebase and restores the original branch
+func (g *gitImpl) RebaseAbort() error {
+	cmd := g.newCommand("rebase", "--abort")
 	_, err := cmd.Execute()
 	return err
-}
\ No newline at end of file
+}
+
+// RebaseEditTodo replaces the remaining steps of a stopped interactive rebase
+func (g *gitImpl) RebaseEditTodo(todo []types.RebaseTodo) error {
+	script, err := g.formatRebaseTodo(todo)
+	if err != nil {
+		return err
+	}
+
+	cmd := g.newCommand("rebase", "--edit-todo")
+	cmd.ApplyOptions(WithEnv("GIT_SEQUENCE_EDITOR", rebaseSequenceEditor), WithEnv(rebaseTodoEnv, script))
+	_, err = cmd.Execute()
+	return err
+}
+
+// runRebase executes a rebase command and reports where the rebase stands
+func (g *gitImpl) runRebase(cmd Command) (*types.RebaseResult, error) {
+	// Keep default commit messages (squash, merge) instead of opening an editor
+	cmd.ApplyOptions(WithEnv("GIT_EDITOR", "true"), WithEnv("LC_ALL", "C"))
+
+	result := &types.RebaseResult{}
+	_, err := cmd.Execute()
+	g.loadRebaseProgress(result)
+
+	if err != nil {
+		if result.InProgress && g.collectConflicts(&result.MergeResult, err) {
+			return result, nil
+		}
+		return result, err
+	}
+
+	result.Success = !result.InProgress
+	if result.Success {
+		result.MergeCommit = g.revParse("HEAD")
+	}
+	return result, nil
+}
+
+// rebaseProgressFiles are the state files parseRebaseProgress reads
+var rebaseProgressFiles = []string{
+	"rebase-merge",
+	"rebase-merge/done",
+	"rebase-merge/git-rebase-todo",
+	"rebase-apply",
+	"rebase-apply/next",
+	"rebase-apply/last",
+}
+
+// loadRebaseProgress fills the progress of a rebase in progress from the
+// state git keeps in rebase-merge/ (or rebase-apply/ for the apply backend)
+func (g *gitImpl) loadRebaseProgress(result *types.RebaseResult) {
+	files, err := g.readGitFiles(rebaseProgressFiles...)
+	if err != nil {
+		return
+	}
+	g.parseRebaseProgress(result, files)
+}
+
+// parseRebaseProgress fills the progress of a rebase from its state files,
+// as read by readGitFiles
+func (g *gitImpl) parseRebaseProgress(result *types.RebaseResult, files map[string]string) {
+	if _, found := files["rebase-merge"]; found {
+		result.InProgress = true
+		result.Done = g.parseRebaseTodo(files["rebase-merge/done"])
+		result.Remaining = g.parseRebaseTodo(files["rebase-merge/git-rebase-todo"])
+		// Count steps after folding message exec steps, git counts them separately
+		result.Step = len(result.Done)
+		result.TotalSteps = len(result.Done) + len(result.Remaining)
+	} else if _, found := files["rebase-apply"]; found {
+		result.InProgress = true
+		result.Step, _ = strconv.Atoi(strings.TrimSpace(files["rebase-apply/next"]))
+		result.TotalSteps, _ = strconv.Atoi(strings.TrimSpace(files["rebase-apply/last"]))
+	} else {
+		return
+	}
+
+	result.StoppedAt = g.revParse("REBASE_HEAD")
+}
+
+// formatRebaseTodo renders a todo list. New commit messages are stored as
+// blobs and applied by an exec step amending the commit, so they survive
+// stops without an editor being involved
+func (g *gitImpl) formatRebaseTodo(todo []types.RebaseTodo) (string, error) {
+	var script strings.Builder
+
+	for _, step := range todo {
+		action := step.Action
+		if action == types.RebaseActionReword && step.Message != "" {
+			action = types.RebaseActionPick
+		}
+
+		switch action {
+		case types.RebaseActionExec:
+			fmt.Fprintf(&script, "exec %s\n", step.Command)
+		case types.RebaseActionBreak:
+			script.WriteString("break\n")
+		case types.RebaseActionLabel, types.RebaseActionUpdateRef:
+			fmt.Fprintf(&script, "%s %s\n", action, step.Label)
+		case types.RebaseActionReset:
+			target := step.Label
+			if target == "" {
+				target = step.Commit
+			}
+			fmt.Fprintf(&script, "reset %s\n", target)
+		case types.RebaseActionMerge:
+			if step.Commit != "" {
+				option := step.MessageOption
+				if option == "" {
+					option = "-C"
+				}
+				fmt.Fprintf(&script, "merge %s %s %s\n", option, step.Commit, step.Label)
+			} else {
+				fmt.Fprintf(&script, "merge %s\n", step.Label)
+			}
+		default:
+			script.WriteString(string(action))
+			if action == types.RebaseActionFixup && step.MessageOption != "" {
+				fmt.Fprintf(&script, " %s", step.MessageOption)
+			}
+			fmt.Fprintf(&script, " %s", step.Commit)
+			if step.Subject != "" {
+				fmt.Fprintf(&script, " %s", step.Subject)
+			}
+			script.WriteString("\n")
+		}
+
+		if step.Message == "" {
+			continue
+		}
+		switch step.Action {
+		case types.RebaseActionReword, types.RebaseActionSquash, types.RebaseActionFixup, types.RebaseActionMerge:
+		default:
+			continue
+		}
+
+		blob, err := g.storeBlob(step.Message)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&script, "exec git cat-file blob %s | git commit --amend --only --allow-empty --no-verify --quiet --cleanup=whitespace -F -\n", blob)
+	}
+
+	return script.String(), nil
+}
+
+// storeBlob writes content to the object database and returns its SHA
+func (g *gitImpl) storeBlob(content string) (string, error) {
+	cmd := g.newCommand("hash-object", "-w", "--stdin")
+	cmd.SetStdin(content)
+	output, err := cmd.Execute()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// parseRebaseTodo parses a todo list as written by git, folding the message
+// exec steps written by formatRebaseTodo back into the step they belong to
+func (g *gitImpl) parseRebaseTodo(script string) []types.RebaseTodo {
+	todo := []types.RebaseTodo{}
+
+	for _, line := range strings.Split(script, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || line == "noop" {
+			continue
+		}
+
+		step := parseRebaseTodoLine(line)
+		if step.Action == types.RebaseActionExec && len(todo) > 0 {
+			if matches := rewordExecPattern.FindStringSubmatch(step.Command); matches != nil {
+				previous := &todo[len(todo)-1]
+				if previous.Action == types.RebaseActionPick {
+					previous.Action = types.RebaseActionReword
+				}
+				previous.Message = g.readBlob(matches[1])
+				continue
+			}
+		}
+		todo = append(todo, step)
+	}
+
+	return todo
+}
+
+// parseRebaseTodoLine parses "<action> [<flags>] [<commit or label>] [<subject>]"
+func parseRebaseTodoLine(line string) types.RebaseTodo {
+	word, rest, _ := strings.Cut(line, " ")
+	action := types.RebaseAction(word)
+	if alias, ok := rebaseActionAliases[word]; ok {
+		action = alias
+	}
+	step := types.RebaseTodo{Action: action}
+
+	switch action {
+	case types.RebaseActionExec:
+		step.Command = rest
+	case types.RebaseActionBreak:
+	case types.RebaseActionLabel, types.RebaseActionReset, types.RebaseActionUpdateRef:
+		step.Label, _, _ = strings.Cut(rest, " ")
+	case types.RebaseActionMerge:
+		// merge [-C <commit> | -c <commit>] <label> [# <subject>]
+		fields := strings.Fields(rest)
+		if len(fields) >= 2 && (fields[0] == "-C" || fields[0] == "-c") {
+			step.MessageOption = fields[0]
+			step.Commit = fields[1]
+			fields = fields[2:]
+		}
+		if len(fields) > 0 {
+			step.Label = fields[0]
+		}
+		if _, subject, found := strings.Cut(rest, "# "); found {
+			step.Subject = subject
+		}
+	default:
+		// fixup may carry -C or -c to take over the message
+		if flag, commit, found := strings.Cut(rest, " "); found && (flag == "-C" || flag == "-c") {
+			step.MessageOption = flag
+			rest = commit
+		}
+		step.Commit, step.Subject, _ = strings.Cut(rest, " ")
+		step.Subject = strings.TrimPrefix(step.Subject, "# ")
+	}
+
+	return step
+}
+
+// readBlob returns the content of a blob, or "" when it cannot be read
+func (g *gitImpl) readBlob(sha string) string {
+	cmd := g.newCommand("cat-file", "blob", sha)
+	output, err := cmd.Execute()
+	if err != nil {
+		return ""
+	}
+	return string(output)
+}
@@ -0,0 +1,183 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupRebasable adds three commits on main, each creating its own file, and
+// returns them oldest first
+func setupRebasable(t *testing.T) (string, git.Git, []string) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	for _, file := range []string{"one.txt", "two.txt", "three.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, file), []byte(file+"\n"), 0644))
+		require.NoError(t, gitInstance.Add([]string{file}))
+		require.NoError(t, gitInstance.Commit("Add "+file))
+	}
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("3"))
+	require.NoError(t, err)
+	return tempDir, gitInstance, []string{logs[2].Commit, logs[1].Commit, logs[0].Commit}
+}
+
+// Test rewording, dropping and squashing commits with new messages
+func TestRebaseInteractive(t *testing.T) {
+	tempDir, gitInstance, commits := setupRebasable(t)
+
+	result, err := gitInstance.RebaseInteractive("HEAD~3", []types.RebaseTodo{
+		{Action: types.RebaseActionReword, Commit: commits[0], Message: "First, reworded"},
+		{Action: types.RebaseActionDrop, Commit: commits[1]},
+		{Action: types.RebaseActionSquash, Commit: commits[2], Message: "Squashed\n\nWith a body"},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.False(t, result.InProgress)
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("2"))
+	require.NoError(t, err)
+	assert.Equal(t, result.MergeCommit, logs[0].Commit)
+	assert.Contains(t, logs[0].Message, "Squashed")
+	assert.Equal(t, "Initial commit", logs[1].Message)
+
+	assert.FileExists(t, filepath.Join(tempDir, "one.txt"))
+	assert.NoFileExists(t, filepath.Join(tempDir, "two.txt"))
+	assert.FileExists(t, filepath.Join(tempDir, "three.txt"))
+}
+
+// Test stopping at edit and break steps and editing the remaining todo list
+func TestRebaseInteractiveStops(t *testing.T) {
+	tempDir, gitInstance, commits := setupRebasable(t)
+
+	result, err := gitInstance.RebaseInteractive("HEAD~3", []types.RebaseTodo{
+		{Action: types.RebaseActionEdit, Commit: commits[0]},
+		{Action: types.RebaseActionReword, Commit: commits[1], Message: "Second, reworded"},
+		{Action: types.RebaseActionBreak},
+		{Action: types.RebaseActionPick, Commit: commits[2]},
+		{Action: types.RebaseActionExec, Command: "echo done > exec.txt"},
+	})
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.True(t, result.InProgress)
+	assert.Equal(t, 1, result.Step)
+	assert.Equal(t, 5, result.TotalSteps)
+	require.Len(t, result.Done, 1)
+	assert.Equal(t, types.RebaseActionEdit, result.Done[0].Action)
+	assert.Equal(t, commits[0], result.Done[0].Commit)
+	require.Len(t, result.Remaining, 4)
+	assert.Equal(t, types.RebaseActionReword, result.Remaining[0].Action)
+	assert.Equal(t, "Second, reworded", result.Remaining[0].Message)
+	assert.Equal(t, types.RebaseActionBreak, result.Remaining[1].Action)
+	assert.Equal(t, "echo done > exec.txt", result.Remaining[3].Command)
+
+	// Amend the edited commit, then replace the pick with a drop
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "one.txt"), []byte("edited\n"), 0644))
+	require.NoError(t, gitInstance.Commit("Add one.txt, edited", git.CommitWithAll(), git.CommitWithAmend()))
+
+	remaining := result.Remaining
+	remaining[2].Action = types.RebaseActionDrop
+	require.NoError(t, gitInstance.RebaseEditTodo(remaining))
+
+	result, err = gitInstance.RebaseContinue()
+	require.NoError(t, err)
+	assert.True(t, result.InProgress)
+	require.Len(t, result.Remaining, 2)
+	assert.Equal(t, types.RebaseActionDrop, result.Remaining[0].Action)
+	assert.Equal(t, commits[2], result.Remaining[0].Commit)
+
+	result, err = gitInstance.RebaseContinue()
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("3"))
+	require.NoError(t, err)
+	assert.Equal(t, "Second, reworded", logs[0].Message)
+	assert.Equal(t, "Add one.txt, edited", logs[1].Message)
+	assert.Equal(t, "Initial commit", logs[2].Message)
+	assert.FileExists(t, filepath.Join(tempDir, "exec.txt"))
+	assert.NoFileExists(t, filepath.Join(tempDir, "three.txt"))
+}
+
+// Test rebasing through an executor running git elsewhere, with fixup steps
+// taking over the message of the commit they fold in
+func TestRebaseRemoteExecutor(t *testing.T) {
+	tempDir, _, commits := setupRebasable(t)
+	remote := openRemoteRepo(t, tempDir)
+
+	result, err := remote.RebaseInteractive("HEAD~3", []types.RebaseTodo{
+		{Action: types.RebaseActionEdit, Commit: commits[0]},
+		{Action: types.RebaseActionFixup, Commit: commits[1], MessageOption: "-C"},
+		{Action: types.RebaseActionPick, Commit: commits[2]},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.InProgress)
+	assert.Equal(t, 1, result.Step)
+	assert.Equal(t, 3, result.TotalSteps)
+	require.Len(t, result.Remaining, 2)
+	assert.Equal(t, types.RebaseActionFixup, result.Remaining[0].Action)
+	assert.Equal(t, "-C", result.Remaining[0].MessageOption)
+	assert.Equal(t, commits[1], result.Remaining[0].Commit)
+
+	// The todo list is written back unchanged
+	require.NoError(t, remote.RebaseEditTodo(result.Remaining))
+	result, err = remote.RebaseContinue()
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+
+	logs, err := remote.Log(git.LogWithMaxCount("3"))
+	require.NoError(t, err)
+	assert.Equal(t, "Add three.txt", logs[0].Subject)
+	assert.Equal(t, "Add two.txt", logs[1].Subject)
+	assert.Equal(t, "Initial commit", logs[2].Subject)
+	assert.FileExists(t, filepath.Join(tempDir, "one.txt"))
+}
+
+// Test a rebase that stops on conflicts, then skipping or aborting it
+func TestRebaseConflicts(t *testing.T) {
+	tempDir, gitInstance, commits := setupPickable(t)
+	_, err := gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+
+	result, err := gitInstance.Rebase(git.WithArgs("main"))
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.True(t, result.InProgress)
+	assert.Equal(t, commits[0], result.StoppedAt)
+	assert.Equal(t, 1, result.Step)
+	assert.Equal(t, 3, result.TotalSteps)
+	assert.Equal(t, []string{"README.md"}, result.ConflictedFiles)
+	require.Len(t, result.Conflicts, 1)
+
+	result, err = gitInstance.RebaseSkip()
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.False(t, result.InProgress)
+
+	content, err := os.ReadFile(filepath.Join(tempDir, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "main\n", string(content))
+	assert.FileExists(t, filepath.Join(tempDir, "three.txt"))
+
+	// Rebase again from the original commits and abort
+	require.NoError(t, gitInstance.Reset(nil, git.WithArgs("--hard", commits[2])))
+	result, err = gitInstance.RebaseInteractive("main", []types.RebaseTodo{
+		{Action: types.RebaseActionPick, Commit: commits[0]},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.InProgress)
+	assert.Equal(t, commits[0], result.StoppedAt)
+
+	require.NoError(t, gitInstance.RebaseAbort())
+	logs, err := gitInstance.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	assert.Equal(t, commits[2], logs[0].Commit)
+}
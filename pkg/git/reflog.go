@@ -1,9 +1,261 @@
 package git
 
-// Reflog manages the reference logs
-func (g *gitImpl) Reflog(opts ...Option) error {
-	cmd := g.newCommand("reflog")
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// reflogFormat separates fields with US and records with RS. Without a date
+// mode, %gd and %gD address entries by index, such as "HEAD@{3}", even when
+// entries are skipped or filtered out. With --date=raw they hold the time of
+// the entry instead, see listReflog
+const reflogFormat = "--format=%H%x1f%gd%x1f%gD%x1f%gn%x1f%ge%x1f%gs%x1e"
+
+// Reflog returns the entries of a reference log, newest first. An empty
+// ref shows the reflog of HEAD.
+func (g *gitImpl) Reflog(ref string, opts ...Option) ([]types.ReflogEntry, error) {
+	return g.listReflog(func(extra ...string) Command {
+		cmd := g.newCommand("reflog", "show", reflogFormat)
+		cmd.ApplyOptions(opts...)
+		cmd.AddArgs(extra...)
+		if ref != "" {
+			cmd.AddArgs(ref, "--")
+		}
+		return cmd
+	})
+}
+
+// listReflog runs the reflog listing built by newCmd twice: as given, for the
+// selectors, and with raw dates, for the time of each entry. Both list the
+// same entries in the same order. The old values come from loadReflogRecords
+func (g *gitImpl) listReflog(newCmd func(extra ...string) Command) ([]types.ReflogEntry, error) {
+	output, err := newCmd().Execute()
+	if err != nil {
+		return nil, err
+	}
+	entries := parseReflog(string(output))
+	if len(entries) == 0 {
+		return entries, nil
+	}
+
+	output, err = newCmd("--date=raw").Execute()
+	if err != nil {
+		return nil, err
+	}
+	dated := parseReflog(string(output))
+	if len(dated) != len(entries) {
+		return nil, fmt.Errorf("reflog changed while it was read: %d entries, then %d", len(entries), len(dated))
+	}
+	for i := range entries {
+		// <ref>@{<seconds> <zone>}
+		_, date, _ := strings.Cut(dated[i].Selector, "@{")
+		entries[i].Timestamp = parseRawDate(strings.TrimSuffix(date, "}"))
+		if dated[i].NewSHA != entries[i].NewSHA || entries[i].Timestamp.IsZero() {
+			return nil, fmt.Errorf("reflog changed while it was read: %s", entries[i].Selector)
+		}
+	}
+
+	return g.loadReflogRecords(entries)
+}
+
+// ReflogExpire prunes old reflog entries
+func (g *gitImpl) ReflogExpire(opts ...Option) error {
+	cmd := g.newCommand("reflog", "expire")
 	cmd.ApplyOptions(opts...)
 	_, err := cmd.Execute()
 	return err
-}
\ No newline at end of file
+}
+
+// ReflogDelete deletes a single reflog entry, e.g. "main@{2}"
+func (g *gitImpl) ReflogDelete(selector string, opts ...Option) error {
+	cmd := g.newCommand("reflog", "delete")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(selector)
+	_, err := cmd.Execute()
+	return err
+}
+
+// parseReflog parses reflog records produced with reflogFormat. OldSHA and
+// Timestamp are not available as placeholders, see listReflog
+func parseReflog(output string) []types.ReflogEntry {
+	entries := []types.ReflogEntry{}
+
+	for _, record := range strings.Split(output, "\x1e") {
+		record = strings.TrimPrefix(record, "\n")
+		fields := strings.Split(record, "\x1f")
+		if len(fields) != 6 {
+			continue
+		}
+
+		entry := types.ReflogEntry{
+			NewSHA:   fields[0],
+			Selector: fields[1],
+			Name:     fields[3],
+			Email:    fields[4],
+			Subject:  fields[5],
+			Message:  fields[5],
+		}
+		// <full ref name>@{<index>}
+		entry.Ref, _, _ = strings.Cut(fields[2], "@{")
+
+		// <action>[ <detail>]: <message>
+		if prefix, message, found := strings.Cut(fields[5], ": "); found {
+			entry.Action, _, _ = strings.Cut(prefix, " ")
+			entry.Message = message
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// loadReflogRecords fills in the old value of each entry from its record in
+// the reflog file, "<old> <new> <name> <<email>> <time> <zone>\t<message>",
+// read through the executor. Records are stored oldest first, so entry
+// <ref>@{n} is the n-th record from the end. Entries listed with a date mode
+// have no index and take the newest unused record with their new value and
+// time. Refs without reflog files, as with the reftable backend, fail
+func (g *gitImpl) loadReflogRecords(entries []types.ReflogEntry) ([]types.ReflogEntry, error) {
+	g.resolveReflogRefs(entries)
+
+	var names []string
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		if !seen[entry.Ref] {
+			seen[entry.Ref] = true
+			names = append(names, "logs/"+entry.Ref)
+		}
+	}
+	files, err := g.readGitFiles(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	records := map[string][]reflogRecord{}
+	for _, name := range names {
+		content, found := files[name]
+		if !found {
+			return nil, fmt.Errorf("reading old values of the %s reflog: no %s file, as with the reftable backend", strings.TrimPrefix(name, "logs/"), name)
+		}
+		for _, line := range strings.FieldsFunc(content, func(r rune) bool { return r == '\n' }) {
+			records[name] = append(records[name], parseReflogRecord(line))
+		}
+	}
+
+	used := map[*reflogRecord]bool{}
+	for i := range entries {
+		lines := records["logs/"+entries[i].Ref]
+		var record *reflogRecord
+		if n, found := reflogIndex(entries[i].Selector); found {
+			if n < len(lines) {
+				record = &lines[len(lines)-1-n]
+			}
+		} else {
+			for j := len(lines) - 1; j >= 0; j-- {
+				if !used[&lines[j]] && lines[j].newSHA == entries[i].NewSHA && lines[j].timestamp.Equal(entries[i].Timestamp) {
+					record = &lines[j]
+					break
+				}
+			}
+		}
+		if record == nil || record.newSHA != entries[i].NewSHA {
+			return nil, fmt.Errorf("reading old values of the %s reflog: no record for %s", entries[i].Ref, entries[i].Selector)
+		}
+
+		used[record] = true
+		if !zeroSHAPattern.MatchString(record.oldSHA) {
+			entries[i].OldSHA = record.oldSHA
+		}
+	}
+	return entries, nil
+}
+
+// reflogRecord is a record of a reflog file
+type reflogRecord struct {
+	oldSHA    string
+	newSHA    string
+	timestamp time.Time
+}
+
+// parseReflogRecord parses the header of a reflog file record, leaving the
+// fields of malformed records empty
+func parseReflogRecord(line string) reflogRecord {
+	header, _, _ := strings.Cut(line, "\t")
+	fields := strings.Fields(header)
+	if len(fields) < 4 {
+		return reflogRecord{}
+	}
+	return reflogRecord{
+		oldSHA:    fields[0],
+		newSHA:    fields[1],
+		timestamp: parseRawDate(fields[len(fields)-2] + " " + fields[len(fields)-1]),
+	}
+}
+
+// reflogIndex returns n of a "<ref>@{n}" selector. Selectors printed with a
+// date mode have no index
+func reflogIndex(selector string) (int, bool) {
+	_, index, found := strings.Cut(selector, "@{")
+	if !found || !strings.HasSuffix(index, "}") {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(index, "}"))
+	return n, err == nil && n >= 0
+}
+
+// resolveReflogRefs expands the ref names of entries, which %gD prints the
+// way they were given (e.g. "main"), to full names. HEAD keeps its own reflog
+func (g *gitImpl) resolveReflogRefs(entries []types.ReflogEntry) {
+	var short []string
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		if entry.Ref != "HEAD" && !strings.HasPrefix(entry.Ref, "refs/") && !seen[entry.Ref] {
+			seen[entry.Ref] = true
+			short = append(short, entry.Ref)
+		}
+	}
+	if len(short) == 0 {
+		return
+	}
+
+	cmd := g.newCommand("rev-parse", "--symbolic-full-name")
+	cmd.AddArgs(short...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return
+	}
+	full := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(full) != len(short) {
+		return
+	}
+	resolved := map[string]string{}
+	for i, name := range short {
+		resolved[name] = full[i]
+	}
+	for i := range entries {
+		if name, found := resolved[entries[i].Ref]; found {
+			entries[i].Ref = name
+		}
+	}
+}
+
+// parseRawDate parses a "<seconds> <+hhmm>" date, returning the zero time
+// when it is invalid
+func parseRawDate(date string) time.Time {
+	seconds, zone, _ := strings.Cut(date, " ")
+	unix, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	timestamp := time.Unix(unix, 0)
+	if parsed, err := time.Parse("-0700", zone); err == nil {
+		timestamp = timestamp.In(parsed.Location())
+	}
+	return timestamp
+}
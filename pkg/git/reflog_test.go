@@ -0,0 +1,186 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test reflog entries describe what happened to HEAD and branches
+func TestReflogEntries(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	before := time.Now().Add(-time.Minute)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "lost.txt"), []byte("lost"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"lost.txt"}))
+	require.NoError(t, gitInstance.Commit("Commit: to be lost"))
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.Reset([]string{}, git.WithArgs("--hard", "HEAD~1")))
+
+	entries, err := gitInstance.Reflog("")
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+
+	reset, checkout, commit, initial := entries[0], entries[1], entries[2], entries[3]
+	assert.Equal(t, "HEAD@{0}", reset.Selector)
+	assert.Equal(t, "reset", reset.Action)
+	assert.Equal(t, "moving to HEAD~1", reset.Message)
+	assert.Equal(t, checkout.NewSHA, reset.OldSHA)
+	assert.Equal(t, initial.NewSHA, reset.NewSHA)
+
+	assert.Equal(t, "HEAD@{1}", checkout.Selector)
+	assert.Equal(t, "checkout", checkout.Action)
+	assert.Equal(t, "moving from main to feature", checkout.Message)
+
+	// The lost commit can be recovered from the reflog
+	assert.Equal(t, "commit", commit.Action)
+	assert.Equal(t, "Commit: to be lost", commit.Message)
+	assert.Equal(t, "commit: Commit: to be lost", commit.Subject)
+	assert.Equal(t, initial.NewSHA, commit.OldSHA)
+	assert.Equal(t, "Test User", commit.Name)
+	assert.Equal(t, "test@example.com", commit.Email)
+	assert.True(t, commit.Timestamp.After(before), "unexpected timestamp %v", commit.Timestamp)
+
+	assert.Equal(t, "commit", initial.Action)
+	assert.Equal(t, "Initial commit", initial.Message)
+	assert.Empty(t, initial.OldSHA)
+
+	branchEntries, err := gitInstance.Reflog("feature", git.ReflogWithMaxCount("1"))
+	require.NoError(t, err)
+	require.Len(t, branchEntries, 1)
+	assert.Equal(t, "feature@{0}", branchEntries[0].Selector)
+	assert.Equal(t, "reset", branchEntries[0].Action)
+}
+
+// Test selectors and old values of skipped, filtered and deleted entries
+func TestReflogSelectors(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	for _, message := range []string{"Second", "Third"} {
+		require.NoError(t, gitInstance.Commit(message, git.CommitWithAllowEmpty()))
+	}
+	entries, err := gitInstance.Reflog("main")
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	third, second, initial := entries[0], entries[1], entries[2]
+	assert.Equal(t, "refs/heads/main", third.Ref)
+
+	skipped, err := gitInstance.Reflog("main", git.WithArgs("--skip=1"))
+	require.NoError(t, err)
+	require.Len(t, skipped, 2)
+	assert.Equal(t, second, skipped[0])
+	assert.Equal(t, "main@{1}", skipped[0].Selector)
+	assert.Equal(t, initial.NewSHA, skipped[0].OldSHA)
+
+	// The old value comes from the entry, not from the next one returned
+	filtered, err := gitInstance.Reflog("main", git.WithArgs("--grep-reflog=Third"))
+	require.NoError(t, err)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "main@{0}", filtered[0].Selector)
+	assert.Equal(t, second.NewSHA, filtered[0].OldSHA)
+	assert.Equal(t, third.Timestamp, filtered[0].Timestamp)
+
+	require.NoError(t, gitInstance.ReflogDelete("main@{1}"))
+	entries, err = gitInstance.Reflog("main")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "main@{1}", entries[1].Selector)
+	assert.Equal(t, second.NewSHA, entries[0].OldSHA)
+	assert.Empty(t, entries[1].OldSHA)
+}
+
+// Test entries listed with a date mode, whose selectors have no index
+func TestReflogDateSelectors(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.Commit("Second", git.CommitWithAllowEmpty()))
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	// Switching back and forth can give records that only differ in their old value
+	for _, branch := range []string{"feature", "main", "feature"} {
+		_, err = gitInstance.Checkout(git.CheckoutWithBranch(branch))
+		require.NoError(t, err)
+	}
+	expected, err := gitInstance.Reflog("")
+	require.NoError(t, err)
+	require.Len(t, expected, 5)
+
+	entries, err := gitInstance.Reflog("", git.WithArgs("--date=iso"))
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	for i, entry := range entries {
+		assert.NotEqual(t, expected[i].Selector, entry.Selector)
+		assert.Equal(t, expected[i].OldSHA, entry.OldSHA, "entry %d", i)
+		assert.Equal(t, expected[i].NewSHA, entry.NewSHA)
+		assert.True(t, expected[i].Timestamp.Equal(entry.Timestamp))
+		assert.False(t, entry.Timestamp.IsZero())
+	}
+
+	stashes, err := gitInstance.StashList(git.WithArgs("--date=relative"))
+	require.NoError(t, err)
+	assert.Empty(t, stashes)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("stashed\n"), 0644))
+	_, err = gitInstance.StashPush()
+	require.NoError(t, err)
+	stashes, err = gitInstance.StashList(git.WithArgs("--date=relative"))
+	require.NoError(t, err)
+	require.Len(t, stashes, 1)
+	assert.Equal(t, -1, stashes[0].Index)
+	assert.False(t, stashes[0].Timestamp.IsZero())
+}
+
+// Test old values cannot be read from reftable reflogs
+func TestReflogReftable(t *testing.T) {
+	requireGitVersion(t, 2, 45)
+	tempDir := t.TempDir()
+	runGit(t, tempDir, "init", "--ref-format=reftable", "--initial-branch=main")
+	runGit(t, tempDir, "-c", "user.name=Test User", "-c", "user.email=test@example.com", "commit", "--allow-empty", "-m", "Initial commit")
+
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+	_, err = gitInstance.Reflog("")
+	assert.ErrorContains(t, err, "reftable")
+}
+
+// Test deleting and expiring reflog entries
+func TestReflogDeleteAndExpire(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	for _, message := range []string{"Second", "Third"} {
+		require.NoError(t, gitInstance.Commit(message, git.CommitWithAllowEmpty()))
+	}
+
+	require.NoError(t, gitInstance.ReflogDelete("main@{1}", git.ReflogWithRewrite()))
+	entries, err := gitInstance.Reflog("main")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "Third", entries[0].Message)
+	assert.Equal(t, "Initial commit", entries[1].Message)
+
+	require.NoError(t, gitInstance.ReflogExpire(git.ReflogWithExpire("now"), git.ReflogWithAll()))
+	entries, err = gitInstance.Reflog("main")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = gitInstance.Reflog("missing")
+	assert.Error(t, err)
+}
@@ -1,9 +1,34 @@
 package git
 
-// Revert reverts commits
-func (g *gitImpl) Revert(opts ...Option) error {
-	cmd := g.newCommand("revert")
+import (
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// Revert creates commits undoing existing commits, using the default commit
+// message. Conflicts are not reported as an error; the result names the
+// commit that stopped and lists the conflicted files
+func (g *gitImpl) Revert(commits []string, opts ...Option) (*types.SequencerResult, error) {
+	cmd := g.newCommand("revert", "--no-edit")
 	cmd.ApplyOptions(opts...)
-	_, err := cmd.Execute()
-	return err
-}
\ No newline at end of file
+	return g.startSequencer(revertSequencer, commits, cmd)
+}
+
+// RevertContinue commits the resolved conflicts and reverts the remaining commits
+func (g *gitImpl) RevertContinue() (*types.SequencerResult, error) {
+	return g.resumeSequencer(revertSequencer, false)
+}
+
+// RevertSkip skips the commit that stopped and reverts the remaining commits
+func (g *gitImpl) RevertSkip() (*types.SequencerResult, error) {
+	return g.resumeSequencer(revertSequencer, true)
+}
+
+// RevertAbort cancels the revert and restores the original branch
+func (g *gitImpl) RevertAbort() error {
+	return g.stopSequencer(revertSequencer, "--abort")
+}
+
+// RevertQuit forgets the revert in progress, keeping the commits reverted so far
+func (g *gitImpl) RevertQuit() error {
+	return g.stopSequencer(revertSequencer, "--quit")
+}
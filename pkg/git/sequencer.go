@@ -0,0 +1,220 @@
+package git
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// sequencer describes a command that applies a series of commits
+type sequencer struct {
+	command string // "cherry-pick" or "revert"
+	head    string // Pseudo-ref naming the commit being applied
+}
+
+var (
+	cherryPickSequencer = sequencer{command: "cherry-pick", head: "CHERRY_PICK_HEAD"}
+	revertSequencer     = sequencer{command: "revert", head: "REVERT_HEAD"}
+)
+
+// startSequencer applies commits, which may include ranges such as "A..B"
+func (g *gitImpl) startSequencer(seq sequencer, commits []string, cmd Command) (*types.SequencerResult, error) {
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+	cmd.AddArgs(commits...)
+	return g.runSequencer(seq, cmd, g.sequencerSources(seq, commits))
+}
+
+// resumeSequencer continues (or skips the current commit of) a stopped sequence
+func (g *gitImpl) resumeSequencer(seq sequencer, skip bool) (*types.SequencerResult, error) {
+	pending := g.pendingSequencerCommits(seq)
+	flag := "--continue"
+	if skip {
+		flag = "--skip"
+		if len(pending) > 0 {
+			pending = pending[1:]
+		}
+	}
+
+	cmd := g.newCommand(seq.command, flag)
+	// Keep the prepared commit message instead of opening an editor
+	cmd.ApplyOptions(WithEnv("GIT_EDITOR", "true"), WithEnv("LC_ALL", "C"))
+	return g.runSequencer(seq, cmd, pending)
+}
+
+// stopSequencer aborts or quits a stopped sequence
+func (g *gitImpl) stopSequencer(seq sequencer, flag string) error {
+	cmd := g.newCommand(seq.command, flag)
+	_, err := cmd.Execute()
+	return err
+}
+
+// runSequencer executes a sequencer command and pairs the commits it created
+// with the source commits they were made from, see pairSequencerCommits
+func (g *gitImpl) runSequencer(seq sequencer, cmd Command, sources []string) (*types.SequencerResult, error) {
+	result := &types.SequencerResult{Applied: []types.AppliedCommit{}}
+	result.BaseBranch = g.currentBranch()
+	base := g.revParse("HEAD")
+
+	output, err := cmd.Execute()
+
+	var sourceCommits []sequencerCommit
+	if len(sources) > 0 {
+		sourceCommits = g.sequencerCommits(append([]string{"--no-walk=unsorted"}, sources...)...)
+	}
+	result.Applied = pairSequencerCommits(seq, g.commitsSince(base), sourceCommits)
+
+	if err != nil {
+		result.StoppedAt = g.revParse(seq.head)
+		if g.collectConflicts(&result.MergeResult, err) {
+			return result, nil
+		}
+		return result, err
+	}
+
+	g.completeMerge(&result.MergeResult, base, string(output))
+	return result, nil
+}
+
+// sequencerSources resolves the commits a sequencer will apply, in order.
+// Like git, cherry-pick walks ranges oldest first and revert newest first,
+// while single commits keep the order they were given in
+func (g *gitImpl) sequencerSources(seq sequencer, commits []string) []string {
+	if len(commits) == 0 {
+		return nil
+	}
+
+	walk := []string{"--no-walk=unsorted"}
+	for _, commit := range commits {
+		if strings.Contains(commit, "..") || strings.HasPrefix(commit, "^") {
+			walk = nil
+			if seq != revertSequencer {
+				walk = []string{"--reverse"}
+			}
+			break
+		}
+	}
+
+	cmd := g.newCommand("rev-list", walk...)
+	cmd.AddArgs(commits...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(string(output))
+}
+
+// pendingSequencerCommits returns the commits a stopped sequence still has
+// to apply, starting with the one it stopped at
+func (g *gitImpl) pendingSequencerCommits(seq sequencer) []string {
+	// A single commit does not use a todo list
+	todo, found := g.readGitFile("sequencer/todo")
+	if !found {
+		if head := g.revParse(seq.head); head != "" {
+			return []string{head}
+		}
+		return nil
+	}
+
+	// <action> <abbreviated sha> <subject>
+	var abbreviated []string
+	for _, line := range strings.Split(todo, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && !strings.HasPrefix(fields[0], "#") {
+			abbreviated = append(abbreviated, fields[1])
+		}
+	}
+	if len(abbreviated) == 0 {
+		return nil
+	}
+
+	cmd := g.newCommand("rev-parse")
+	cmd.AddArgs(abbreviated...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(string(output))
+}
+
+// sequencerOriginPatterns find the source a cherry-pick with -x or a revert
+// names in its message
+var sequencerOriginPatterns = map[sequencer]*regexp.Regexp{
+	cherryPickSequencer: regexp.MustCompile(`\(cherry picked from commit ([0-9a-f]{40,64})\)`),
+	revertSequencer:     regexp.MustCompile(`This reverts commit ([0-9a-f]{40,64})`),
+}
+
+// sequencerCommit is a commit created or applied by a sequencer
+type sequencerCommit struct {
+	sha     string
+	author  string // "<name> <email> <time>", kept by cherry-picks
+	message string
+}
+
+// pairSequencerCommits pairs created commits with their sources. A
+// fast-forwarded commit is its own source, other commits name it in their
+// message ("(cherry picked from commit ...)" or "This reverts commit ...").
+// Cherry-picks without that line take the source with the same author, date
+// and subject. Commits are not paired by position, as dropped empty commits,
+// --no-commit and fast-forwards leave gaps; unmatched commits get no Source
+func pairSequencerCommits(seq sequencer, created, sources []sequencerCommit) []types.AppliedCommit {
+	used := map[int]bool{}
+	take := func(match func(sequencerCommit) bool) string {
+		for i, source := range sources {
+			if !used[i] && match(source) {
+				used[i] = true
+				return source.sha
+			}
+		}
+		return ""
+	}
+
+	applied := []types.AppliedCommit{}
+	for _, commit := range created {
+		source := take(func(source sequencerCommit) bool { return source.sha == commit.sha })
+		if source == "" {
+			// The last line names the latest origin of commits picked again
+			if matches := sequencerOriginPatterns[seq].FindAllStringSubmatch(commit.message, -1); len(matches) > 0 {
+				source = matches[len(matches)-1][1]
+				take(func(candidate sequencerCommit) bool { return candidate.sha == source })
+			}
+		}
+		if source == "" && seq == cherryPickSequencer {
+			subject, _, _ := strings.Cut(commit.message, "\n")
+			source = take(func(candidate sequencerCommit) bool {
+				candidateSubject, _, _ := strings.Cut(candidate.message, "\n")
+				return candidate.author == commit.author && candidateSubject == subject
+			})
+		}
+		applied = append(applied, types.AppliedCommit{Source: source, Commit: commit.sha})
+	}
+	return applied
+}
+
+// commitsSince lists the first-parent commits after base up to HEAD, oldest first
+func (g *gitImpl) commitsSince(base string) []sequencerCommit {
+	if base == "" {
+		return nil
+	}
+	return g.sequencerCommits("--reverse", "--first-parent", base+"..HEAD")
+}
+
+// sequencerCommits lists the commits selected by log arguments
+func (g *gitImpl) sequencerCommits(args ...string) []sequencerCommit {
+	cmd := g.newCommand("log", "--format=%H%x1f%an <%ae> %at%x1f%B%x1e")
+	cmd.AddArgs(args...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil
+	}
+
+	var commits []sequencerCommit
+	for _, record := range strings.Split(string(output), "\x1e") {
+		fields := strings.Split(strings.TrimPrefix(record, "\n"), "\x1f")
+		if len(fields) != 3 {
+			continue
+		}
+		commits = append(commits, sequencerCommit{sha: fields[0], author: fields[1], message: fields[2]})
+	}
+	return commits
+}
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -16,6 +17,9 @@ type SessionConfig struct {
 	// Session properties
 	WorkingDirectory string
 	
+	// Executor runs the session's git commands (defaults to the local git binary)
+	Executor Executor
+	
 	// Metadata (key-value pairs for any use case)
 	Metadata map[string]string
 }
@@ -55,6 +59,13 @@ func SessionWithMetadata(section, key, value string) SessionOption {
 	}
 }
 
+// SessionWithExecutor runs the session's git commands through the given executor
+func SessionWithExecutor(executor Executor) SessionOption {
+	return func(c *SessionConfig) {
+		c.Executor = executor
+	}
+}
+
 // SessionWithWorkingDirectory sets the working directory for the session
 func SessionWithWorkingDirectory(dir string) SessionOption {
 	return func(c *SessionConfig) {
@@ -70,12 +81,6 @@ type sessionImpl struct {
 
 // NewSession creates a new Git session with persistent configuration
 func NewSession(sessionPath string, opts ...SessionOption) (Session, error) {
-	// Create base git instance
-	g, err := NewGit()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create git instance: %w", err)
-	}
-	
 	// Initialize session config
 	config := &SessionConfig{
 		WorkingDirectory: sessionPath,
@@ -87,6 +92,12 @@ func NewSession(sessionPath string, opts ...SessionOption) (Session, error) {
 		opt(config)
 	}
 	
+	// Create base git instance
+	g, err := newSessionGit(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create git instance: %w", err)
+	}
+	
 	// Create session
 	s := &sessionImpl{
 		gitImpl: g,
@@ -131,15 +142,24 @@ func NewSession(sessionPath string, opts ...SessionOption) (Session, error) {
 	return s, nil
 }
 
-// LoadSession loads an existing session from a repository path
-func LoadSession(sessionPath string) (Session, error) {
+// LoadSession loads an existing session from a repository path.
+// Options are applied before the configuration stored in the repository is loaded
+func LoadSession(sessionPath string, opts ...SessionOption) (Session, error) {
 	// Check if path exists
 	if _, err := os.Stat(sessionPath); os.IsNotExist(err) {
 		return nil, fmt.Errorf("session path does not exist: %s", sessionPath)
 	}
 	
+	config := &SessionConfig{
+		WorkingDirectory: sessionPath,
+		Metadata:         make(map[string]string),
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	
 	// Create base git instance
-	g, err := NewGit()
+	g, err := newSessionGit(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create git instance: %w", err)
 	}
@@ -147,10 +167,7 @@ func LoadSession(sessionPath string) (Session, error) {
 	// Create session
 	s := &sessionImpl{
 		gitImpl: g,
-		config: &SessionConfig{
-			WorkingDirectory: sessionPath,
-			Metadata:         make(map[string]string),
-		},
+		config:  config,
 	}
 	
 	// Set working directory
@@ -170,6 +187,14 @@ func LoadSession(sessionPath string) (Session, error) {
 	return s, nil
 }
 
+// newSessionGit creates the git implementation backing a session
+func newSessionGit(config *SessionConfig) (*gitImpl, error) {
+	if config.Executor != nil {
+		return NewGit(GitWithExecutor(config.Executor))
+	}
+	return NewGit()
+}
+
 // ValidateSession checks if a session at the given path is valid
 func ValidateSession(sessionPath string) error {
 	s, err := LoadSession(sessionPath)
@@ -212,6 +237,28 @@ func (s *sessionImpl) UpdateUser(name, email string) error {
 }
 
 
+// WithContext returns a copy of the session whose commands run under ctx.
+// The returned value keeps the session behaviour and can be asserted to Session
+func (s *sessionImpl) WithContext(ctx context.Context) Git {
+	return &sessionImpl{
+		gitImpl: s.gitImpl.withContext(ctx),
+		config:  s.config,
+	}
+}
+
+// WithWorktree returns a copy of the session whose commands run in the
+// worktree at path, with its own copy of the session configuration. The
+// returned value keeps the session behaviour and can be asserted to Session
+func (s *sessionImpl) WithWorktree(path string) Git {
+	worktree := s.gitImpl.withWorktree(path)
+	config := *s.config
+	config.WorkingDirectory = worktree.wd
+	return &sessionImpl{
+		gitImpl: worktree,
+		config:  &config,
+	}
+}
+
 // IsValid checks if the session is still valid
 func (s *sessionImpl) IsValid() bool {
 	// Check if working directory exists
@@ -0,0 +1,74 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// shellAlias is the git alias shellCommand runs scripts through
+const shellAlias = "git-exec-sh"
+
+// shellCommand returns a command running a shell script through a git alias.
+// git starts the shell itself, so the script sees the same file system as git
+// whichever Executor runs it. The script starts in the working directory and
+// receives args as its positional parameters
+func (g *gitImpl) shellCommand(script string, args ...string) Command {
+	cmd := g.newCommand(shellAlias, args...)
+	cmd.ApplyOptions(WithConfig("alias."+shellAlias, `!f() { cd "./$GIT_PREFIX" || exit 1; `+script+`; }; f`))
+	return cmd
+}
+
+// readFilesScript prints "<size> <path> NUL <content>" for every argument
+// that is a regular file
+const readFilesScript = `for path; do if [ -f "$path" ]; then ` +
+	`printf '%s %s\000' "$(wc -c < "$path")" "$path" && cat -- "$path" || exit 1; fi; done`
+
+// readFiles reads files relative to the working directory through the
+// executor. Paths that are not regular files are left out
+func (g *gitImpl) readFiles(paths ...string) (map[string]string, error) {
+	if len(paths) == 0 {
+		return map[string]string{}, nil
+	}
+
+	output, err := g.shellCommand(readFilesScript, paths...).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFileRecords(string(output))
+}
+
+// parseFileRecords parses "<size> <path> NUL <content>" records
+func parseFileRecords(output string) (map[string]string, error) {
+	files := make(map[string]string)
+	rest := output
+	for rest != "" {
+		header, content, found := strings.Cut(rest, "\x00")
+		if !found {
+			return nil, fmt.Errorf("reading files: truncated output")
+		}
+		// wc pads the size with spaces on some systems
+		sizeField, path, _ := strings.Cut(strings.TrimLeft(header, " "), " ")
+		size, err := strconv.Atoi(sizeField)
+		if err != nil || size > len(content) {
+			return nil, fmt.Errorf("reading %s: unexpected output", path)
+		}
+		files[path] = content[:size]
+		rest = content[size:]
+	}
+	return files, nil
+}
+
+// writeFileScript replaces the content of an existing file with its standard
+// input, keeping the file mode
+const writeFileScript = `if [ ! -f "$1" ]; then echo "$1: not a file" >&2; exit 1; fi; cat > "$1"`
+
+// writeFile replaces the content of an existing file relative to the working
+// directory through the executor
+func (g *gitImpl) writeFile(path, content string) error {
+	cmd := g.shellCommand(writeFileScript, path)
+	cmd.SetStdin(content)
+	_, err := cmd.Execute()
+	return err
+}
@@ -4,10 +4,11 @@ import (
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
-// Show shows information about a git object
+// Show shows information about a git object. For commits, Diffs holds the
+// diff git shows by default, or the numstat with ShowWithNumstat
 func (g *gitImpl) Show(object string, opts ...Option) (*types.Log, error) {
-	format := "--pretty=format:COMMIT:%H%nTREE:%T%nPARENT:%P%nAUTHOR:%an <%ae>%nAUTHOR_DATE:%ai%nCOMMITTER:%cn <%ce>%nCOMMITTER_DATE:%ci%nMESSAGE:%s%n---END---"
-	cmd := g.newCommand("show", format, object)
+	cmd := g.newCommand("show", logFormat, "--decorate=full", object)
+	cmd.AddArgs(diffPrefixArgs...)
 	cmd.ApplyOptions(opts...)
 	output, err := cmd.Execute()
 	if err != nil {
@@ -22,4 +23,4 @@ func (g *gitImpl) Show(object string, opts ...Option) (*types.Log, error) {
 		return nil, nil
 	}
 	return &logs[0], nil
-}
\ No newline at end of file
+}
@@ -0,0 +1,162 @@
+package git
+
+import (
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// StashPush stashes local changes and returns the new entry, or nil when
+// there was nothing to stash
+func (g *gitImpl) StashPush(opts ...Option) (*types.StashEntry, error) {
+	before := g.revParse("refs/stash")
+
+	cmd := g.newCommand("stash", "push")
+	cmd.ApplyOptions(opts...)
+	if _, err := cmd.Execute(); err != nil {
+		return nil, err
+	}
+
+	if g.revParse("refs/stash") == before {
+		return nil, nil
+	}
+
+	entries, err := g.StashList(WithArgs("--max-count", "1"))
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
+
+// StashList returns the stash entries, most recent first
+func (g *gitImpl) StashList(opts ...Option) ([]types.StashEntry, error) {
+	reflogs, err := g.listReflog(func(extra ...string) Command {
+		cmd := g.newCommand("stash", "list", reflogFormat)
+		cmd.ApplyOptions(opts...)
+		cmd.AddArgs(extra...)
+		return cmd
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []types.StashEntry{}
+	for _, reflog := range reflogs {
+		entry := types.StashEntry{
+			Index:     -1,
+			Selector:  reflog.Selector,
+			SHA:       reflog.NewSHA,
+			Message:   reflog.Message,
+			Timestamp: reflog.Timestamp,
+		}
+
+		// The selector holds the index, also when entries are skipped
+		if index, found := reflogIndex(reflog.Selector); found {
+			entry.Index = index
+		}
+
+		// "WIP on <branch>: <sha> <subject>" or "On <branch>: <message>"
+		if prefix, _, found := strings.Cut(reflog.Subject, ": "); found {
+			prefix = strings.TrimPrefix(prefix, "WIP on ")
+			entry.Branch = strings.TrimPrefix(prefix, "On ")
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// StashShow returns the changes recorded in a stash entry. An empty stash
+// shows the most recent entry.
+func (g *gitImpl) StashShow(stash string, opts ...Option) ([]types.Diff, error) {
+	cmd := g.newCommand("stash", "show", "--patch", "--no-color", "--no-ext-diff")
+	cmd.AddArgs(diffPrefixArgs...)
+	cmd.ApplyOptions(opts...)
+	if stash != "" {
+		cmd.AddArgs(stash)
+	}
+
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(output) == 0 {
+		return []types.Diff{}, nil
+	}
+	return parseDiffOutput(string(output)), nil
+}
+
+// StashApply applies a stash entry to the working tree. Conflicts are not
+// reported as an error; the result lists the conflicted files instead
+func (g *gitImpl) StashApply(stash string, opts ...Option) (*types.MergeResult, error) {
+	return g.applyStash("apply", stash, opts...)
+}
+
+// StashPop applies a stash entry and drops it. When applying it conflicts the
+// entry is kept and the result lists the conflicted files
+func (g *gitImpl) StashPop(stash string, opts ...Option) (*types.MergeResult, error) {
+	return g.applyStash("pop", stash, opts...)
+}
+
+// applyStash runs stash apply or pop and reports conflicts like Merge does
+func (g *gitImpl) applyStash(action, stash string, opts ...Option) (*types.MergeResult, error) {
+	cmd := g.newCommand("stash", action)
+	cmd.ApplyOptions(opts...)
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+	if stash != "" {
+		cmd.AddArgs(stash)
+	}
+
+	result := &types.MergeResult{
+		MergedBranch: stash,
+		BaseBranch:   g.currentBranch(),
+	}
+	if result.MergedBranch == "" {
+		result.MergedBranch = "stash@{0}"
+	}
+
+	if _, err := cmd.Execute(); err != nil {
+		if g.collectConflicts(result, err) {
+			return result, nil
+		}
+		return result, err
+	}
+
+	result.Success = true
+	return result, nil
+}
+
+// StashDrop removes a stash entry. An empty stash drops the most recent entry.
+func (g *gitImpl) StashDrop(stash string, opts ...Option) error {
+	cmd := g.newCommand("stash", "drop")
+	cmd.ApplyOptions(opts...)
+	if stash != "" {
+		cmd.AddArgs(stash)
+	}
+	_, err := cmd.Execute()
+	return err
+}
+
+// StashClear removes all stash entries
+func (g *gitImpl) StashClear(opts ...Option) error {
+	cmd := g.newCommand("stash", "clear")
+	cmd.ApplyOptions(opts...)
+	_, err := cmd.Execute()
+	return err
+}
+
+// StashBranch creates and checks out a branch at the commit the stash was
+// based on, applies the stash and drops it when it applied cleanly
+func (g *gitImpl) StashBranch(branch, stash string, opts ...Option) error {
+	cmd := g.newCommand("stash", "branch")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(branch)
+	if stash != "" {
+		cmd.AddArgs(stash)
+	}
+	_, err := cmd.Execute()
+	return err
+}
@@ -0,0 +1,135 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test stashing changes and listing and showing stash entries
+func TestStashPushListShow(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	entry, err := gitInstance.StashPush()
+	require.NoError(t, err)
+	assert.Nil(t, entry, "nothing to stash")
+
+	readme := filepath.Join(tempDir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("first\n"), 0644))
+	entry, err = gitInstance.StashPush()
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, "stash@{0}", entry.Selector)
+	assert.Equal(t, "main", entry.Branch)
+	assert.Contains(t, entry.Message, "Initial commit")
+
+	require.NoError(t, os.WriteFile(readme, []byte("second\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "untracked.txt"), []byte("new\n"), 0644))
+	entry, err = gitInstance.StashPush(
+		git.StashWithMessage("Second stash"),
+		git.StashWithIncludeUntracked(),
+		git.StashWithPathspec("README.md", "untracked.txt"),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, "Second stash", entry.Message)
+	assert.NoFileExists(t, filepath.Join(tempDir, "untracked.txt"))
+
+	entries, err := gitInstance.StashList()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, 0, entries[0].Index)
+	assert.Equal(t, "Second stash", entries[0].Message)
+	assert.Equal(t, 1, entries[1].Index)
+	assert.Equal(t, "stash@{1}", entries[1].Selector)
+	assert.False(t, entries[1].Timestamp.IsZero())
+
+	// Indexes come from the selectors, not the position in the result
+	entries, err = gitInstance.StashList(git.WithArgs("--skip=1"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, 1, entries[0].Index)
+	assert.Equal(t, "stash@{1}", entries[0].Selector)
+	assert.Contains(t, entries[0].Message, "Initial commit")
+
+	status, err := gitInstance.DetailedStatus()
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.StashCount)
+
+	diffs, err := gitInstance.StashShow("stash@{1}")
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	assert.Equal(t, "README.md", diffs[0].NewFile)
+	require.Len(t, diffs[0].Hunks, 1)
+	assert.Contains(t, diffs[0].Hunks[0].Lines, types.DiffLine{Type: types.DiffLineAdded, Content: "first", NewLine: 1})
+}
+
+// Test applying, popping, dropping and branching from stash entries
+func TestStashApplyPopDropBranch(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	readme := filepath.Join(tempDir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("stashed\n"), 0644))
+	_, err = gitInstance.StashPush()
+	require.NoError(t, err)
+
+	// Conflicting change in the working tree
+	require.NoError(t, os.WriteFile(readme, []byte("committed\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"README.md"}))
+	require.NoError(t, gitInstance.Commit("Conflicting change"))
+
+	result, err := gitInstance.StashPop("")
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+	assert.Equal(t, "stash@{0}", result.MergedBranch)
+	assert.Equal(t, []string{"README.md"}, result.ConflictedFiles)
+	require.Len(t, result.Conflicts, 1)
+	require.Len(t, result.Conflicts[0].Sections, 1)
+	assert.Equal(t, "stashed\n", result.Conflicts[0].Sections[0].TheirContent)
+
+	// A conflicting pop keeps the entry
+	entries, err := gitInstance.StashList()
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, gitInstance.Reset([]string{}, git.WithArgs("--hard")))
+	require.NoError(t, gitInstance.StashBranch("from-stash", ""))
+	content, err := os.ReadFile(readme)
+	require.NoError(t, err)
+	assert.Equal(t, "stashed\n", string(content))
+	entries, err = gitInstance.StashList()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = gitInstance.StashPush(git.StashWithMessage("Apply me"))
+	require.NoError(t, err)
+	result, err = gitInstance.StashApply("stash@{0}")
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "from-stash", result.BaseBranch)
+	entries, err = gitInstance.StashList()
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, gitInstance.StashDrop("stash@{0}"))
+	_, err = gitInstance.StashApply("")
+	assert.Error(t, err, "no stash entries left")
+
+	_, err = gitInstance.StashPush(git.StashWithMessage("Cleared"))
+	require.NoError(t, err)
+	require.NoError(t, gitInstance.StashClear())
+	entries, err = gitInstance.StashList()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -0,0 +1,106 @@
+package git
+
+import (
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// stateFiles are the git directory entries State inspects, besides the
+// rebaseProgressFiles
+var stateFiles = []string{
+	"rebase-merge/interactive",
+	"rebase-merge/head-name",
+	"rebase-merge/onto",
+	"rebase-merge/orig-head",
+	"rebase-apply/applying",
+	"rebase-apply/head-name",
+	"rebase-apply/onto",
+	"rebase-apply/orig-head",
+	"MERGE_HEAD",
+	"MERGE_MSG",
+	"CHERRY_PICK_HEAD",
+	"REVERT_HEAD",
+	"sequencer",
+	"sequencer/todo",
+	"BISECT_LOG",
+	"BISECT_START",
+}
+
+// State reports which operation is in progress (merge, rebase, am,
+// cherry-pick, revert or bisect) from the state files git keeps in the git
+// directory, read through the executor. Like git status, a rebase or am takes
+// precedence over a merge, cherry-pick or revert it stopped in, and bisect is
+// reported last
+func (g *gitImpl) State() (*types.RepositoryState, error) {
+	names := append(append([]string{}, stateFiles...), rebaseProgressFiles...)
+	files, err := g.readGitFiles(names...)
+	if err != nil {
+		return nil, err
+	}
+	exists := func(name string) bool {
+		_, found := files[name]
+		return found
+	}
+
+	state := &types.RepositoryState{}
+	switch {
+	case exists("rebase-merge"):
+		state.Operation = types.RepositoryOperationRebase
+		state.RebaseBackend = "merge"
+		state.Interactive = exists("rebase-merge/interactive")
+		g.loadRebaseState(state, "rebase-merge", files)
+	case exists("rebase-apply/applying"):
+		state.Operation = types.RepositoryOperationAm
+		g.loadRebaseState(state, "rebase-apply", files)
+	case exists("rebase-apply"):
+		state.Operation = types.RepositoryOperationRebase
+		state.RebaseBackend = "apply"
+		g.loadRebaseState(state, "rebase-apply", files)
+	case exists("MERGE_HEAD"):
+		state.Operation = types.RepositoryOperationMerge
+		state.MergeHeads = strings.Fields(files["MERGE_HEAD"])
+	case exists("CHERRY_PICK_HEAD"):
+		state.Operation = types.RepositoryOperationCherryPick
+		state.StoppedAt = strings.TrimSpace(files["CHERRY_PICK_HEAD"])
+	case exists("REVERT_HEAD"):
+		state.Operation = types.RepositoryOperationRevert
+		state.StoppedAt = strings.TrimSpace(files["REVERT_HEAD"])
+	case exists("sequencer/todo"):
+		// A sequence whose stopped commit was committed by hand
+		state.Operation = types.RepositoryOperationCherryPick
+		if strings.HasPrefix(strings.TrimSpace(files["sequencer/todo"]), "revert") {
+			state.Operation = types.RepositoryOperationRevert
+		}
+	case exists("BISECT_LOG"):
+		state.Operation = types.RepositoryOperationBisect
+		state.HeadName = strings.TrimSpace(files["BISECT_START"])
+		return state, nil
+	default:
+		return state, nil
+	}
+
+	switch state.Operation {
+	case types.RepositoryOperationCherryPick, types.RepositoryOperationRevert:
+		state.Sequence = exists("sequencer")
+	}
+	state.Message = files["MERGE_MSG"]
+	return state, nil
+}
+
+// loadRebaseState fills the details of a rebase or am from the files of its
+// state directory
+func (g *gitImpl) loadRebaseState(state *types.RepositoryState, dir string, files map[string]string) {
+	headName := strings.TrimSpace(files[dir+"/head-name"])
+	if headName != "detached HEAD" {
+		state.HeadName = strings.TrimPrefix(headName, "refs/heads/")
+	}
+	state.Onto = strings.TrimSpace(files[dir+"/onto"])
+	state.OrigHead = strings.TrimSpace(files[dir+"/orig-head"])
+
+	progress := &types.RebaseResult{}
+	g.parseRebaseProgress(progress, files)
+	state.Step = progress.Step
+	state.TotalSteps = progress.TotalSteps
+	state.StoppedAt = progress.StoppedAt
+}
@@ -0,0 +1,138 @@
+package git_test
+
+import (
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test idle repositories, merges and directories outside of a repository
+func TestStateMerge(t *testing.T) {
+	_, gitInstance := setupConflict(t, "file.txt")
+
+	state, err := gitInstance.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationNone, state.Operation)
+
+	feature, err := gitInstance.Log(git.LogWithMaxCount("1"), git.WithArgs("feature"))
+	require.NoError(t, err)
+	_, err = gitInstance.Merge(git.MergeWithBranch("feature"))
+	require.NoError(t, err)
+
+	state, err = gitInstance.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationMerge, state.Operation)
+	assert.Equal(t, []string{feature[0].Commit}, state.MergeHeads)
+	assert.Contains(t, state.Message, "Merge branch 'feature'")
+
+	require.NoError(t, gitInstance.MergeAbort())
+	state, err = gitInstance.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationNone, state.Operation)
+
+	outside, err := git.NewGit()
+	require.NoError(t, err)
+	outside.SetWorkingDirectory(t.TempDir())
+	_, err = outside.State()
+	assert.Error(t, err)
+}
+
+// Test rebases with the merge and apply backends
+func TestStateRebase(t *testing.T) {
+	_, gitInstance, commits := setupRebasable(t)
+	base, err := gitInstance.Log(git.LogWithMaxCount("1"), git.WithArgs("HEAD~3"))
+	require.NoError(t, err)
+
+	_, err = gitInstance.RebaseInteractive("HEAD~3", []types.RebaseTodo{
+		{Action: types.RebaseActionPick, Commit: commits[0]},
+		{Action: types.RebaseActionEdit, Commit: commits[1]},
+		{Action: types.RebaseActionPick, Commit: commits[2]},
+	})
+	require.NoError(t, err)
+
+	state, err := gitInstance.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationRebase, state.Operation)
+	assert.Equal(t, "merge", state.RebaseBackend)
+	assert.True(t, state.Interactive)
+	assert.Equal(t, "main", state.HeadName)
+	assert.Equal(t, base[0].Commit, state.Onto)
+	assert.Equal(t, commits[2], state.OrigHead)
+	assert.Equal(t, 2, state.Step)
+	assert.Equal(t, 3, state.TotalSteps)
+	require.NoError(t, gitInstance.RebaseAbort())
+
+	_, gitInstance, commits = setupPickable(t)
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	_, err = gitInstance.Rebase(git.WithArgs("--apply", "main"))
+	require.NoError(t, err)
+
+	state, err = gitInstance.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationRebase, state.Operation)
+	assert.Equal(t, "apply", state.RebaseBackend)
+	assert.False(t, state.Interactive)
+	assert.Equal(t, "feature", state.HeadName)
+	assert.Equal(t, commits[2], state.OrigHead)
+	assert.Equal(t, 1, state.Step)
+	assert.Equal(t, 3, state.TotalSteps)
+}
+
+// Test cherry-pick sequences and single commit reverts
+func TestStateSequencer(t *testing.T) {
+	_, gitInstance, commits := setupPickable(t)
+
+	_, err := gitInstance.CherryPick([]string{"main..feature"})
+	require.NoError(t, err)
+	state, err := gitInstance.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationCherryPick, state.Operation)
+	assert.Equal(t, commits[0], state.StoppedAt)
+	assert.True(t, state.Sequence)
+	assert.Contains(t, state.Message, "Feature README.md")
+	require.NoError(t, gitInstance.CherryPickAbort())
+
+	// Reverting the initial commit conflicts with the change on main
+	initial, err := gitInstance.Log(git.LogWithMaxCount("1"), git.WithArgs("HEAD~1"))
+	require.NoError(t, err)
+	_, err = gitInstance.Revert([]string{initial[0].Commit})
+	require.NoError(t, err)
+	state, err = gitInstance.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationRevert, state.Operation)
+	assert.Equal(t, initial[0].Commit, state.StoppedAt)
+	assert.False(t, state.Sequence)
+}
+
+// Test state and stopped sequences behind an executor without local access
+func TestStateRemoteExecutor(t *testing.T) {
+	dir, _, commits := setupPickable(t)
+	remote := openRemoteRepo(t, dir)
+
+	state, err := remote.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationNone, state.Operation)
+
+	result, err := remote.CherryPick(commits)
+	require.NoError(t, err)
+	require.False(t, result.Success)
+
+	state, err = remote.State()
+	require.NoError(t, err)
+	assert.Equal(t, types.RepositoryOperationCherryPick, state.Operation)
+	assert.Equal(t, commits[0], state.StoppedAt)
+	assert.True(t, state.Sequence)
+	assert.Contains(t, state.Message, "Feature README.md")
+
+	// Skipping pairs the remaining commits from the sequencer todo list
+	result, err = remote.CherryPickSkip()
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	require.Len(t, result.Applied, 2)
+	assert.Equal(t, commits[1], result.Applied[0].Source)
+	assert.Equal(t, commits[2], result.Applied[1].Source)
+}
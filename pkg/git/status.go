@@ -1,72 +1,235 @@
 package git
 
 import (
+	"strconv"
 	"strings"
+
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
-// Status shows the working tree status
+// Status shows the working tree status. Each path is reported once, with its
+// staged status if it has one and its working tree status otherwise; use
+// DetailedStatus to see both sides
 func (g *gitImpl) Status(opts ...Option) ([]types.File, error) {
-	cmd := g.newCommand("status", "--porcelain")
-	
+	result, err := g.DetailedStatus(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []types.File{}
+	for _, entry := range result.Entries {
+		file := types.File{
+			Status: entry.Staged,
+			Name:   entry.Path,
+		}
+		switch {
+		case entry.Conflict != "":
+			file.Status = types.FileStatusUpdated
+		case entry.Staged == types.FileStatusUnmodified:
+			file.Status = entry.Unstaged
+		}
+		if entry.OrigPath != "" {
+			file.Name = entry.OrigPath
+			file.Destination = entry.Path
+		}
+		files = append(files, file)
+	}
+
+	return files, nil
+}
+
+// DetailedStatus shows the working tree status with staged and unstaged
+// changes reported separately, conflicts, submodule state and branch information
+func (g *gitImpl) DetailedStatus(opts ...Option) (*types.StatusResult, error) {
+	cmd := g.newCommand("status", "--porcelain=v2", "--branch", "--show-stash", "-z")
+
 	// Apply all provided options
 	cmd.ApplyOptions(opts...)
-	
+
 	output, err := cmd.Execute()
 	if err != nil {
 		return nil, err
 	}
 
-	files := []types.File{}
-	lines := strings.Split(string(output), "\n")
-	for _, file := range lines {
-		if file == "" {
+	return parseStatusV2(string(output)), nil
+}
+
+// parseStatusV2 parses NUL-terminated `git status --porcelain=v2` records
+func parseStatusV2(output string) *types.StatusResult {
+	result := &types.StatusResult{
+		Entries: []types.StatusEntry{},
+	}
+
+	records := strings.Split(output, "\x00")
+	for i := 0; i < len(records); i++ {
+		record := records[i]
+		if len(record) < 2 {
 			continue
 		}
 
-		switch file[:2] {
-		case "??":
-			files = append(files, types.File{
-				Status: types.FileStatusUntracked,
-				Name:   file[3:],
-			})
-		case "A ":
-			files = append(files, types.File{
-				Status: types.FileStatusAdded,
-				Name:   file[3:],
-			})
-		case "M ":
-			files = append(files, types.File{
-				Status: types.FileStatusModified,
-				Name:   file[3:],
-			})
-		case "D ":
-			files = append(files, types.File{
-				Status: types.FileStatusDeleted,
-				Name:   file[3:],
+		switch record[0] {
+		case '#':
+			parseStatusHeader(result, record)
+		case '1':
+			fields := strings.SplitN(record, " ", 9)
+			if len(fields) < 9 {
+				continue
+			}
+			entry := parseStatusFields(fields[1:8])
+			entry.Path = fields[8]
+			result.Entries = append(result.Entries, entry)
+		case '2':
+			fields := strings.SplitN(record, " ", 10)
+			if len(fields) < 10 {
+				continue
+			}
+			entry := parseStatusFields(fields[1:8])
+			entry.Score, _ = strconv.Atoi(fields[8][1:])
+			entry.Path = fields[9]
+			// The source path follows as a separate record
+			if i+1 < len(records) {
+				i++
+				entry.OrigPath = records[i]
+			}
+			result.Entries = append(result.Entries, entry)
+		case 'u':
+			fields := strings.SplitN(record, " ", 11)
+			if len(fields) < 11 {
+				continue
+			}
+			entry := types.StatusEntry{
+				Path:      fields[10],
+				Staged:    types.FileStatusUpdated,
+				Unstaged:  types.FileStatusUpdated,
+				Conflict:  conflictStatusFromXY(fields[1]),
+				Submodule: parseSubmoduleState(fields[2]),
+			}
+			entry.WorktreeMode = parseOctal(fields[6])
+			result.Entries = append(result.Entries, entry)
+		case '?':
+			result.Entries = append(result.Entries, types.StatusEntry{
+				Path:     record[2:],
+				Staged:   types.FileStatusUntracked,
+				Unstaged: types.FileStatusUntracked,
 			})
-		case "R ":
-			parts := strings.Split(file[3:], " -> ")
-			files = append(files, types.File{
-				Status:      types.FileStatusRenamed,
-				Name:        parts[0],
-				Destination: parts[1],
-			})
-		case "C ":
-			parts := strings.Split(file[3:], " -> ")
-			files = append(files, types.File{
-				Status:      types.FileStatusCopied,
-				Name:        parts[0],
-				Destination: parts[1],
-			})
-		case "U ":
-			files = append(files, types.File{
-				Status: types.FileStatusUpdated,
-				Name:   file[3:],
+		case '!':
+			result.Entries = append(result.Entries, types.StatusEntry{
+				Path:     record[2:],
+				Staged:   types.FileStatusIgnored,
+				Unstaged: types.FileStatusIgnored,
 			})
 		}
-		// TODO: add more cases for other status codes
 	}
 
-	return files, nil
-}
\ No newline at end of file
+	return result
+}
+
+// parseStatusHeader handles "# branch.*" and "# stash" header lines
+func parseStatusHeader(result *types.StatusResult, record string) {
+	fields := strings.Fields(record)
+	if len(fields) < 3 {
+		return
+	}
+
+	switch fields[1] {
+	case "branch.oid":
+		if fields[2] != "(initial)" {
+			result.Branch.OID = fields[2]
+		}
+	case "branch.head":
+		if fields[2] == "(detached)" {
+			result.Branch.Detached = true
+		} else {
+			result.Branch.Head = fields[2]
+		}
+	case "branch.upstream":
+		result.Branch.Upstream = fields[2]
+	case "branch.ab":
+		if len(fields) >= 4 {
+			result.Branch.Ahead, _ = strconv.Atoi(strings.TrimPrefix(fields[2], "+"))
+			result.Branch.Behind, _ = strconv.Atoi(strings.TrimPrefix(fields[3], "-"))
+		}
+	case "stash":
+		result.StashCount, _ = strconv.Atoi(fields[2])
+	}
+}
+
+// parseStatusFields parses the "<XY> <sub> <mH> <mI> <mW> <hH> <hI>" fields
+// shared by ordinary and rename/copy entries
+func parseStatusFields(fields []string) types.StatusEntry {
+	return types.StatusEntry{
+		Staged:       fileStatusFromCode(fields[0][0]),
+		Unstaged:     fileStatusFromCode(fields[0][1]),
+		Submodule:    parseSubmoduleState(fields[1]),
+		HeadMode:     parseOctal(fields[2]),
+		IndexMode:    parseOctal(fields[3]),
+		WorktreeMode: parseOctal(fields[4]),
+		HeadSHA:      fields[5],
+		IndexSHA:     fields[6],
+	}
+}
+
+// parseSubmoduleState parses the "N..." or "S<c><m><u>" submodule field
+func parseSubmoduleState(field string) *types.SubmoduleState {
+	if len(field) != 4 || field[0] != 'S' {
+		return nil
+	}
+	return &types.SubmoduleState{
+		CommitChanged:    field[1] == 'C',
+		TrackedChanges:   field[2] == 'M',
+		UntrackedChanges: field[3] == 'U',
+	}
+}
+
+// fileStatusFromCode maps one side of a porcelain XY status code
+func fileStatusFromCode(code byte) types.FileStatus {
+	switch code {
+	case '.':
+		return types.FileStatusUnmodified
+	case 'M':
+		return types.FileStatusModified
+	case 'T':
+		return types.FileStatusTypeChanged
+	case 'A':
+		return types.FileStatusAdded
+	case 'D':
+		return types.FileStatusDeleted
+	case 'R':
+		return types.FileStatusRenamed
+	case 'C':
+		return types.FileStatusCopied
+	case 'U':
+		return types.FileStatusUpdated
+	default:
+		return types.FileStatusUnspecified
+	}
+}
+
+// conflictStatusFromXY maps the XY code of an unmerged entry to its conflict type
+func conflictStatusFromXY(xy string) types.ConflictStatus {
+	switch xy {
+	case "DD":
+		return types.ConflictStatusBothDeleted
+	case "AU":
+		return types.ConflictStatusAddedByUs
+	case "UD":
+		return types.ConflictStatusDeletedByThem
+	case "UA":
+		return types.ConflictStatusAddedByThem
+	case "DU":
+		return types.ConflictStatusDeletedByUs
+	case "AA":
+		return types.ConflictStatusBothAdded
+	default:
+		return types.ConflictStatusBothModified
+	}
+}
+
+// parseOctal parses an octal file mode, returning 0 when it is invalid
+func parseOctal(mode string) int {
+	value, err := strconv.ParseInt(mode, 8, 32)
+	if err != nil {
+		return 0
+	}
+	return int(value)
+}
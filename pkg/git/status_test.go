@@ -0,0 +1,177 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusEntries indexes status entries by path
+func statusEntries(result *types.StatusResult) map[string]types.StatusEntry {
+	entries := make(map[string]types.StatusEntry)
+	for _, entry := range result.Entries {
+		entries[entry.Path] = entry
+	}
+	return entries
+}
+
+// Test staged and unstaged changes are reported separately
+func TestDetailedStatusStagedAndUnstaged(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "tracked.txt"), []byte("v1\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"tracked.txt"}))
+	require.NoError(t, gitInstance.Commit("Add tracked file"))
+
+	// README.md: staged and modified again (MM)
+	readme := filepath.Join(tempDir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("staged\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"README.md"}))
+	require.NoError(t, os.WriteFile(readme, []byte("unstaged\n"), 0644))
+
+	// tracked.txt: modified in the working tree only ( M)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "tracked.txt"), []byte("v2\n"), 0644))
+
+	// new.txt: added then modified (AM)
+	newFile := filepath.Join(tempDir, "new.txt")
+	require.NoError(t, os.WriteFile(newFile, []byte("new\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"new.txt"}))
+	require.NoError(t, os.WriteFile(newFile, []byte("newer\n"), 0644))
+
+	// Untracked path with spaces and quotes
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, `we"ird name.txt`), []byte("x"), 0644))
+
+	result, err := gitInstance.DetailedStatus()
+	require.NoError(t, err)
+	assert.Equal(t, "main", result.Branch.Head)
+	assert.False(t, result.Branch.Detached)
+	assert.Len(t, result.Branch.OID, 40)
+
+	entries := statusEntries(result)
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, types.FileStatusModified, entries["README.md"].Staged)
+	assert.Equal(t, types.FileStatusModified, entries["README.md"].Unstaged)
+	assert.Equal(t, types.FileStatusUnmodified, entries["tracked.txt"].Staged)
+	assert.Equal(t, types.FileStatusModified, entries["tracked.txt"].Unstaged)
+	assert.Equal(t, types.FileStatusAdded, entries["new.txt"].Staged)
+	assert.Equal(t, types.FileStatusModified, entries["new.txt"].Unstaged)
+	assert.Equal(t, 0100644, entries["new.txt"].IndexMode)
+	assert.Equal(t, types.FileStatusUntracked, entries[`we"ird name.txt`].Staged)
+
+	// The flat view keeps one status per file
+	files, err := gitInstance.Status()
+	require.NoError(t, err)
+	statusMap := make(map[string]types.FileStatus)
+	for _, file := range files {
+		statusMap[file.Name] = file.Status
+	}
+	assert.Equal(t, types.FileStatusModified, statusMap["tracked.txt"])
+	assert.Equal(t, types.FileStatusAdded, statusMap["new.txt"])
+	assert.Equal(t, types.FileStatusUntracked, statusMap[`we"ird name.txt`])
+}
+
+// Test renames report the source path and similarity score
+func TestDetailedStatusRename(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.Remove(git.WithArgs("--cached", "README.md")))
+	require.NoError(t, os.Rename(filepath.Join(tempDir, "README.md"), filepath.Join(tempDir, "read me.md")))
+	require.NoError(t, gitInstance.Add([]string{"read me.md"}))
+
+	result, err := gitInstance.DetailedStatus()
+	require.NoError(t, err)
+	require.Len(t, result.Entries, 1)
+
+	entry := result.Entries[0]
+	assert.Equal(t, types.FileStatusRenamed, entry.Staged)
+	assert.Equal(t, "read me.md", entry.Path)
+	assert.Equal(t, "README.md", entry.OrigPath)
+	assert.Equal(t, 100, entry.Score)
+
+	files, err := gitInstance.Status()
+	require.NoError(t, err)
+	assert.Equal(t, []types.File{{
+		Status:      types.FileStatusRenamed,
+		Name:        "README.md",
+		Destination: "read me.md",
+	}}, files)
+}
+
+// Test unmerged entries report their conflict type
+func TestDetailedStatusConflicts(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "gone.txt"), []byte("base\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{"gone.txt"}))
+	require.NoError(t, gitInstance.Commit("Add gone.txt"))
+
+	require.NoError(t, gitInstance.CreateBranch("feature"))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("main\n"), 0644))
+	require.NoError(t, gitInstance.Remove(git.WithArgs("gone.txt")))
+	require.NoError(t, gitInstance.Add([]string{}, git.AddWithAll()))
+	require.NoError(t, gitInstance.Commit("Change on main"))
+
+	_, err = gitInstance.Checkout(git.CheckoutWithBranch("feature"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("feature\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "gone.txt"), []byte("changed\n"), 0644))
+	require.NoError(t, gitInstance.Add([]string{}))
+	require.NoError(t, gitInstance.Commit("Change on feature"))
+
+	result, err := gitInstance.Merge(git.MergeWithBranch("main"))
+	require.NoError(t, err)
+	require.False(t, result.Success)
+
+	status, err := gitInstance.DetailedStatus()
+	require.NoError(t, err)
+	entries := statusEntries(status)
+	assert.Equal(t, types.ConflictStatusBothModified, entries["README.md"].Conflict)
+	assert.Equal(t, types.ConflictStatusDeletedByThem, entries["gone.txt"].Conflict)
+	assert.Equal(t, types.FileStatusUpdated, entries["gone.txt"].Staged)
+}
+
+// Test upstream tracking information and detached HEAD
+func TestDetailedStatusBranch(t *testing.T) {
+	_, upstreamDir, downstreamDir := setupRemotePair(t)
+	upstream := openTestRepo(t, upstreamDir)
+	downstream := openTestRepo(t, downstreamDir)
+
+	require.NoError(t, upstream.Commit("Upstream commit", git.CommitWithAllowEmpty()))
+	_, err := upstream.Push(git.PushWithRemote("origin", "main"))
+	require.NoError(t, err)
+
+	require.NoError(t, downstream.Commit("Local commit 1", git.CommitWithAllowEmpty()))
+	require.NoError(t, downstream.Commit("Local commit 2", git.CommitWithAllowEmpty()))
+	_, err = downstream.Fetch()
+	require.NoError(t, err)
+
+	result, err := downstream.DetailedStatus()
+	require.NoError(t, err)
+	assert.Equal(t, "main", result.Branch.Head)
+	assert.Equal(t, "origin/main", result.Branch.Upstream)
+	assert.Equal(t, 2, result.Branch.Ahead)
+	assert.Equal(t, 1, result.Branch.Behind)
+
+	_, err = downstream.Checkout(git.CheckoutWithCommit("HEAD~1"))
+	require.NoError(t, err)
+	result, err = downstream.DetailedStatus()
+	require.NoError(t, err)
+	assert.True(t, result.Branch.Detached)
+	assert.Empty(t, result.Branch.Head)
+	assert.Empty(t, result.Branch.Upstream)
+}
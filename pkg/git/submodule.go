@@ -0,0 +1,110 @@
+package git
+
+import (
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// SubmoduleAdd adds the repository at url as a submodule at path
+func (g *gitImpl) SubmoduleAdd(url, path string, opts ...Option) error {
+	cmd := g.newCommand("submodule", "add")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs("--", url, path)
+	_, err := cmd.Execute()
+	return err
+}
+
+// SubmoduleInit registers submodules in the repository configuration
+func (g *gitImpl) SubmoduleInit(opts ...Option) error {
+	cmd := g.newCommand("submodule", "init")
+	cmd.ApplyOptions(opts...)
+	_, err := cmd.Execute()
+	return err
+}
+
+// SubmoduleUpdate checks out the commits recorded for submodules
+func (g *gitImpl) SubmoduleUpdate(opts ...Option) error {
+	cmd := g.newCommand("submodule", "update")
+	cmd.ApplyOptions(opts...)
+	_, err := cmd.Execute()
+	return err
+}
+
+// SubmoduleStatus returns the state of each submodule
+func (g *gitImpl) SubmoduleStatus(opts ...Option) ([]types.Submodule, error) {
+	cmd := g.newCommand("submodule", "status")
+	cmd.ApplyOptions(opts...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return parseSubmoduleStatus(string(output)), nil
+}
+
+// SubmoduleSync updates submodule remote URLs from .gitmodules
+func (g *gitImpl) SubmoduleSync(opts ...Option) error {
+	cmd := g.newCommand("submodule", "sync")
+	cmd.ApplyOptions(opts...)
+	_, err := cmd.Execute()
+	return err
+}
+
+// SubmoduleDeinit unregisters submodules and removes their working trees.
+// Pass WithArgs("--all") and no paths to deinitialize every submodule
+func (g *gitImpl) SubmoduleDeinit(paths []string, opts ...Option) error {
+	cmd := g.newCommand("submodule", "deinit")
+	cmd.ApplyOptions(opts...)
+	if len(paths) > 0 {
+		cmd.AddArgs("--")
+		cmd.AddArgs(paths...)
+	}
+	_, err := cmd.Execute()
+	return err
+}
+
+// SubmoduleForeach runs a shell command in each checked out submodule and
+// returns its combined output
+func (g *gitImpl) SubmoduleForeach(command string, opts ...Option) (string, error) {
+	cmd := g.newCommand("submodule", "foreach")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(command)
+	output, err := cmd.ExecuteCombined()
+	return string(output), err
+}
+
+// parseSubmoduleStatus parses "<flag><sha> <path>[ (<describe>)]" lines,
+// where flag is ' ', '-' (not initialized), '+' (out of sync) or 'U' (conflict)
+func parseSubmoduleStatus(output string) []types.Submodule {
+	submodules := []types.Submodule{}
+
+	for _, line := range strings.Split(output, "\n") {
+		if len(line) < 2 {
+			continue
+		}
+
+		flag := line[0]
+		sha, rest, found := strings.Cut(line[1:], " ")
+		if !found {
+			continue
+		}
+
+		submodule := types.Submodule{
+			SHA:         sha,
+			Path:        rest,
+			Initialized: flag != '-',
+			OutOfSync:   flag == '+',
+			Conflict:    flag == 'U',
+		}
+		if strings.HasSuffix(rest, ")") {
+			if i := strings.LastIndex(rest, " ("); i >= 0 {
+				submodule.Path = rest[:i]
+				submodule.Describe = rest[i+2 : len(rest)-1]
+			}
+		}
+
+		submodules = append(submodules, submodule)
+	}
+
+	return submodules
+}
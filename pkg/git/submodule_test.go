@@ -0,0 +1,103 @@
+package git_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// allowFileProtocol lets submodules be cloned from local paths (git 2.38.1+)
+var allowFileProtocol = git.WithConfig("protocol.file.allow", "always")
+
+// setupSuperproject creates a repository with a "lib" submodule
+func setupSuperproject(t *testing.T) (superDir, libDir string) {
+	libDir = setupTestRepo(t)
+	superDir = setupTestRepo(t)
+
+	super := openTestRepo(t, superDir)
+	require.NoError(t, super.SubmoduleAdd(libDir, "lib", allowFileProtocol))
+	require.NoError(t, super.Commit("Add lib submodule"))
+	return superDir, libDir
+}
+
+// Test submodule status reflects the state of the submodule checkout
+func TestSubmoduleStatus(t *testing.T) {
+	superDir, libDir := setupSuperproject(t)
+	super := openTestRepo(t, superDir)
+
+	libLogs, err := openTestRepo(t, libDir).Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+
+	submodules, err := super.SubmoduleStatus()
+	require.NoError(t, err)
+	require.Len(t, submodules, 1)
+	assert.Equal(t, "lib", submodules[0].Path)
+	assert.Equal(t, libLogs[0].Commit, submodules[0].SHA)
+	assert.Equal(t, "heads/main", submodules[0].Describe)
+	assert.True(t, submodules[0].Initialized)
+	assert.False(t, submodules[0].OutOfSync)
+
+	// Move the submodule checkout away from the recorded commit
+	lib := openTestRepo(t, filepath.Join(superDir, "lib"))
+	require.NoError(t, lib.Commit("Submodule change", git.CommitWithAllowEmpty()))
+
+	submodules, err = super.SubmoduleStatus()
+	require.NoError(t, err)
+	require.Len(t, submodules, 1)
+	assert.True(t, submodules[0].OutOfSync)
+
+	status, err := super.DetailedStatus()
+	require.NoError(t, err)
+	require.Len(t, status.Entries, 1)
+	assert.Equal(t, "lib", status.Entries[0].Path)
+	require.NotNil(t, status.Entries[0].Submodule)
+	assert.Equal(t, types.SubmoduleState{CommitChanged: true}, *status.Entries[0].Submodule)
+
+	output, err := super.SubmoduleForeach("git log -1 --format=%s")
+	require.NoError(t, err)
+	assert.Contains(t, output, "Entering 'lib'")
+	assert.Contains(t, output, "Submodule change")
+}
+
+// Test cloning, initializing, updating and deinitializing submodules
+func TestSubmoduleLifecycle(t *testing.T) {
+	superDir, _ := setupSuperproject(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+
+	recursiveDir := filepath.Join(t.TempDir(), "recursive")
+	require.NoError(t, gitInstance.Clone(superDir, recursiveDir, git.CloneWithRecurseSubmodules(), allowFileProtocol))
+	submodules, err := openTestRepo(t, recursiveDir).SubmoduleStatus()
+	require.NoError(t, err)
+	require.Len(t, submodules, 1)
+	assert.True(t, submodules[0].Initialized)
+
+	plainDir := filepath.Join(t.TempDir(), "plain")
+	require.NoError(t, gitInstance.Clone(superDir, plainDir))
+	clone := openTestRepo(t, plainDir)
+
+	submodules, err = clone.SubmoduleStatus()
+	require.NoError(t, err)
+	require.Len(t, submodules, 1)
+	assert.False(t, submodules[0].Initialized)
+
+	require.NoError(t, clone.SubmoduleInit(git.SubmoduleWithPaths("lib")))
+	require.NoError(t, clone.SubmoduleUpdate(git.SubmoduleWithRecursive(), allowFileProtocol))
+	require.NoError(t, clone.SubmoduleSync(git.SubmoduleWithRecursive()))
+	submodules, err = clone.SubmoduleStatus(git.SubmoduleWithRecursive())
+	require.NoError(t, err)
+	require.Len(t, submodules, 1)
+	assert.True(t, submodules[0].Initialized)
+	assert.FileExists(t, filepath.Join(plainDir, "lib", "README.md"))
+
+	require.NoError(t, clone.SubmoduleDeinit([]string{"lib"}, git.SubmoduleWithForce()))
+	submodules, err = clone.SubmoduleStatus()
+	require.NoError(t, err)
+	require.Len(t, submodules, 1)
+	assert.False(t, submodules[0].Initialized)
+	assert.NoFileExists(t, filepath.Join(plainDir, "lib", "README.md"))
+}
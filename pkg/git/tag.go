@@ -1,12 +1,18 @@
 package git
 
 import (
+	stderrors "errors"
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
+
+	"github.com/instruqt/git-exec/pkg/git/errors"
 	"github.com/instruqt/git-exec/pkg/git/types"
 )
 
-// Tag creates a new tag
+// Tag creates a new tag at HEAD, or at the object given with TagWithTarget.
+// The tag is lightweight unless TagWithMessage or TagWithSign is given
 func (g *gitImpl) Tag(name string, opts ...Option) error {
 	cmd := g.newCommand("tag", name)
 	cmd.ApplyOptions(opts...)
@@ -14,25 +20,217 @@ func (g *gitImpl) Tag(name string, opts ...Option) error {
 	return err
 }
 
-// ListTags lists all tags in the repository
-func (g *gitImpl) ListTags(opts ...Option) ([]string, error) {
-	cmd := g.newCommand("tag", "-l")
+// tagPrefix is the ref prefix of tags, also used by ListTagsWithPattern
+const tagPrefix = "refs/tags/"
+
+// tagFields are the for-each-ref fields of a tag, each followed by NUL
+var tagFields = []string{
+	"%(refname)",
+	"%(objecttype)",
+	"%(objectname)",
+	"%(*objecttype)",
+	"%(*objectname)",
+	"%(taggername) %(taggeremail)",
+	"%(creatordate:iso-strict)",
+	"%(contents:subject)",
+	"%(contents)",
+	"%(contents:signature)",
+}
+
+// verifyTagsArg marks a listing made with ListTagsWithVerify. ListTags takes
+// it out before for-each-ref runs
+const verifyTagsArg = "--verify"
+
+// ListTags lists tags with their target, tagger and message, sorted by name
+// unless ListTagsWithSort is given. Signatures are only verified with
+// ListTagsWithVerify, as that runs gpg or ssh-keygen for every signed tag
+func (g *gitImpl) ListTags(opts ...Option) ([]types.Tag, error) {
+	cmd := g.newCommand("for-each-ref", "--format=%00"+strings.Join(tagFields, "%00")+"%00")
+
+	// Apply all provided options
 	cmd.ApplyOptions(opts...)
+
+	// List every tag unless patterns were given
+	args := cmd.GetArgs()
+	sub := subcommandArgs(args)
+	filtered := append([]string{}, args[:len(args)-len(sub)]...)
+	hasPattern, verify := false, false
+	for _, arg := range sub {
+		switch {
+		case arg == verifyTagsArg:
+			verify = true
+			continue
+		case strings.HasPrefix(arg, tagPrefix):
+			hasPattern = true
+		}
+		filtered = append(filtered, arg)
+	}
+	cmd.SetArgs(filtered)
+	if !hasPattern {
+		cmd.AddArgs(tagPrefix)
+	}
+
 	output, err := cmd.Execute()
 	if err != nil {
 		return nil, err
 	}
-	if len(output) == 0 {
-		return []string{}, nil
+
+	tags := []types.Tag{}
+	// Records are "\x00<fields>\x00\n", so after the leading empty token each
+	// tag is its fields followed by the newline separating records
+	tokens := strings.Split(string(output), "\x00")
+	for i := 1; i+len(tagFields) <= len(tokens); i += len(tagFields) + 1 {
+		fields := tokens[i : i+len(tagFields)]
+		tag := parseTag(fields)
+
+		if tag.Signed && verify {
+			tag.Signature, err = g.VerifyTag(tag.Ref)
+			// One tag that cannot be checked, e.g. because gpg is missing,
+			// does not fail the listing
+			if err != nil {
+				tag.Signature = &types.CommitSignature{Status: types.SignatureStatusUnverifiable}
+			}
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// parseTag parses the fields of one for-each-ref record
+func parseTag(fields []string) types.Tag {
+	tag := types.Tag{
+		Name:       strings.TrimPrefix(fields[0], tagPrefix),
+		Ref:        fields[0],
+		Object:     fields[2],
+		Target:     fields[2],
+		TargetType: fields[1],
+	}
+
+	if fields[1] == "tag" {
+		tag.Annotated = true
+		tag.Target = fields[4]
+		tag.TargetType = fields[3]
+		tag.Tagger = strings.TrimSpace(fields[5])
+		tag.Subject = fields[7]
+		tag.Message = strings.TrimSpace(strings.TrimSuffix(fields[8], fields[9]))
+		tag.Signed = fields[9] != ""
 	}
-	tags := strings.Split(strings.TrimSpace(string(output)), "\n")
-	result := []string{}
-	for _, tag := range tags {
-		if tag != "" {
-			result = append(result, tag)
+	if tag.TargetType == "commit" {
+		tag.Commit = tag.Target
+	}
+
+	if date, err := time.Parse(time.RFC3339, fields[6]); err == nil {
+		tag.Date = date
+	}
+	return tag
+}
+
+// sshSignaturePattern matches ssh verification output such as
+// `Good "git" signature for user@example.com with ED25519 key SHA256:...`
+var sshSignaturePattern = regexp.MustCompile(`Good "git" signature(?: for (.+))? with \S+ key (\S+)`)
+
+// VerifyTag checks the GPG or SSH signature of an annotated tag. A signature
+// that fails verification is reported through its Status; errors.ErrTagNotSigned
+// is returned for unsigned and lightweight tags
+func (g *gitImpl) VerifyTag(name string, opts ...Option) (*types.CommitSignature, error) {
+	cmd := g.newCommand("verify-tag", "--raw", name)
+	cmd.ApplyOptions(opts...)
+	cmd.ApplyOptions(WithEnv("LC_ALL", "C"))
+
+	output, err := cmd.ExecuteCombined()
+	if err != nil {
+		var gitErr *errors.GitError
+		if !stderrors.As(err, &gitErr) {
+			return nil, err
+		}
+		if strings.Contains(gitErr.Stderr, "no signature found") ||
+			strings.Contains(gitErr.Stderr, "cannot verify a non-tag object") {
+			return nil, errors.ErrTagNotSigned
+		}
+		output = []byte(gitErr.Stderr)
+	}
+
+	signature := parseVerifyOutput(string(output))
+	if signature == nil {
+		if err != nil {
+			return nil, err
+		}
+		// git accepted the signature without output we recognise
+		signature = &types.CommitSignature{Status: types.SignatureStatusGood}
+	}
+	return signature, nil
+}
+
+// gpgSignatureStatuses maps GPG status keywords to signature statuses
+var gpgSignatureStatuses = map[string]types.SignatureStatus{
+	"GOODSIG":   types.SignatureStatusGood,
+	"BADSIG":    types.SignatureStatusBad,
+	"EXPSIG":    types.SignatureStatusExpired,
+	"EXPKEYSIG": types.SignatureStatusExpiredKey,
+	"REVKEYSIG": types.SignatureStatusRevokedKey,
+	"ERRSIG":    types.SignatureStatusUnverifiable,
+}
+
+// parseVerifyOutput parses GPG status lines ("[GNUPG:] GOODSIG ...") or SSH
+// verification messages, returning nil when neither was found
+func parseVerifyOutput(output string) *types.CommitSignature {
+	var signature *types.CommitSignature
+	trusted := true
+
+	for _, line := range strings.Split(output, "\n") {
+		if match := sshSignaturePattern.FindStringSubmatch(line); match != nil {
+			signature = &types.CommitSignature{
+				Status:      types.SignatureStatusGood,
+				Signer:      match[1],
+				Fingerprint: match[2],
+			}
+			// Good signature from a key that is not in the allowed signers
+			if match[1] == "" {
+				signature.Status = types.SignatureStatusUnknownValidity
+			}
+			continue
+		}
+		if strings.Contains(line, "Signature verification failed") ||
+			strings.Contains(line, "Could not verify signature") {
+			signature = &types.CommitSignature{Status: types.SignatureStatusBad}
+			continue
+		}
+		if strings.Contains(line, "allowedSignersFile needs to be configured") {
+			signature = &types.CommitSignature{Status: types.SignatureStatusUnverifiable}
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimPrefix(line, "[GNUPG:] "))
+		if !strings.HasPrefix(line, "[GNUPG:] ") || len(fields) == 0 {
+			continue
 		}
+		switch status, ok := gpgSignatureStatuses[fields[0]]; {
+		case ok:
+			if signature == nil {
+				signature = &types.CommitSignature{}
+			}
+			signature.Status = status
+			if len(fields) > 1 {
+				signature.Key = fields[1]
+			}
+			// ERRSIG is followed by algorithm details rather than the user ID
+			if len(fields) > 2 && fields[0] != "ERRSIG" {
+				signature.Signer = strings.Join(fields[2:], " ")
+			}
+		case fields[0] == "VALIDSIG" && len(fields) > 1:
+			if signature == nil {
+				signature = &types.CommitSignature{}
+			}
+			signature.Fingerprint = fields[1]
+		case fields[0] == "TRUST_UNDEFINED" || fields[0] == "TRUST_NEVER":
+			trusted = false
+		}
+	}
+
+	if signature != nil && !trusted && signature.Status == types.SignatureStatusGood {
+		signature.Status = types.SignatureStatusUnknownValidity
 	}
-	return result, nil
+	return signature
 }
 
 // DeleteTag deletes a tag
@@ -45,13 +243,9 @@ func (g *gitImpl) DeleteTag(name string, opts ...Option) error {
 
 // PushTags pushes all tags to the remote
 func (g *gitImpl) PushTags(remote string, opts ...Option) ([]types.Remote, error) {
-	cmd := g.newCommand("push", remote, "--tags")
+	cmd := g.newCommand("push", "--porcelain", remote, "--tags")
 	cmd.ApplyOptions(opts...)
-	_, err := cmd.ExecuteWithStderr()
-	if err != nil {
-		return nil, err
-	}
-	return []types.Remote{}, nil
+	return g.executePush(cmd)
 }
 
 // DeleteRemoteTag deletes a tag from the remote repository
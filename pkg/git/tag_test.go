@@ -1,16 +1,31 @@
 package git_test
 
 import (
+	stderrors "errors"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/instruqt/git-exec/pkg/git/errors"
+	"github.com/instruqt/git-exec/pkg/git/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// tagNames returns the names of tags in order
+func tagNames(tags []types.Tag) []string {
+	names := []string{}
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
 // Test tag operations - CRUD operations and edge cases
 func TestTagOperations(t *testing.T) {
 	tempDir := setupTestRepo(t)
@@ -43,10 +58,11 @@ func TestTagOperations(t *testing.T) {
 	assert.Len(t, tags, 3)
 	
 	// Sort tags for consistent testing
-	sort.Strings(tags)
+	names := tagNames(tags)
+	sort.Strings(names)
 	expected := []string{"beta-1", "v1.0.0", "v1.1.0"}
 	sort.Strings(expected)
-	assert.Equal(t, expected, tags)
+	assert.Equal(t, expected, names)
 	
 	// Test deleting a tag
 	err = gitInstance.DeleteTag("beta-1")
@@ -56,7 +72,7 @@ func TestTagOperations(t *testing.T) {
 	tags, err = gitInstance.ListTags()
 	require.NoError(t, err)
 	assert.Len(t, tags, 2)
-	assert.NotContains(t, tags, "beta-1")
+	assert.NotContains(t, tagNames(tags), "beta-1")
 	
 	// Test deleting non-existent tag
 	err = gitInstance.DeleteTag("nonexistent")
@@ -159,4 +175,167 @@ func TestRemoteTagOperations(t *testing.T) {
 	err = gitInstance.DeleteRemoteTag("origin", "v2.0.0")
 	// Don't require success - network operations are environment dependent
 	// The value is in testing the interface works
-}
\ No newline at end of file
+}
+
+// Test lightweight and annotated tag metadata
+func TestListTagsMetadata(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.Tag("light"))
+	require.NoError(t, gitInstance.Tag("annotated", git.TagWithMessage("Release 1.0\n\nFirst stable release")))
+	require.NoError(t, gitInstance.Tag("tree", git.TagWithTarget("HEAD^{tree}"), git.TagWithMessage("Tree snapshot")))
+
+	tags, err := gitInstance.ListTags()
+	require.NoError(t, err)
+	require.Equal(t, []string{"annotated", "light", "tree"}, tagNames(tags))
+
+	annotated, light, tree := tags[0], tags[1], tags[2]
+
+	assert.False(t, light.Annotated)
+	assert.Equal(t, "refs/tags/light", light.Ref)
+	assert.Equal(t, "commit", light.TargetType)
+	assert.Len(t, light.Commit, 40)
+	assert.Equal(t, light.Commit, light.Object)
+	assert.Equal(t, light.Commit, light.Target)
+	assert.Empty(t, light.Tagger)
+	assert.Empty(t, light.Message)
+	assert.WithinDuration(t, time.Now(), light.Date, time.Minute)
+
+	assert.True(t, annotated.Annotated)
+	assert.NotEqual(t, annotated.Object, annotated.Target)
+	assert.Equal(t, light.Commit, annotated.Target)
+	assert.Equal(t, light.Commit, annotated.Commit)
+	assert.Equal(t, "Test User <test@example.com>", annotated.Tagger)
+	assert.Equal(t, "Release 1.0", annotated.Subject)
+	assert.Equal(t, "Release 1.0\n\nFirst stable release", annotated.Message)
+	assert.WithinDuration(t, time.Now(), annotated.Date, time.Minute)
+	assert.Nil(t, annotated.Signature)
+
+	assert.Equal(t, "tree", tree.TargetType)
+	assert.Empty(t, tree.Commit)
+
+	err = gitInstance.Tag("light", git.TagWithMessage("Replaced"), git.TagWithForce())
+	require.NoError(t, err)
+	tags, err = gitInstance.ListTags(git.ListTagsWithPattern("light"))
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	assert.True(t, tags[0].Annotated)
+}
+
+// Test sorting and pattern, contains and points-at filters
+func TestListTagsFilters(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	require.NoError(t, gitInstance.Tag("v1.9"))
+	require.NoError(t, gitInstance.Tag("v1.10", git.TagWithMessage("Version 1.10")))
+	require.NoError(t, gitInstance.Commit("Second commit", git.CommitWithAllowEmpty(),
+		git.WithEnv("GIT_COMMITTER_DATE", time.Now().Add(time.Hour).Format(time.RFC3339))))
+	require.NoError(t, gitInstance.Tag("v2.0"))
+	require.NoError(t, gitInstance.Tag("nightly"))
+
+	list := func(opts ...git.Option) []string {
+		tags, err := gitInstance.ListTags(opts...)
+		require.NoError(t, err)
+		return tagNames(tags)
+	}
+
+	assert.Equal(t, []string{"nightly", "v1.10", "v1.9", "v2.0"}, list())
+	assert.Equal(t, []string{"v1.9", "v1.10", "v2.0"}, list(git.ListTagsWithPattern("v*"), git.ListTagsWithSort("version:refname")))
+	assert.Equal(t, []string{"v2.0", "v1.10", "v1.9"}, list(git.ListTagsWithPattern("v*"), git.ListTagsWithSort("-version:refname")))
+	assert.Equal(t, []string{"nightly", "v2.0"}, list(git.ListTagsWithSort("refname"), git.ListTagsWithSort("-creatordate"))[:2])
+	assert.Equal(t, []string{"nightly", "v2.0"}, list(git.ListTagsWithContains("HEAD")))
+	assert.Equal(t, []string{"v1.10", "v1.9"}, list(git.ListTagsWithNoContains("HEAD")))
+	assert.Equal(t, []string{"v1.10", "v1.9"}, list(git.ListTagsWithPointsAt("HEAD~1")))
+}
+
+// Test verifying SSH-signed tags
+func TestVerifyTag(t *testing.T) {
+	if _, err := exec.LookPath("ssh-keygen"); err != nil {
+		t.Skip("ssh-keygen is not available")
+	}
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	key := filepath.Join(t.TempDir(), "id_ed25519")
+	output, err := exec.Command("ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "test@example.com", "-f", key).CombinedOutput()
+	require.NoError(t, err, string(output))
+	publicKey, err := os.ReadFile(key + ".pub")
+	require.NoError(t, err)
+
+	require.NoError(t, gitInstance.SetConfig("gpg.format", "ssh"))
+	require.NoError(t, gitInstance.Tag("signed", git.TagWithMessage("Signed release"), git.TagWithLocalUser(key)))
+	require.NoError(t, gitInstance.Tag("unsigned", git.TagWithMessage("Unsigned release")))
+	require.NoError(t, gitInstance.Tag("light"))
+
+	// Without allowed signers the signature cannot be checked
+	signature, err := gitInstance.VerifyTag("signed")
+	require.NoError(t, err)
+	assert.Equal(t, types.SignatureStatusUnverifiable, signature.Status)
+
+	allowedSigners := filepath.Join(t.TempDir(), "allowed_signers")
+	require.NoError(t, os.WriteFile(allowedSigners, append([]byte("test@example.com "), publicKey...), 0644))
+	require.NoError(t, gitInstance.SetConfig("gpg.ssh.allowedSignersFile", allowedSigners))
+
+	signature, err = gitInstance.VerifyTag("signed")
+	require.NoError(t, err)
+	assert.Equal(t, types.SignatureStatusGood, signature.Status)
+	assert.Equal(t, "test@example.com", signature.Signer)
+	assert.Contains(t, signature.Fingerprint, "SHA256:")
+
+	_, err = gitInstance.VerifyTag("unsigned")
+	assert.ErrorIs(t, err, errors.ErrTagNotSigned)
+	_, err = gitInstance.VerifyTag("light")
+	assert.ErrorIs(t, err, errors.ErrTagNotSigned)
+	_, err = gitInstance.VerifyTag("missing")
+	var gitErr *errors.GitError
+	assert.True(t, stderrors.As(err, &gitErr), "expected GitError, got %T", err)
+
+	// A tampered tag object keeps its signature but fails verification
+	tagObject, err := exec.Command("git", "-C", tempDir, "cat-file", "tag", "signed").Output()
+	require.NoError(t, err)
+	tampered := exec.Command("git", "-C", tempDir, "hash-object", "-t", "tag", "-w", "--stdin")
+	tampered.Stdin = strings.NewReader(strings.Replace(string(tagObject), "Signed release", "Tampered release", 1))
+	tamperedID, err := tampered.Output()
+	require.NoError(t, err)
+	runGit(t, tempDir, "update-ref", "refs/tags/tampered", strings.TrimSpace(string(tamperedID)))
+
+	signature, err = gitInstance.VerifyTag("tampered")
+	require.NoError(t, err)
+	assert.Equal(t, types.SignatureStatusBad, signature.Status)
+
+	// Listing only verifies signed tags on request
+	tags, err := gitInstance.ListTags()
+	require.NoError(t, err)
+	require.Equal(t, []string{"light", "signed", "tampered", "unsigned"}, tagNames(tags))
+	assert.Equal(t, []bool{false, true, true, false}, []bool{tags[0].Signed, tags[1].Signed, tags[2].Signed, tags[3].Signed})
+	for _, tag := range tags {
+		assert.Nil(t, tag.Signature, tag.Name)
+	}
+
+	tags, err = gitInstance.ListTags(git.ListTagsWithVerify())
+	require.NoError(t, err)
+	require.Equal(t, []string{"light", "signed", "tampered", "unsigned"}, tagNames(tags))
+	assert.Nil(t, tags[0].Signature)
+	require.NotNil(t, tags[1].Signature)
+	assert.Equal(t, types.SignatureStatusGood, tags[1].Signature.Status)
+	assert.Equal(t, "Signed release", tags[1].Message)
+	require.NotNil(t, tags[2].Signature)
+	assert.Equal(t, types.SignatureStatusBad, tags[2].Signature.Status)
+	assert.Nil(t, tags[3].Signature)
+
+	// Tags that cannot be checked do not fail the listing
+	require.NoError(t, gitInstance.SetConfig("gpg.ssh.program", filepath.Join(tempDir, "missing-ssh-keygen")))
+	tags, err = gitInstance.ListTags(git.ListTagsWithVerify(), git.ListTagsWithPattern("signed"))
+	require.NoError(t, err)
+	require.Len(t, tags, 1)
+	require.NotNil(t, tags[0].Signature)
+	assert.Equal(t, types.SignatureStatusUnverifiable, tags[0].Signature.Status)
+}
@@ -16,6 +16,8 @@ const (
 	FileStatusRenamed     FileStatus = "renamed"
 	FileStatusCopied      FileStatus = "copied"
 	FileStatusUpdated     FileStatus = "updated"
+	FileStatusTypeChanged FileStatus = "type_changed"
+	FileStatusIgnored     FileStatus = "ignored"
 
 	RefStatusUnspecified  RefStatus = "unspecified"
 	RefStatusFastForward  RefStatus = "fast_forward"  // " "
@@ -33,19 +35,135 @@ type File struct {
 	Destination string
 }
 
+// StatusResult is the parsed output of git status --porcelain=v2
+type StatusResult struct {
+	Branch     BranchStatus
+	Entries    []StatusEntry
+	StashCount int
+}
+
+// BranchStatus describes HEAD and its upstream
+type BranchStatus struct {
+	OID      string // Commit at HEAD, empty before the first commit
+	Head     string // Current branch, empty when detached
+	Detached bool
+	Upstream string // Upstream branch, empty when none is configured
+	Ahead    int
+	Behind   int
+}
+
+// RepositoryOperation is a multi-step operation that can be in progress
+type RepositoryOperation string
+
+const (
+	RepositoryOperationNone       RepositoryOperation = ""
+	RepositoryOperationMerge      RepositoryOperation = "merge"
+	RepositoryOperationRebase     RepositoryOperation = "rebase"
+	RepositoryOperationAm         RepositoryOperation = "am"
+	RepositoryOperationCherryPick RepositoryOperation = "cherry_pick"
+	RepositoryOperationRevert     RepositoryOperation = "revert"
+	RepositoryOperationBisect     RepositoryOperation = "bisect"
+)
+
+// RepositoryState describes the operation in progress in a repository.
+// Operation is RepositoryOperationNone when the repository is idle
+type RepositoryState struct {
+	Operation     RepositoryOperation
+	RebaseBackend string   // "merge" or "apply" for rebases
+	Interactive   bool     // Interactive rebase
+	HeadName      string   // Branch being rebased, or where bisect started; empty when detached
+	Onto          string   // Commit the rebase replays onto
+	OrigHead      string   // HEAD before the rebase started
+	StoppedAt     string   // Commit being applied when the operation stopped
+	MergeHeads    []string // Commits being merged
+	Step          int      // Step being executed, for rebases and am
+	TotalSteps    int      // Number of steps, for rebases and am
+	Sequence      bool     // Cherry-pick or revert of several commits
+	Message       string   // Prepared commit message
+}
+
+// StatusEntry is a changed, unmerged, untracked or ignored path. Staged holds
+// the index side (X) and Unstaged the working tree side (Y) of the status code
+type StatusEntry struct {
+	Path         string
+	OrigPath     string // Source path of renames and copies
+	Staged       FileStatus
+	Unstaged     FileStatus
+	Conflict     ConflictStatus // Set for unmerged entries
+	Score        int            // Similarity score of renames and copies
+	Submodule    *SubmoduleState
+	HeadMode     int // Octal modes in HEAD, the index and the working tree
+	IndexMode    int
+	WorktreeMode int
+	HeadSHA      string
+	IndexSHA     string
+}
+
+// SubmoduleState describes a submodule's working tree in status output
+type SubmoduleState struct {
+	CommitChanged    bool // Checked out commit differs from the one recorded
+	TrackedChanges   bool // Submodule has modified tracked files
+	UntrackedChanges bool // Submodule has untracked files
+}
+
+// Branch describes a local or remote-tracking branch
 type Branch struct {
-	Name   string
-	Active bool
+	Name         string    // Short name, such as "main" or "origin/main"
+	Ref          string    // Full ref name, such as "refs/heads/main"
+	Active       bool      // Checked out in the current working tree
+	Remote       bool      // Remote-tracking branch
+	Commit       string    // Commit the branch points to
+	SymbolicRef  string    // Branch a symbolic ref such as origin/HEAD points to
+	Upstream     string    // Short name of the upstream branch, empty when none is set
+	UpstreamGone bool      // Upstream is configured but no longer exists
+	Ahead        int       // Commits on the branch that are not on its upstream
+	Behind       int       // Commits on the upstream that are not on the branch
+	Subject      string    // Subject of the last commit
+	CommitDate   time.Time // Committer date of the last commit
+	WorktreePath string    // Worktree the branch is checked out in, if any
 }
 
 type Diff struct {
-	Format   string
+	Format   string // "unified", "combined", "raw" or "numstat" depending on the richest output seen
 	OldFile  string
 	NewFile  string
+	Status   FileStatus
 	Header   DiffHeader
-	Contents string
+	Contents string // Raw patch text for this file
+	Binary   bool
+	Hunks    []DiffHunk
+	Stat     *DiffStat // Available with --numstat
 }
 
+// DiffHunk is a single @@ section of a unified diff
+type DiffHunk struct {
+	OldStart int // Range in the first parent for combined diffs of merges
+	OldLines int
+	NewStart int
+	NewLines int
+	Section  string // Function context after the closing @@
+	Lines    []DiffLine
+}
+
+type DiffLineType string
+
+const (
+	DiffLineContext DiffLineType = "context"
+	DiffLineAdded   DiffLineType = "added"
+	DiffLineDeleted DiffLineType = "deleted"
+)
+
+// DiffLine is a single line of a hunk with its position in the old and new file
+type DiffLine struct {
+	Type      DiffLineType
+	Content   string
+	OldLine   int  // 0 for added lines
+	NewLine   int  // 0 for deleted lines
+	NoNewline bool // Line is not terminated by a newline
+}
+
+// DiffHeader holds the extended header lines of a file diff. Modes are octal
+// git modes (e.g. 0100644)
 type DiffHeader struct {
 	OldMode            *int
 	NewMode            *int
@@ -82,20 +200,140 @@ type Remote struct {
 type Log struct {
 	Commit        string
 	Tree          string
-	Parent        string
+	Parent        string   // Parent SHAs separated by spaces, see Parents
+	Parents       []string // Parent SHAs, more than one for merge commits
 	Author        string
 	AuthorDate    time.Time
-	Message       string
+	Message       string // Subject line, kept for compatibility; see FullMessage
+	Subject       string
+	Body          string // Message after the subject
+	FullMessage   string // Subject and body
 	Committer     string
 	CommitterDate time.Time
-	Diffs         []Diff
+	Refs          []string // Full names of the refs pointing at the commit, including HEAD
+	Trailers      []Trailer
+	Notes         string
+	Signature     *CommitSignature // Only checked with LogWithSignatures, nil for unsigned commits
+	Diffs         []Diff           // Available with patch or numstat output
+}
+
+// Trailer is a "Key: value" line at the end of a commit message
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+// SignatureStatus is the result of verifying a commit signature
+type SignatureStatus string
+
+const (
+	SignatureStatusGood            SignatureStatus = "good"
+	SignatureStatusBad             SignatureStatus = "bad"
+	SignatureStatusUnknownValidity SignatureStatus = "unknown_validity" // Good signature from a key of unknown validity
+	SignatureStatusExpired         SignatureStatus = "expired"          // Good signature that has expired
+	SignatureStatusExpiredKey      SignatureStatus = "expired_key"      // Good signature made by an expired key
+	SignatureStatusRevokedKey      SignatureStatus = "revoked_key"      // Good signature made by a revoked key
+	SignatureStatusUnverifiable    SignatureStatus = "unverifiable"     // The signature cannot be checked, e.g. the key is missing
+)
+
+// CommitSignature describes the signature of a commit or tag
+type CommitSignature struct {
+	Status      SignatureStatus
+	Signer      string
+	Key         string
+	Fingerprint string
+}
+
+// Tag describes a lightweight or annotated tag
+type Tag struct {
+	Name       string           // Short name, such as "v1.0.0"
+	Ref        string           // Full ref name, such as "refs/tags/v1.0.0"
+	Annotated  bool             // Tag object with a tagger and message
+	Object     string           // Object the ref points to: the tag object of annotated tags
+	Target     string           // Tagged object
+	TargetType string           // Type of the tagged object: "commit", "tree", "blob" or "tag"
+	Commit     string           // Tagged commit, empty when the tag does not point at a commit
+	Tagger     string           // "Name <email>" of annotated tags
+	Date       time.Time        // Tagger date, or the committer date of lightweight tags
+	Subject    string           // First line of the tag message
+	Message    string           // Full tag message without the signature
+	Signed     bool             // The tag message carries a signature
+	Signature  *CommitSignature // Verified signature with ListTagsWithVerify, nil for unsigned tags
+}
+
+// BlameLine attributes a line of a file to the commit that last changed it
+type BlameLine struct {
+	Commit         string
+	OrigLine       int    // Line number in the commit that introduced it
+	FinalLine      int    // Line number in the blamed file
+	OrigPath       string // Path of the file in the commit that introduced the line
+	Content        string // Line content, empty for incremental blame
+	Author         string
+	AuthorEmail    string
+	AuthorTime     time.Time
+	Committer      string
+	CommitterEmail string
+	CommitterTime  time.Time
+	Summary        string
+	Boundary       bool   // The commit is the boundary of the blamed range
+	Previous       string // Parent commit the line was blamed past, if any
+	PreviousPath   string // Path of the file in Previous
+}
+
+// ReflogEntry is a single update recorded in a reference log
+type ReflogEntry struct {
+	Selector  string // Selector addressing the entry, e.g. "HEAD@{3}"
+	Ref       string // Full name of the ref, e.g. "HEAD" or "refs/heads/main"
+	OldSHA    string // Value before the update, empty when the update created the ref
+	NewSHA    string // Value after the update
+	Action    string // Operation that updated the ref: commit, checkout, reset, merge, rebase, ...
+	Message   string // Text following the action, e.g. "moving from main to feature"
+	Subject   string // Complete reflog message, e.g. "checkout: moving from main to feature"
+	Name      string // Identity that made the update
+	Email     string
+	Timestamp time.Time // Time of the update
+}
+
+// StashEntry is a single entry of the stash list
+type StashEntry struct {
+	Index     int       // Position in the stash list, 0 being the most recent; -1 when listed with a date mode
+	Selector  string    // e.g. "stash@{0}"
+	SHA       string    // Stash commit
+	Branch    string    // Branch the changes were stashed on, "(no branch)" for a detached HEAD
+	Message   string    // Custom message, or "<sha> <subject>" of the commit the stash was based on
+	Timestamp time.Time // Time the changes were stashed
+}
+
+// Worktree is a working tree attached to the repository
+type Worktree struct {
+	Path           string
+	HEAD           string // Checked out commit, empty for bare repositories
+	Branch         string // Checked out branch, empty when detached
+	Bare           bool
+	Detached       bool
+	Locked         bool
+	LockReason     string
+	Prunable       bool   // The worktree directory is missing and can be pruned
+	PrunableReason string
+}
+
+// Submodule is a submodule as reported by `git submodule status`
+type Submodule struct {
+	Path        string
+	SHA         string // Checked out commit, or the recorded commit when not initialized
+	Describe    string // Output of git describe for SHA, e.g. "heads/main"
+	Initialized bool
+	OutOfSync   bool   // The checked out commit differs from the one recorded in the superproject
+	Conflict    bool   // The submodule has merge conflicts
 }
 
 type Ref struct {
 	Status  RefStatus
-	Summary string
-	From    string
-	To      string
+	Summary string // Summary column as printed by git (e.g. "[new branch]" or "abc1234..def5678")
+	From    string // Ref on the side being read from
+	To      string // Ref on the side being updated
+	OldSHA  string // Object the updated ref pointed to before (empty for new refs)
+	NewSHA  string // Object the updated ref points to now (empty for deleted refs)
 	Reason  *string
 }
 
@@ -112,6 +350,118 @@ type MergeResult struct {
 	AbortReason      string
 }
 
+// SequencerResult is the outcome of a cherry-pick or revert. The embedded
+// MergeResult reports the conflicts of the commit that stopped the sequence
+type SequencerResult struct {
+	MergeResult
+	Applied   []AppliedCommit // Commits applied by this operation, in order
+	StoppedAt string          // Commit that could not be applied, empty when the sequence completed
+}
+
+// AppliedCommit pairs a cherry-picked or reverted commit with the commit created for it
+type AppliedCommit struct {
+	Source string
+	Commit string
+}
+
+// RebaseAction is a command of an interactive rebase todo list
+type RebaseAction string
+
+const (
+	RebaseActionPick      RebaseAction = "pick"
+	RebaseActionReword    RebaseAction = "reword"
+	RebaseActionEdit      RebaseAction = "edit"
+	RebaseActionSquash    RebaseAction = "squash"
+	RebaseActionFixup     RebaseAction = "fixup"
+	RebaseActionDrop      RebaseAction = "drop"
+	RebaseActionExec      RebaseAction = "exec"
+	RebaseActionBreak     RebaseAction = "break"
+	RebaseActionLabel     RebaseAction = "label"
+	RebaseActionReset     RebaseAction = "reset"
+	RebaseActionMerge     RebaseAction = "merge"
+	RebaseActionUpdateRef RebaseAction = "update-ref"
+)
+
+// RebaseTodo is a single step of an interactive rebase
+type RebaseTodo struct {
+	Action  RebaseAction
+	Commit  string // Commit to pick, reword, edit, squash, fixup or drop; for merge, the commit whose message is reused
+	Message string // New commit message for reword, squash, fixup and merge
+	Command string // Shell command for exec
+	Label   string // Label for label, reset and merge; ref for update-ref
+	Subject string // Commit subject, as shown in todo lists read back from git
+
+	// MessageOption is "-C" to take the commit's message for fixup and merge,
+	// or "-c" to take it and open the editor. merge defaults to "-C"
+	MessageOption string
+}
+
+// RebaseResult is the outcome of a rebase step. The embedded MergeResult
+// reports the conflicts of the step that stopped the rebase
+type RebaseResult struct {
+	MergeResult
+	InProgress bool         // The rebase stopped and waits for RebaseContinue, RebaseSkip or RebaseAbort
+	Step       int          // Number of the step being executed
+	TotalSteps int          // Number of steps of the rebase
+	StoppedAt  string       // Commit being applied when the rebase stopped
+	Done       []RebaseTodo // Steps executed so far
+	Remaining  []RebaseTodo // Steps still to execute
+}
+
+// BisectVerdict is the outcome of testing a commit during bisect. With
+// custom terms, good is the old state and bad the new state
+type BisectVerdict string
+
+const (
+	BisectVerdictGood BisectVerdict = "good"
+	BisectVerdictBad  BisectVerdict = "bad"
+	BisectVerdictSkip BisectVerdict = "skip"
+)
+
+// BisectStep is a commit marked during bisect
+type BisectStep struct {
+	Commit  string
+	Subject string
+	Verdict BisectVerdict
+}
+
+// BisectResult describes the progress of a bisect session
+type BisectResult struct {
+	Done          bool         // The first bad commit was found, or only skipped commits are left
+	FirstBad      string       // First bad commit, once found
+	Candidates    []string     // Possible first bad commits when only skipped commits are left
+	Current       string       // Commit to test next
+	RevisionsLeft int          // Revisions left to test after the current one
+	StepsLeft     int          // Rough number of steps left after the current one
+	Tested        []BisectStep // Commits marked so far, in order
+}
+
+// ArchiveFormat is the format of an archive created by git archive
+type ArchiveFormat string
+
+const (
+	ArchiveFormatTar   ArchiveFormat = "tar"
+	ArchiveFormatTarGz ArchiveFormat = "tar.gz"
+	ArchiveFormatZip   ArchiveFormat = "zip"
+)
+
+// BundleRef is a ref recorded in a bundle
+type BundleRef struct {
+	Name string
+	SHA  string
+}
+
+// BundleInfo describes a bundle and whether it can be applied to the repository
+type BundleInfo struct {
+	Valid           bool
+	Refs            []BundleRef
+	Prerequisites   []string // Commits the bundle needs, empty for complete histories
+	Missing         []string // Prerequisites the repository lacks
+	CompleteHistory bool
+	HashAlgorithm   string
+	Errors          []string // Reasons the bundle is not valid
+}
+
 type MergeStats struct {
 	FilesChanged int
 	Insertions   int
@@ -143,6 +493,10 @@ type ConflictSection struct {
 	OurContent   string
 	TheirContent string
 	BaseContent  string // Available with diff3 conflict style
+	HasBase      bool   // Whether the section has a base part (diff3/zdiff3)
+	OurLabel     string // Label after the <<<<<<< marker
+	BaseLabel    string // Label after the ||||||| marker
+	TheirLabel   string // Label after the >>>>>>> marker
 	Resolved     bool
 	Resolution   string // User's resolution
 }
@@ -152,7 +506,17 @@ type ConflictResolution struct {
 	Sections   []ResolvedSection
 	UseOurs    bool   // Use our version entirely
 	UseTheirs  bool   // Use their version entirely
-	Custom     bool   // Use custom resolution
+	Custom     bool   // Use custom resolution: rewrite Sections, or stage the file as edited when there are none
+	Delete     bool   // Resolve by removing the file
+}
+
+// ConflictResolutionResult reports how a single file was resolved
+type ConflictResolutionResult struct {
+	FilePath          string
+	Staged            bool // The resolution was added to the index
+	Deleted           bool // The file was removed
+	ResolvedSections  int  // Conflict sections rewritten with the supplied text
+	RemainingSections int  // Conflict sections still present, preventing staging
 }
 
 type ResolvedSection struct {
@@ -0,0 +1,115 @@
+package git
+
+import (
+	"strings"
+
+	"github.com/instruqt/git-exec/pkg/git/types"
+)
+
+// WorktreeAdd creates a worktree at path. An empty commitish checks out a
+// new branch named after the last path component, as git does
+func (g *gitImpl) WorktreeAdd(path, commitish string, opts ...Option) error {
+	cmd := g.newCommand("worktree", "add")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(path)
+	if commitish != "" {
+		cmd.AddArgs(commitish)
+	}
+	_, err := cmd.Execute()
+	return err
+}
+
+// WorktreeList lists the main worktree followed by the linked worktrees
+func (g *gitImpl) WorktreeList(opts ...Option) ([]types.Worktree, error) {
+	cmd := g.newCommand("worktree", "list", "--porcelain")
+	cmd.ApplyOptions(opts...)
+	output, err := cmd.Execute()
+	if err != nil {
+		return nil, err
+	}
+	return parseWorktreeList(string(output)), nil
+}
+
+// WorktreeRemove removes a worktree
+func (g *gitImpl) WorktreeRemove(path string, opts ...Option) error {
+	cmd := g.newCommand("worktree", "remove")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(path)
+	_, err := cmd.Execute()
+	return err
+}
+
+// WorktreeLock prevents a worktree from being pruned, moved or removed
+func (g *gitImpl) WorktreeLock(path string, opts ...Option) error {
+	cmd := g.newCommand("worktree", "lock")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(path)
+	_, err := cmd.Execute()
+	return err
+}
+
+// WorktreeUnlock unlocks a worktree
+func (g *gitImpl) WorktreeUnlock(path string, opts ...Option) error {
+	cmd := g.newCommand("worktree", "unlock")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(path)
+	_, err := cmd.Execute()
+	return err
+}
+
+// WorktreeMove moves a worktree to a new location
+func (g *gitImpl) WorktreeMove(path, newPath string, opts ...Option) error {
+	cmd := g.newCommand("worktree", "move")
+	cmd.ApplyOptions(opts...)
+	cmd.AddArgs(path, newPath)
+	_, err := cmd.Execute()
+	return err
+}
+
+// WorktreePrune removes administrative data of worktrees whose directory is gone
+func (g *gitImpl) WorktreePrune(opts ...Option) error {
+	cmd := g.newCommand("worktree", "prune")
+	cmd.ApplyOptions(opts...)
+	_, err := cmd.Execute()
+	return err
+}
+
+// parseWorktreeList parses `git worktree list --porcelain` output, where
+// each worktree is a block of attribute lines followed by a blank line
+func parseWorktreeList(output string) []types.Worktree {
+	worktrees := []types.Worktree{}
+	var current *types.Worktree
+
+	for _, line := range strings.Split(output, "\n") {
+		key, value, _ := strings.Cut(line, " ")
+		if key == "worktree" {
+			worktrees = append(worktrees, types.Worktree{Path: value})
+			current = &worktrees[len(worktrees)-1]
+			continue
+		}
+		if current == nil {
+			continue
+		}
+
+		switch key {
+		case "":
+			current = nil
+		case "HEAD":
+			current.HEAD = value
+		case "branch":
+			current.Branch = strings.TrimPrefix(value, "refs/heads/")
+		case "bare":
+			current.Bare = true
+		case "detached":
+			current.Detached = true
+		case "locked":
+			current.Locked = true
+			current.LockReason = value
+		case "prunable":
+			current.Prunable = true
+			current.PrunableReason = value
+		}
+	}
+
+	return worktrees
+}
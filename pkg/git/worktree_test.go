@@ -0,0 +1,102 @@
+package git_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/instruqt/git-exec/pkg/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test inspecting older commits in worktrees without touching the main checkout
+func TestWorktreeAddAndList(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	logs, err := gitInstance.Log(git.LogWithMaxCount("1"))
+	require.NoError(t, err)
+	initial := logs[0].Commit
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "README.md"), []byte("changed\n"), 0644))
+	require.NoError(t, gitInstance.Commit("Change README", git.CommitWithAll()))
+
+	worktreesDir := t.TempDir()
+	detachedPath := filepath.Join(worktreesDir, "initial")
+	branchPath := filepath.Join(worktreesDir, "review")
+	require.NoError(t, gitInstance.WorktreeAdd(detachedPath, initial, git.WorktreeWithDetach()))
+	require.NoError(t, gitInstance.WorktreeAdd(branchPath, "main", git.WorktreeWithBranch("review"),
+		git.WorktreeWithLock(), git.WorktreeWithReason("grading")))
+
+	content, err := os.ReadFile(filepath.Join(detachedPath, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Test Repo", string(content))
+
+	worktrees, err := gitInstance.WorktreeList()
+	require.NoError(t, err)
+	require.Len(t, worktrees, 3)
+
+	main, detached, review := worktrees[0], worktrees[1], worktrees[2]
+	assert.Equal(t, "main", main.Branch)
+	assert.False(t, main.Detached)
+	assert.True(t, detached.Detached)
+	assert.Equal(t, initial, detached.HEAD)
+	assert.Empty(t, detached.Branch)
+	assert.Equal(t, "review", review.Branch)
+	assert.True(t, review.Locked)
+	assert.Equal(t, "grading", review.LockReason)
+
+	// A Git instance bound to the worktree sees its own checkout
+	worktree := gitInstance.WithWorktree(detachedPath)
+	status, err := worktree.DetailedStatus()
+	require.NoError(t, err)
+	assert.True(t, status.Branch.Detached)
+	assert.Equal(t, initial, status.Branch.OID)
+
+	status, err = gitInstance.DetailedStatus()
+	require.NoError(t, err)
+	assert.Equal(t, "main", status.Branch.Head)
+}
+
+// Test locking, moving, removing and pruning worktrees
+func TestWorktreeLifecycle(t *testing.T) {
+	tempDir := setupTestRepo(t)
+	gitInstance, err := git.NewGit()
+	require.NoError(t, err)
+	gitInstance.SetWorkingDirectory(tempDir)
+
+	worktreesDir := t.TempDir()
+	path := filepath.Join(worktreesDir, "feature")
+	movedPath := filepath.Join(worktreesDir, "moved")
+	require.NoError(t, gitInstance.WorktreeAdd(path, ""))
+
+	require.NoError(t, gitInstance.WorktreeLock(path, git.WorktreeWithReason("in use")))
+	assert.Error(t, gitInstance.WorktreeRemove(path), "locked worktrees cannot be removed")
+	require.NoError(t, gitInstance.WorktreeUnlock(path))
+
+	require.NoError(t, gitInstance.WorktreeMove(path, movedPath))
+	assert.DirExists(t, movedPath)
+
+	require.NoError(t, os.WriteFile(filepath.Join(movedPath, "dirty.txt"), []byte("dirty"), 0644))
+	assert.Error(t, gitInstance.WorktreeRemove(movedPath), "dirty worktrees need force")
+	require.NoError(t, gitInstance.WorktreeRemove(movedPath, git.WorktreeWithForce()))
+	assert.NoDirExists(t, movedPath)
+
+	// Worktrees whose directory disappeared are prunable
+	gonePath := filepath.Join(worktreesDir, "gone")
+	require.NoError(t, gitInstance.WorktreeAdd(gonePath, "", git.WorktreeWithDetach()))
+	require.NoError(t, os.RemoveAll(gonePath))
+
+	worktrees, err := gitInstance.WorktreeList()
+	require.NoError(t, err)
+	require.Len(t, worktrees, 2)
+	assert.True(t, worktrees[1].Prunable)
+
+	require.NoError(t, gitInstance.WorktreePrune())
+	worktrees, err = gitInstance.WorktreeList()
+	require.NoError(t, err)
+	assert.Len(t, worktrees, 1)
+}